@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases"
+)
+
+func runAdminCommand(
+	ctx context.Context,
+	arguments []string,
+	dependencies commandDependencies,
+) error {
+	if len(arguments) == 0 {
+		return errors.New("the admin command isn't specified")
+	}
+
+	storageGateways := dependencies.storageGateways
+	command, arguments := arguments[0], arguments[1:]
+	switch {
+	case command == "get" && len(arguments) == 1:
+		return showLink(
+			ctx,
+			arguments[0],
+			storageGateways.linkByCodeGetter,
+			dependencies,
+		)
+	case command == "resolve" && len(arguments) == 1:
+		// links are stored with normalized URLs
+		url, err := dependencies.urlNormalizer.NormalizeURL(arguments[0])
+		if err != nil {
+			return errors.Wrap(err, "unable to normalize the URL")
+		}
+		if url != arguments[0] {
+			fmt.Printf("normalized URL: %s\n", url)
+		}
+
+		return showLink(ctx, url, storageGateways.linkByURLGetter, dependencies)
+	case command == "delete" && len(arguments) == 1:
+		return deleteLink(ctx, arguments[0], dependencies)
+	case command == "cache" && len(arguments) == 2 && arguments[0] == "purge":
+		return purgeCache(ctx, arguments[1], dependencies)
+	case command == "counter" && len(arguments) == 1 && arguments[0] == "show":
+		return showCounters(ctx, dependencies)
+	case command == "code" && len(arguments) == 2 && arguments[0] == "decode":
+		return decodeCode(arguments[1], dependencies)
+	case command == "verify" && len(arguments) == 0:
+		return verifyCache(ctx, dependencies)
+	default:
+		return errors.Errorf("unknown admin command %q or its arguments", command)
+	}
+}
+
+func showLink(
+	ctx context.Context,
+	key string,
+	storageGetter usecases.LinkGetter,
+	dependencies commandDependencies,
+) error {
+	for _, source := range []struct {
+		name   string
+		getter usecases.LinkGetter
+	}{
+		{name: "storage", getter: storageGetter},
+		{name: "cache", getter: dependencies.cacheGateways.rawLinkGetter},
+	} {
+		link, err := source.getter.GetLink(ctx, key)
+		switch errors.Cause(err) {
+		case nil:
+			fmt.Printf("%s: %s\n", source.name, formatLink(link))
+		case sql.ErrNoRows:
+			fmt.Printf("%s: not found\n", source.name)
+		case entities.ErrLinkExpired:
+			fmt.Printf("%s: expired\n", source.name)
+		default:
+			fmt.Printf("%s: unable to get the link: %v\n", source.name, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteLink(
+	ctx context.Context,
+	code string,
+	dependencies commandDependencies,
+) error {
+	// the storage is the source of truth, so links are got only from it
+	remover := usecases.LinkRemover{
+		LinkGetter: dependencies.storageGateways.linkByCodeGetter,
+		LinkDeleter: usecases.LinkDeleterGroup{
+			dependencies.cacheGateways.linkDeleter,
+			dependencies.storageGateways.linkDeleter,
+		},
+	}
+	link, err := remover.RemoveLink(ctx, code)
+	if err != nil {
+		return errors.Wrap(err, "unable to remove the link")
+	}
+
+	fmt.Printf("deleted: %s\n", formatLink(link))
+	return nil
+}
+
+func purgeCache(
+	ctx context.Context,
+	key string,
+	dependencies commandDependencies,
+) error {
+	cacheGateways := dependencies.cacheGateways
+	var links []entities.Link
+	link, err := cacheGateways.rawLinkGetter.GetLink(ctx, key)
+	switch errors.Cause(err) {
+	case nil:
+		// the link is cached by both its code and its URL
+		links = append(links, link)
+	case sql.ErrNoRows, entities.ErrLinkExpired:
+	default:
+		dependencies.logger.Logf("unable to get the cached link: %v", err)
+	}
+
+	// the key is purged as is too, in case it's broken
+	if link.Code != key && link.URL != key {
+		links = append(links, entities.Link{Code: key, URL: key})
+	}
+
+	for _, link := range links {
+		if err := cacheGateways.linkDeleter.DeleteLink(ctx, link); err != nil {
+			return errors.Wrap(err, "unable to delete the link from the cache")
+		}
+	}
+
+	fmt.Printf("purged: %s\n", key)
+	return nil
+}
+
+func showCounters(ctx context.Context, dependencies commandDependencies) error {
+	for index := 0; index < dependencies.counterCount; index++ {
+		name := fmt.Sprintf(counterNameTemplate, index)
+		countChunk, err := dependencies.newCounter(name).PeekCountChunk(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "unable to peek the counter #%d", index)
+		}
+
+		// the chunk is transformed in the same way as on generating codes
+		countChunk = newCounterTransformer(
+			index,
+			dependencies.counterChunk,
+			dependencies.counterRange,
+		)(countChunk)
+		fmt.Printf(
+			"%s: the next chunk starts at %d (code %s)\n",
+			name,
+			countChunk,
+			dependencies.codeFormatters.formatter(countChunk),
+		)
+	}
+
+	return nil
+}
+
+func decodeCode(code string, dependencies commandDependencies) error {
+	counter, err := dependencies.codeFormatters.parser(code)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse the code")
+	}
+
+	// it's possible only for custom codes (aliases)
+	index := counter / dependencies.counterRange
+	if index >= uint64(dependencies.counterCount) {
+		fmt.Printf("%s: the counter %d is out of the counters\n", code, counter)
+		return nil
+	}
+
+	fmt.Printf(
+		"%s: the counter %d of %s\n",
+		code,
+		counter,
+		fmt.Sprintf(counterNameTemplate, index),
+	)
+	return nil
+}
+
+func verifyCache(ctx context.Context, dependencies commandDependencies) error {
+	verifier := usecases.CacheVerifier{
+		KeyIterator:     dependencies.cacheGateways.keyIterator,
+		CacheLinkGetter: dependencies.cacheGateways.rawLinkGetter,
+		CodeLinkGetter:  dependencies.storageGateways.linkByCodeGetter,
+		URLLinkGetter:   dependencies.storageGateways.linkByURLGetter,
+	}
+
+	var count int
+	err := verifier.VerifyCache(ctx, func(
+		inconsistency usecases.CacheInconsistency,
+	) error {
+		count++
+
+		fmt.Printf("key %q: %s\n", inconsistency.Key, inconsistency.Reason)
+		if inconsistency.CachedLink != nil {
+			fmt.Printf("  cached: %s\n", formatLink(*inconsistency.CachedLink))
+		}
+		if inconsistency.StoredLink != nil {
+			fmt.Printf("  stored: %s\n", formatLink(*inconsistency.StoredLink))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to verify the cache")
+	}
+	if count != 0 {
+		return errors.Errorf("%d inconsistencies are found", count)
+	}
+
+	fmt.Println("no inconsistencies are found")
+	return nil
+}
+
+func formatLink(link entities.Link) string {
+	data, err := json.Marshal(link)
+	if err != nil {
+		return fmt.Sprintf("unable to marshal the link: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"time"
+
+	"github.com/go-log/log"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
+)
+
+type breakerFactory func(name string) *breakers.CircuitBreaker
+
+func newBreakerFactory(
+	failureThreshold int,
+	openTimeout time.Duration,
+	probeCount int,
+	observer breakers.Observer,
+	logger log.Logger,
+) breakerFactory {
+	return func(name string) *breakers.CircuitBreaker {
+		return breakers.NewCircuitBreaker(
+			name,
+			breakers.WithFailureThreshold(failureThreshold),
+			breakers.WithOpenTimeout(openTimeout),
+			breakers.WithProbeCount(probeCount),
+			breakers.WithObserver(observer),
+			breakers.WithLogger(logger),
+		)
+	}
+}
+
+func breakStorageGateways(
+	gateways storageGatewaySet,
+	breaker *breakers.CircuitBreaker,
+) storageGatewaySet {
+	// only the gateways used on serving of links are broken, the rest ones
+	// are used rarely, so their failures are reported as is
+	gateways.linkByCodeGetter = breakers.BreakingLinkGetter{
+		LinkGetter: gateways.linkByCodeGetter,
+		Breaker:    breaker,
+	}
+	gateways.linkByURLGetter = breakers.BreakingLinkGetter{
+		LinkGetter: gateways.linkByURLGetter,
+		Breaker:    breaker,
+	}
+	gateways.linkSetter = breakers.BreakingLinkSetter{
+		LinkSetter: gateways.linkSetter,
+		Breaker:    breaker,
+	}
+
+	return gateways
+}
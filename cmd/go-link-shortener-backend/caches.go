@@ -0,0 +1,151 @@
+package main
+
+import (
+	"time"
+
+	"github.com/go-log/log"
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/cache"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/metrics"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/timeouts"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
+)
+
+type cacheGatewaySet struct {
+	linkGetter  usecases.LinkGetter
+	linkSetter  usecases.LinkSetter
+	linkDeleter usecases.LinkDeleter
+	linkUpdater usecases.LinkUpdater
+	// they are used for inspecting of the cache, so they aren't silent
+	rawLinkGetter usecases.LinkGetter
+	keyIterator   usecases.KeyIterator
+}
+
+func newCacheGateways(
+	driver string,
+	address string,
+	codeTTL time.Duration,
+	urlTTL time.Duration,
+	timeout time.Duration,
+	newBreaker breakerFactory,
+	cacheMetrics metrics.CacheMetrics,
+	logger log.Logger,
+) (cacheGatewaySet, error) {
+	switch driver {
+	case "redis":
+		return newRedisGateways(
+			address,
+			codeTTL,
+			urlTTL,
+			timeout,
+			newBreaker(driver),
+			cacheMetrics,
+			logger,
+		), nil
+	case "none":
+		// empty groups never find, set, delete or update anything
+		return cacheGatewaySet{
+			linkGetter:  usecases.LinkGetterGroup{},
+			linkSetter:  usecases.LinkSetterGroup{},
+			linkDeleter: usecases.LinkDeleterGroup{},
+			linkUpdater: usecases.LinkUpdaterGroup{},
+
+			rawLinkGetter: usecases.LinkGetterGroup{},
+			keyIterator:   usecases.KeyIteratorGroup{},
+		}, nil
+	default:
+		return cacheGatewaySet{}, errors.Errorf("unknown cache driver %q", driver)
+	}
+}
+
+func newRedisGateways(
+	address string,
+	codeTTL time.Duration,
+	urlTTL time.Duration,
+	timeout time.Duration,
+	breaker *breakers.CircuitBreaker,
+	cacheMetrics metrics.CacheMetrics,
+	logger log.Logger,
+) cacheGatewaySet {
+	client := cache.NewClient(address)
+	codeKeyExtractor := func(link entities.Link) string { return link.Code }
+	urlKeyExtractor := func(link entities.Link) string { return link.URL }
+	// the timeouts and the breaker are inside the silent wrappers, so a timed
+	// out cache or the open circuit behaves as a missed one; the counting
+	// is inside them too, so it still sees errors of the cache
+	linkGetter := timeouts.TimeLimitedLinkGetter{
+		LinkGetter: cache.LinkGetter{Client: client},
+		Timeout:    timeout,
+	}
+	return cacheGatewaySet{
+		linkGetter: usecases.SilentLinkGetter{
+			LinkGetter: metrics.CountingLinkGetter{
+				LinkGetter: breakers.BreakingLinkGetter{
+					LinkGetter: linkGetter,
+					Breaker:    breaker,
+				},
+				Metrics: cacheMetrics,
+			},
+			Logger: logger,
+		},
+		linkSetter: usecases.LinkSetterGroup{
+			usecases.SilentLinkSetter{
+				LinkSetter: breakers.BreakingLinkSetter{
+					LinkSetter: timeouts.TimeLimitedLinkSetter{
+						LinkSetter: cache.LinkSetter{
+							KeyExtractor: codeKeyExtractor,
+							Client:       client,
+							Expiration:   codeTTL,
+						},
+						Timeout: timeout,
+					},
+					Breaker: breaker,
+				},
+				Logger: logger,
+			},
+			usecases.SilentLinkSetter{
+				LinkSetter: breakers.BreakingLinkSetter{
+					LinkSetter: timeouts.TimeLimitedLinkSetter{
+						LinkSetter: cache.LinkSetter{
+							KeyExtractor: urlKeyExtractor,
+							Client:       client,
+							Expiration:   urlTTL,
+						},
+						Timeout: timeout,
+					},
+					Breaker: breaker,
+				},
+				Logger: logger,
+			},
+		},
+		// the invalidation isn't silent: otherwise, the cache would serve
+		// a deleted or disabled link until its expiration; it goes before
+		// changing of the storage, so a failed request changes nothing and may
+		// be retried, and the breaker fails it fast during an outage
+		linkDeleter: breakers.BreakingLinkDeleter{
+			LinkDeleter: timeouts.TimeLimitedLinkDeleter{
+				LinkDeleter: usecases.LinkDeleterGroup{
+					cache.LinkDeleter{KeyExtractor: codeKeyExtractor, Client: client},
+					cache.LinkDeleter{KeyExtractor: urlKeyExtractor, Client: client},
+				},
+				Timeout: timeout,
+			},
+			Breaker: breaker,
+		},
+		linkUpdater: breakers.BreakingLinkUpdater{
+			LinkUpdater: timeouts.TimeLimitedLinkUpdater{
+				LinkUpdater: usecases.LinkUpdaterGroup{
+					cache.LinkUpdater{KeyExtractor: codeKeyExtractor, Client: client},
+					cache.LinkUpdater{KeyExtractor: urlKeyExtractor, Client: client},
+				},
+				Timeout: timeout,
+			},
+			Breaker: breaker,
+		},
+
+		rawLinkGetter: linkGetter,
+		keyIterator:   cache.KeyIterator{Client: client},
+	}
+}
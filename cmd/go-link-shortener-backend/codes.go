@@ -0,0 +1,44 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/formatters"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/permutations"
+)
+
+type codeFormatterSet struct {
+	formatter func(code uint64) string
+	parser    formatters.Parser
+}
+
+func newCodeFormatters(
+	permutationKey string,
+	counterCount int,
+	counterRange uint64,
+) (codeFormatterSet, error) {
+	if permutationKey == "" {
+		return codeFormatterSet{
+			formatter: formatters.InBase62,
+			parser:    formatters.FromBase62,
+		}, nil
+	}
+
+	// the permutation covers the ranges of all the counters, so the codes
+	// don't become longer than the largest sequential one
+	permutation, err := permutations.NewFeistel(
+		[]byte(permutationKey),
+		uint64(counterCount)*counterRange,
+	)
+	if err != nil {
+		return codeFormatterSet{},
+			errors.Wrap(err, "unable to create the permutation")
+	}
+
+	return codeFormatterSet{
+		formatter: formatters.NewPermuted(permutation, formatters.InBase62),
+		parser: formatters.NewPermutedParser(
+			permutation,
+			formatters.FromBase62,
+		),
+	}, nil
+}
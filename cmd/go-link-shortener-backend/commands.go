@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+
+	"github.com/go-log/log"
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases"
+)
+
+type commandDependencies struct {
+	storageGateways storageGatewaySet
+	cacheGateways   cacheGatewaySet
+	newCounter      counterFactory
+	counterCount    int
+	counterChunk    uint64
+	counterRange    uint64
+	codeFormatters  codeFormatterSet
+	urlNormalizer   usecases.URLNormalizer
+	logger          log.Logger
+}
+
+func runCommand(
+	ctx context.Context,
+	command string,
+	arguments []string,
+	dependencies commandDependencies,
+) error {
+	switch command {
+	case "import":
+		return runImportCommand(ctx, arguments, dependencies)
+	case "export":
+		return runExportCommand(ctx, arguments, dependencies)
+	case "admin":
+		return runAdminCommand(ctx, arguments, dependencies)
+	default:
+		return errors.Errorf("unknown command %q", command)
+	}
+}
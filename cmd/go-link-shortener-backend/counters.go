@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/boltstorage"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/counter"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/memory"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/metrics"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/timeouts"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/tracing"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/counters"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/counters/transformers"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/retries"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// it's implemented by the counters of all the drivers, but not by their
+// decorators, so the admin commands peek the counters made by the factory
+type peekableCounter interface {
+	counters.DistributedCounter
+
+	PeekCountChunk(ctx context.Context) (uint64, error)
+}
+
+type counterFactory func(name string) peekableCounter
+
+func newDistributedCounters(
+	factory counterFactory,
+	driver string,
+	count int,
+	chunk uint64,
+	rangeSize uint64,
+	timeout time.Duration,
+	newBreaker breakerFactory,
+	retrier retries.Retrier,
+	operationMetrics metrics.OperationMetrics,
+	tracer trace.Tracer,
+) []counters.DistributedCounter {
+	var distributedCounters []counters.DistributedCounter
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf(counterNameTemplate, i)
+		var distributedCounter counters.DistributedCounter = factory(name)
+		// only the etcd counters are remote, so only their operations
+		// are observed
+		if driver == "etcd" {
+			distributedCounter = metrics.ObservedCounter{
+				DistributedCounter: distributedCounter,
+				Metrics:            operationMetrics,
+				Database:           metrics.EtcdDatabase,
+			}
+		}
+
+		distributedCounters = append(distributedCounters, counters.TransformedCounter{
+			// the retrier is outside the breaker, so retries stop on opening
+			// of the circuit; each counter has its own breaker, because they
+			// fail independently
+			DistributedCounter: retries.RetryingCounter{
+				DistributedCounter: breakers.BreakingCounter{
+					DistributedCounter: tracing.TracedCounter{
+						DistributedCounter: timeouts.TimeLimitedCounter{
+							DistributedCounter: distributedCounter,
+							Timeout:            timeout,
+						},
+						Tracer:      tracer,
+						Name:        driver,
+						CounterName: name,
+					},
+					Breaker: newBreaker(name),
+				},
+				Retrier: retrier,
+			},
+			Transformer: newCounterTransformer(i, chunk, rangeSize),
+		})
+	}
+
+	return distributedCounters
+}
+
+func newCounterTransformer(
+	index int,
+	chunk uint64,
+	rangeSize uint64,
+) counters.Transformer {
+	return transformers.NewLinear(
+		transformers.WithFactor(chunk),
+		transformers.WithOffset(uint64(index)*rangeSize),
+	)
+}
+
+func newCounterFactory(
+	driver string,
+	address string,
+	boltClient boltstorage.Client,
+) (counterFactory, error) {
+	switch driver {
+	case "etcd":
+		client, err := counter.NewClient(address)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create the counter client")
+		}
+
+		return func(name string) peekableCounter {
+			return counter.Counter{Client: client, Name: name}
+		}, nil
+	case "memory":
+		client := memory.NewClient()
+		return func(name string) peekableCounter {
+			return memory.Counter{Client: client, Name: name}
+		}, nil
+	case "bolt":
+		return func(name string) peekableCounter {
+			return boltstorage.Counter{Client: boltClient, Name: name}
+		}, nil
+	default:
+		return nil, errors.Errorf("unknown counter driver %q", driver)
+	}
+}
+
+func newCounterStrategy(
+	name string,
+	count int,
+	weights []int,
+	exclusionTime time.Duration,
+) (counters.Strategy, error) {
+	// the global random source is used, because strategies may be called
+	// concurrently, and only it is safe for that
+	switch name {
+	case "random":
+		return counters.RandomSource(rand.Intn), nil // nolint: gosec
+	case "round-robin":
+		return new(counters.RoundRobinStrategy), nil
+	case "weighted":
+		if len(weights) != count {
+			return nil, errors.Errorf(
+				"count of weights %d doesn't match count of counters %d",
+				len(weights),
+				count,
+			)
+		}
+
+		strategy, err :=
+			counters.NewWeightedStrategy(weights, rand.Intn) // nolint: gosec
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create the weighted strategy")
+		}
+
+		return strategy, nil
+	case "least-recently-failed":
+		return counters.NewLeastRecentlyFailedStrategy(
+			exclusionTime,
+			rand.Intn, // nolint: gosec
+		), nil
+	default:
+		return nil, errors.Errorf("unknown counter strategy %q", name)
+	}
+}
@@ -3,28 +3,31 @@ package main
 // nolint: lll
 import (
 	"context"
-	"fmt"
 	"log"
 	"math/rand"
 	"net/http"
 	"os"
 	"time"
 
-	"github.com/caarlos0/env"
+	"github.com/caarlos0/env/v6"
 	"github.com/go-log/log/print"
 	middlewares "github.com/gorilla/handlers"
+	"github.com/prometheus/client_golang/prometheus"
 	httputils "github.com/thewizardplusplus/go-http-utils"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
-	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/cache"
-	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/counter"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/blocklist"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/geoip"
 	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/handlers"
 	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/handlers/presenters"
-	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/storage"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/tracing"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/visitors"
 	"github.com/thewizardplusplus/go-link-shortener-backend/usecases"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/checkers"
 	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators"
 	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/counters"
-	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/counters/transformers"
-	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/formatters"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/normalizers"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/retries"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 type options struct {
@@ -33,21 +36,91 @@ type options struct {
 		Address    string `env:"SERVER_ADDRESS" envDefault:":8080"`
 		StaticPath string `env:"SERVER_STATIC_PATH" envDefault:"./static"`
 	}
+	Metrics struct {
+		Address string `env:"METRICS_ADDRESS" envDefault:":8081"`
+	}
+	Redirect struct {
+		Code   int           `env:"REDIRECT_CODE" envDefault:"301"`
+		MaxAge time.Duration `env:"REDIRECT_MAX_AGE" envDefault:"24h"`
+	}
+	Variant struct {
+		CookieMaxAge time.Duration `env:"VARIANT_COOKIE_MAX_AGE" envDefault:"720h"`
+	}
 	Cache struct {
-		Address string `env:"CACHE_ADDRESS" envDefault:"localhost:6379"`
+		Driver  string        `env:"CACHE_DRIVER"`
+		Address string        `env:"CACHE_ADDRESS" envDefault:"localhost:6379"`
+		Timeout time.Duration `env:"CACHE_TIMEOUT" envDefault:"500ms"`
 		TTL     struct {
 			Code time.Duration `env:"CACHE_TTL_CODE" envDefault:"1h"`
 			URL  time.Duration `env:"CACHE_TTL_URL" envDefault:"1h"`
 		}
 	}
 	Storage struct {
-		Address string `env:"STORAGE_ADDRESS" envDefault:"mongodb://localhost:27017"`
+		Driver  string        `env:"STORAGE_DRIVER"`
+		Address string        `env:"STORAGE_ADDRESS" envDefault:"mongodb://localhost:27017"`
+		Path    string        `env:"STORAGE_PATH"`
+		Timeout time.Duration `env:"STORAGE_TIMEOUT" envDefault:"5s"`
+	}
+	URL struct {
+		AllowedSchemes     []string `env:"URL_ALLOWED_SCHEMES" envDefault:"http,https"`
+		TrackingParameters []string `env:"URL_TRACKING_PARAMETERS"`
+		OwnHosts           []string `env:"URL_OWN_HOSTS"`
+		ShortenerHosts     []string `env:"URL_SHORTENER_HOSTS" envDefault:"bit.ly,buff.ly,cutt.ly,goo.gl,is.gd,ow.ly,rebrand.ly,t.co,tinyurl.com"`
+		AllowPrivate       bool     `env:"URL_ALLOW_PRIVATE_ADDRESSES"`
+	}
+	Blocklist struct {
+		Path          string        `env:"BLOCKLIST_PATH"`
+		CheckInterval time.Duration `env:"BLOCKLIST_CHECK_INTERVAL" envDefault:"10s"`
+	}
+	GeoIP struct {
+		Path string `env:"GEOIP_DATABASE_PATH"`
+	}
+	Code struct {
+		Alias struct {
+			Alphabet      string   `env:"CODE_ALIAS_ALPHABET" envDefault:"0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-_"`
+			MinimalLength int      `env:"CODE_ALIAS_MINIMAL_LENGTH" envDefault:"3"`
+			MaximalLength int      `env:"CODE_ALIAS_MAXIMAL_LENGTH" envDefault:"64"`
+			ReservedCodes []string `env:"CODE_ALIAS_RESERVED_CODES" envDefault:"api,error,redirect,static"`
+		}
+		PermutationKey string `env:"CODE_PERMUTATION_KEY"`
+	}
+	Bulk struct {
+		MaximalCount int `env:"BULK_MAXIMAL_COUNT" envDefault:"100"`
+	}
+	Click struct {
+		BufferSize    int           `env:"CLICK_BUFFER_SIZE" envDefault:"1000"`
+		BatchSize     int           `env:"CLICK_BATCH_SIZE" envDefault:"100"`
+		FlushInterval time.Duration `env:"CLICK_FLUSH_INTERVAL" envDefault:"1s"`
 	}
 	Counter struct {
-		Address string `env:"COUNTER_ADDRESS" envDefault:"localhost:2379"`
-		Count   int    `env:"COUNTER_COUNT" envDefault:"2"`
-		Chunk   uint64 `env:"COUNTER_CHUNK" envDefault:"1000"`
-		Range   uint64 `env:"COUNTER_RANGE" envDefault:"1000000000"`
+		Driver   string        `env:"COUNTER_DRIVER"`
+		Address  string        `env:"COUNTER_ADDRESS" envDefault:"localhost:2379"`
+		Count    int           `env:"COUNTER_COUNT" envDefault:"2"`
+		Chunk    uint64        `env:"COUNTER_CHUNK" envDefault:"1000"`
+		Range    uint64        `env:"COUNTER_RANGE" envDefault:"1000000000"`
+		Timeout  time.Duration `env:"COUNTER_TIMEOUT" envDefault:"5s"`
+		Failover bool          `env:"COUNTER_FAILOVER" envDefault:"true"`
+		Prefetch uint64        `env:"COUNTER_PREFETCH_WATERMARK" envDefault:"100"`
+		Strategy struct {
+			Name          string        `env:"COUNTER_STRATEGY" envDefault:"random"`
+			Weights       []int         `env:"COUNTER_WEIGHTS"`
+			ExclusionTime time.Duration `env:"COUNTER_EXCLUSION_TIME" envDefault:"10s"`
+		}
+	}
+	Breaker struct {
+		FailureThreshold int           `env:"BREAKER_FAILURE_THRESHOLD" envDefault:"5"`
+		OpenTimeout      time.Duration `env:"BREAKER_OPEN_TIMEOUT" envDefault:"10s"`
+		ProbeCount       int           `env:"BREAKER_PROBE_COUNT" envDefault:"1"`
+	}
+	Retry struct {
+		MaxAttempts  int           `env:"RETRY_MAX_ATTEMPTS" envDefault:"3"`
+		InitialDelay time.Duration `env:"RETRY_INITIAL_DELAY" envDefault:"50ms"`
+		MaxDelay     time.Duration `env:"RETRY_MAX_DELAY" envDefault:"1s"`
+	}
+	Tracing struct {
+		Endpoint      string  `env:"TRACING_ENDPOINT"`
+		ServiceName   string  `env:"TRACING_SERVICE_NAME" envDefault:"go-link-shortener-backend"`
+		SamplingRatio float64 `env:"TRACING_SAMPLING_RATIO" envDefault:"1"`
 	}
 }
 
@@ -56,60 +129,234 @@ const (
 	redirectEndpointPrefix = "/redirect"
 	storageDatabase        = "go-link-shortener"
 	storageCollection      = "links"
+	clickCollection        = "clicks"
 	counterNameTemplate    = "root/distributed_counter_%d"
+	useCaseSpanPrefix      = "usecases"
 )
 
 func main() {
 	errorLogger := log.New(os.Stderr, "", log.LstdFlags|log.Lmicroseconds)
 	errorPrinter := print.New(errorLogger)
+	// the global random source is used for selecting of link variants
+	// and of distributed counters
+	rand.Seed(time.Now().UnixNano())
 
 	var options options // nolint: vetshadow
 	if err := env.Parse(&options); err != nil {
 		errorLogger.Fatalf("error with parsing options: %v", err)
 	}
+	if !entities.IsRedirectCode(options.Redirect.Code) {
+		errorLogger.Fatalf("unsupported redirect code %d", options.Redirect.Code)
+	}
 
-	cacheClient := cache.NewClient(options.Cache.Address)
-	cacheGetter := usecases.SilentLinkGetter{
-		LinkGetter: cache.LinkGetter{Client: cacheClient},
-		Logger:     errorPrinter,
+	drivers, err := resolveDrivers(options.Storage.Path, driverSet{
+		storage: options.Storage.Driver,
+		counter: options.Counter.Driver,
+		cache:   options.Cache.Driver,
+	})
+	if err != nil {
+		errorLogger.Fatalf("error with resolving the drivers: %v", err)
 	}
+	options.Storage.Driver = drivers.storage
+	options.Counter.Driver = drivers.counter
+	options.Cache.Driver = drivers.cache
 
-	storageClient, err :=
-		storage.NewClient(options.Storage.Address, storageDatabase, storageCollection)
+	serviceMetrics, err := newMetrics(prometheus.DefaultRegisterer)
 	if err != nil {
-		errorLogger.Fatalf("error with creating the storage client: %v", err)
+		errorLogger.Fatalf("error with creating the metrics: %v", err)
 	}
 
-	counterClient, err := counter.NewClient(options.Counter.Address)
+	tracerProvider, err := newTracerProvider(
+		options.Tracing.Endpoint,
+		options.Tracing.ServiceName,
+		options.Tracing.SamplingRatio,
+	)
 	if err != nil {
-		errorLogger.Fatalf("error with creating the counter client: %v", err)
+		errorLogger.Fatalf("error with creating the tracing: %v", err)
 	}
+	tracer := tracerProvider.Tracer(tracing.InstrumentationName)
 
-	var distributedCounters []counters.DistributedCounter
-	for i := 0; i < options.Counter.Count; i++ {
-		distributedCounters = append(distributedCounters, counters.TransformedCounter{
-			DistributedCounter: counter.Counter{
-				Client: counterClient,
-				Name:   fmt.Sprintf(counterNameTemplate, i),
-			},
-			Transformer: transformers.NewLinear(
-				transformers.WithFactor(options.Counter.Chunk),
-				transformers.WithOffset(uint64(i)*options.Counter.Range),
+	newBreaker := newBreakerFactory(
+		options.Breaker.FailureThreshold,
+		options.Breaker.OpenTimeout,
+		options.Breaker.ProbeCount,
+		serviceMetrics.breakerMetrics,
+		errorPrinter,
+	)
+	retrier := retries.NewRetrier(
+		retries.WithMaxAttempts(options.Retry.MaxAttempts),
+		retries.WithInitialDelay(options.Retry.InitialDelay),
+		retries.WithMaxDelay(options.Retry.MaxDelay),
+	)
+
+	cacheGateways, err := newCacheGateways(
+		options.Cache.Driver,
+		options.Cache.Address,
+		options.Cache.TTL.Code,
+		options.Cache.TTL.URL,
+		options.Cache.Timeout,
+		newBreaker,
+		serviceMetrics.cacheMetrics,
+		errorPrinter,
+	)
+	if err != nil {
+		errorLogger.Fatalf("error with creating the cache gateways: %v", err)
+	}
+	cacheGateways =
+		traceCacheGateways(cacheGateways, tracer, options.Cache.Driver)
+
+	// the Bolt database is locked exclusively while it's open,
+	// so its client is shared by the storage and the counters
+	boltClient, err := newBoltClient(
+		options.Storage.Path,
+		options.Storage.Driver,
+		options.Counter.Driver,
+	)
+	if err != nil {
+		errorLogger.Fatalf("error with creating the Bolt client: %v", err)
+	}
+	// the Bolt database should be closed on any exit after its opening
+	fatalf := func(format string, arguments ...interface{}) {
+		closeBoltClient(boltClient, errorPrinter)
+		errorLogger.Fatalf(format, arguments...)
+	}
+
+	storageGateways, err := newStorageGateways(
+		options.Storage.Driver,
+		options.Storage.Address,
+		boltClient,
+		serviceMetrics.operationMetrics,
+	)
+	if err != nil {
+		fatalf("error with creating the storage gateways: %v", err)
+	}
+	storageGateways = traceStorageGateways(
+		retryStorageGateways(
+			breakStorageGateways(
+				limitStorageGateways(storageGateways, options.Storage.Timeout),
+				newBreaker(options.Storage.Driver),
 			),
-		})
+			retrier,
+		),
+		tracer,
+		options.Storage.Driver,
+	)
+
+	counterFactory, err := newCounterFactory(
+		options.Counter.Driver,
+		options.Counter.Address,
+		boltClient,
+	)
+	if err != nil {
+		fatalf("error with creating the counter factory: %v", err)
+	}
+	distributedCounters := newDistributedCounters(
+		counterFactory,
+		options.Counter.Driver,
+		options.Counter.Count,
+		options.Counter.Chunk,
+		options.Counter.Range,
+		options.Counter.Timeout,
+		newBreaker,
+		retrier,
+		serviceMetrics.operationMetrics,
+		tracer,
+	)
+	codeFormatters, err := newCodeFormatters(
+		options.Code.PermutationKey,
+		options.Counter.Count,
+		options.Counter.Range,
+	)
+	if err != nil {
+		fatalf("error with creating the code formatters: %v", err)
+	}
+	counterStrategy, err := newCounterStrategy(
+		options.Counter.Strategy.Name,
+		options.Counter.Count,
+		options.Counter.Strategy.Weights,
+		options.Counter.Strategy.ExclusionTime,
+	)
+	if err != nil {
+		fatalf("error with creating the counter strategy: %v", err)
+	}
+
+	urlNormalizer := normalizers.URLNormalizer{
+		AllowedSchemes:     options.URL.AllowedSchemes,
+		TrackingParameters: options.URL.TrackingParameters,
+	}
+
+	// the server isn't started, if a command is specified
+	if len(os.Args) > 1 {
+		command := os.Args[1]
+		dependencies := commandDependencies{
+			storageGateways: storageGateways,
+			cacheGateways:   cacheGateways,
+			newCounter:      counterFactory,
+			counterCount:    options.Counter.Count,
+			counterChunk:    options.Counter.Chunk,
+			counterRange:    options.Counter.Range,
+			codeFormatters:  codeFormatters,
+			urlNormalizer:   urlNormalizer,
+			logger:          errorPrinter,
+		}
+		ctx, span := tracer.Start(context.Background(), "command "+command)
+		err = runCommand(ctx, command, os.Args[2:], dependencies)
+		span.End()
+
+		tracerProvider.Shutdown(errorPrinter)
+		if err != nil {
+			fatalf("error with running the %q command: %v", command, err)
+		}
+
+		closeBoltClient(boltClient, errorPrinter)
+		return
+	}
+
+	clickRecorder := usecases.NewBufferedClickRecorder(
+		storageGateways.clickSetter,
+		options.Click.BufferSize,
+		options.Click.BatchSize,
+		options.Click.FlushInterval,
+		errorPrinter,
+	)
+	go clickRecorder.Run()
+
+	urlBlocklist, err := blocklist.NewBlocklist(
+		options.Blocklist.Path,
+		options.Blocklist.CheckInterval,
+		errorPrinter,
+	)
+	if err != nil {
+		fatalf("error with creating the blocklist: %v", err)
+	}
+	go urlBlocklist.Run()
+
+	geoIPDatabase, err := geoip.NewDatabase(options.GeoIP.Path)
+	if err != nil {
+		fatalf("error with opening the GeoIP database: %v", err)
 	}
 
 	linkByCodeGetter := usecases.LinkGetterGroup{
-		cacheGetter,
-		storage.LinkGetter{
-			Client:   storageClient,
-			KeyField: storage.CodeLinkField,
-		},
+		cacheGateways.linkGetter,
+		storageGateways.linkByCodeGetter,
+	}
+
+	// the cache goes first, because the cache is populated only on link
+	// creating; so, after invalidation, it can't get stale data again
+	linkDeleter := usecases.LinkDeleterGroup{
+		cacheGateways.linkDeleter,
+		storageGateways.linkDeleter,
+	}
+	linkUpdater := usecases.LinkUpdaterGroup{
+		cacheGateways.linkUpdater,
+		storageGateways.linkUpdater,
 	}
 
 	redirectPresenter := presenters.RedirectPresenter{
-		ErrorURL: errorURL,
-		Logger:   errorPrinter,
+		ErrorURL:     errorURL,
+		RedirectCode: options.Redirect.Code,
+		MaxAge:       options.Redirect.MaxAge,
+		Logger:       errorPrinter,
 	}
 	jsonLinkPresenter := presenters.SilentLinkPresenter{
 		LinkPresenter: presenters.JSONPresenter{ServerID: options.Server.ID},
@@ -120,12 +367,84 @@ func main() {
 		Logger:         errorPrinter,
 	}
 
+	codeGenerator := tracing.TracedCodeGenerator{
+		CodeGenerator: generators.NewDistributedGenerator(
+			options.Counter.Chunk,
+			counters.CounterGroup{
+				DistributedCounters: distributedCounters,
+				Strategy:            counterStrategy,
+				Failover:            options.Counter.Failover,
+			},
+			codeFormatters.formatter,
+			generators.WithObserver(serviceMetrics.generatorMetrics),
+			generators.WithPrefetchWatermark(options.Counter.Prefetch),
+		),
+		Tracer: tracer,
+		Name:   "generator",
+	}
+	ownHosts, err := makeOwnHosts(options.URL.OwnHosts, options.Server.Address)
+	if err != nil {
+		fatalf("error with making the own hosts: %v", err)
+	}
+
+	linkCreator := usecases.LinkCreator{
+		LinkGetter: usecases.LinkGetterGroup{
+			cacheGateways.linkGetter,
+			storageGateways.linkByURLGetter,
+		},
+		// the storage goes first, because it's able to detect code conflicts;
+		// otherwise, the cache would be populated with conflicting links
+		LinkSetter: usecases.LinkSetterGroup{
+			storageGateways.linkSetter,
+			cacheGateways.linkSetter,
+		},
+		URLNormalizer: urlNormalizer,
+		URLChecker: usecases.URLCheckerGroup{
+			checkers.URLChecker{
+				OwnHosts:              ownHosts,
+				RedirectPrefix:        redirectEndpointPrefix,
+				ShortenerHosts:        options.URL.ShortenerHosts,
+				AllowPrivateAddresses: options.URL.AllowPrivate,
+			},
+			urlBlocklist,
+		},
+		CodeChecker: checkers.AliasChecker{
+			Alphabet:      options.Code.Alias.Alphabet,
+			MinimalLength: options.Code.Alias.MinimalLength,
+			MaximalLength: options.Code.Alias.MaximalLength,
+			ReservedCodes: options.Code.Alias.ReservedCodes,
+		},
+		CodeGenerator: codeGenerator,
+	}
+
 	routerHandler := handlers.NewRouter(redirectEndpointPrefix, handlers.Handlers{
 		LinkRedirectHandler: handlers.LinkGettingHandler{
-			LinkGetter: linkByCodeGetter,
+			LinkGetter: tracing.TracedLinkGetter{
+				LinkGetter: usecases.CheckingLinkGetter{
+					LinkGetter: linkByCodeGetter,
+					URLChecker: urlBlocklist,
+				},
+				Tracer: tracer,
+				Name:   useCaseSpanPrefix,
+			},
 			LinkPresenter: presenters.SilentLinkPresenter{
-				LinkPresenter: redirectPresenter,
-				Logger:        errorPrinter,
+				// the recording goes last, so it knows the served variant
+				LinkPresenter: presenters.RuleLinkPresenter{
+					LinkPresenter: presenters.VariantLinkPresenter{
+						LinkPresenter: presenters.RecordingLinkPresenter{
+							LinkPresenter: redirectPresenter,
+							ClickRecorder: clickRecorder,
+						},
+						// the global source is safe for concurrent use
+						RandomSource: rand.Intn, // nolint: gosec
+						CookieMaxAge: options.Variant.CookieMaxAge,
+					},
+					VisitorDetector: visitors.Detector{
+						CountryResolver: geoIPDatabase,
+						Logger:          errorPrinter,
+					},
+				},
+				Logger: errorPrinter,
 			},
 			ErrorPresenter: presenters.SilentErrorPresenter{
 				ErrorPresenter: redirectPresenter,
@@ -133,53 +452,79 @@ func main() {
 			},
 		},
 		LinkGettingHandler: handlers.LinkGettingHandler{
-			LinkGetter:     linkByCodeGetter,
+			LinkGetter: tracing.TracedLinkGetter{
+				LinkGetter: linkByCodeGetter,
+				Tracer:     tracer,
+				Name:       useCaseSpanPrefix,
+			},
 			LinkPresenter:  jsonLinkPresenter,
 			ErrorPresenter: jsonErrorPresenter,
 		},
 		LinkCreatingHandler: handlers.LinkCreatingHandler{
-			LinkCreator: usecases.LinkCreator{
-				LinkGetter: usecases.LinkGetterGroup{
-					cacheGetter,
-					storage.LinkGetter{
-						Client:   storageClient,
-						KeyField: storage.URLLinkField,
+			LinkCreator: tracing.TracedLinkCreator{
+				LinkCreator: linkCreator,
+				Tracer:      tracer,
+				Name:        useCaseSpanPrefix,
+			},
+			LinkPresenter:  jsonLinkPresenter,
+			ErrorPresenter: jsonErrorPresenter,
+		},
+		LinkBulkCreatingHandler: handlers.LinkBulkCreatingHandler{
+			LinkBulkCreator: tracing.TracedLinkBulkCreator{
+				LinkBulkCreator: usecases.BulkLinkCreator{
+					LinkCreator: linkCreator,
+					// see the comment on the link setter of the link creator
+					LinkSetter: usecases.BulkLinkSetterGroup{
+						storageGateways.bulkLinkSetter,
+						usecases.SequentialLinkSetter{LinkSetter: cacheGateways.linkSetter},
 					},
+					// the codes are reserved by the same generator as for single links
+					CodeGenerator: codeGenerator,
+					MaximalCount:  options.Bulk.MaximalCount,
 				},
-				LinkSetter: usecases.LinkSetterGroup{
-					usecases.SilentLinkSetter{
-						LinkSetter: cache.LinkSetter{
-							KeyExtractor: func(link entities.Link) string { return link.Code },
-							Client:       cacheClient,
-							Expiration:   options.Cache.TTL.Code,
-						},
-						Logger: errorPrinter,
-					},
-					usecases.SilentLinkSetter{
-						LinkSetter: cache.LinkSetter{
-							KeyExtractor: func(link entities.Link) string { return link.URL },
-							Client:       cacheClient,
-							Expiration:   options.Cache.TTL.URL,
-						},
-						Logger: errorPrinter,
-					},
-					storage.LinkSetter{
-						Client: storageClient,
-					},
+				Tracer: tracer,
+				Name:   useCaseSpanPrefix,
+			},
+			LinkResultsPresenter: presenters.SilentLinkResultsPresenter{
+				LinkResultsPresenter: presenters.JSONPresenter{
+					ServerID: options.Server.ID,
 				},
-				CodeGenerator: generators.NewDistributedGenerator(
-					options.Counter.Chunk,
-					counters.CounterGroup{
-						DistributedCounters: distributedCounters,
-						// nolint: gosec
-						RandomSource: rand.New(rand.NewSource(time.Now().UnixNano())).Intn,
-					},
-					formatters.InBase62,
-				),
+				Logger: errorPrinter,
+			},
+			ErrorPresenter: jsonErrorPresenter,
+		},
+		LinkDeletingHandler: handlers.LinkDeletingHandler{
+			LinkRemover: tracing.TracedLinkRemover{
+				LinkRemover: usecases.LinkRemover{
+					LinkGetter:  linkByCodeGetter,
+					LinkDeleter: linkDeleter,
+				},
+				Tracer: tracer,
+				Name:   useCaseSpanPrefix,
 			},
 			LinkPresenter:  jsonLinkPresenter,
 			ErrorPresenter: jsonErrorPresenter,
 		},
+		LinkUpdatingHandler: handlers.LinkUpdatingHandler{
+			LinkDisabler: tracing.TracedLinkDisabler{
+				LinkDisabler: usecases.LinkDisabler{
+					LinkGetter:  linkByCodeGetter,
+					LinkUpdater: linkUpdater,
+				},
+				Tracer: tracer,
+				Name:   useCaseSpanPrefix,
+			},
+			LinkPresenter:  jsonLinkPresenter,
+			ErrorPresenter: jsonErrorPresenter,
+		},
+		ClickStatsGettingHandler: handlers.ClickStatsGettingHandler{
+			ClickStatsGetter: storageGateways.clickStatsGetter,
+			ClickStatsPresenter: presenters.SilentClickStatsPresenter{
+				ClickStatsPresenter: presenters.JSONPresenter{},
+				Logger:              errorPrinter,
+			},
+			ErrorPresenter: jsonErrorPresenter,
+		},
 		StaticFileHandler: httputils.StaticAssetHandler(
 			http.Dir(options.Server.StaticPath),
 			errorPrinter,
@@ -191,6 +536,16 @@ func main() {
 		Use(func(next http.Handler) http.Handler {
 			return middlewares.LoggingHandler(os.Stdout, next)
 		})
+	routerHandler.Use(serviceMetrics.httpMetrics.Middleware)
+	routerHandler.Use(tracing.HTTPTracer{
+		Tracer:     tracer,
+		Propagator: propagation.TraceContext{},
+	}.Middleware)
+
+	metricsServer, err := startMetricsServer(options.Metrics.Address, errorPrinter)
+	if err != nil {
+		fatalf("error with starting the metrics server: %v", err)
+	}
 
 	server := &http.Server{
 		Addr:    options.Server.Address,
@@ -198,6 +553,18 @@ func main() {
 	}
 	ok :=
 		httputils.RunServer(context.Background(), server, errorPrinter, os.Interrupt)
+	stopMetricsServer(metricsServer, errorPrinter)
+	// the server is already stopped, so the clicks of its requests are buffered;
+	// the ones recorded by requests that outlived the shutdown are dropped
+	clickRecorder.Stop()
+	urlBlocklist.Stop()
+	if err := geoIPDatabase.Close(); err != nil {
+		errorPrinter.Logf("error with closing the GeoIP database: %v", err)
+	}
+	tracerProvider.Shutdown(errorPrinter)
+	// the click recorder is already stopped, so the storage isn't used anymore
+	closeBoltClient(boltClient, errorPrinter)
+
 	if !ok {
 		os.Exit(1)
 	}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-log/log"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/metrics"
+)
+
+const metricsShutdownTimeout = 5 * time.Second
+
+type metricSet struct {
+	httpMetrics      metrics.HTTPMetrics
+	cacheMetrics     metrics.CacheMetrics
+	operationMetrics metrics.OperationMetrics
+	generatorMetrics metrics.GeneratorMetrics
+	breakerMetrics   metrics.BreakerMetrics
+}
+
+func newMetrics(registerer prometheus.Registerer) (metricSet, error) {
+	httpMetrics, err := metrics.NewHTTPMetrics(registerer)
+	if err != nil {
+		return metricSet{}, errors.Wrap(err, "unable to create the HTTP metrics")
+	}
+
+	cacheMetrics, err := metrics.NewCacheMetrics(registerer)
+	if err != nil {
+		return metricSet{}, errors.Wrap(err, "unable to create the cache metrics")
+	}
+
+	operationMetrics, err := metrics.NewOperationMetrics(registerer)
+	if err != nil {
+		return metricSet{},
+			errors.Wrap(err, "unable to create the operation metrics")
+	}
+
+	generatorMetrics, err := metrics.NewGeneratorMetrics(registerer)
+	if err != nil {
+		return metricSet{},
+			errors.Wrap(err, "unable to create the generator metrics")
+	}
+
+	breakerMetrics, err := metrics.NewBreakerMetrics(registerer)
+	if err != nil {
+		return metricSet{}, errors.Wrap(err, "unable to create the breaker metrics")
+	}
+
+	return metricSet{
+		httpMetrics:      httpMetrics,
+		cacheMetrics:     cacheMetrics,
+		operationMetrics: operationMetrics,
+		generatorMetrics: generatorMetrics,
+		breakerMetrics:   breakerMetrics,
+	}, nil
+}
+
+// the metrics are served on their own address, so they aren't exposed
+// to clients of the service and don't take a path of custom codes;
+// the empty address disables serving of them
+func startMetricsServer(
+	address string,
+	logger log.Logger,
+) (*http.Server, error) {
+	if address == "" {
+		return nil, nil
+	}
+
+	// the address is listened in advance to report its errors on starting
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to listen the metrics address")
+	}
+
+	router := http.NewServeMux()
+	router.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{Handler: router}
+	go func() {
+		if err := server.Serve(listener); err != http.ErrServerClosed {
+			logger.Logf("error with serving the metrics: %v", err)
+		}
+	}()
+
+	return server, nil
+}
+
+func stopMetricsServer(server *http.Server, logger log.Logger) {
+	if server == nil {
+		return
+	}
+
+	ctx, cancel :=
+		context.WithTimeout(context.Background(), metricsShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		logger.Logf("error with shutting down the metrics server: %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/retries"
+)
+
+func retryStorageGateways(
+	gateways storageGatewaySet,
+	retrier retries.Retrier,
+) storageGatewaySet {
+	// the getters aren't retried, because they are backed by the cache,
+	// and delays of redirects are more noticeable
+	gateways.linkSetter = retries.RetryingLinkSetter{
+		LinkSetter: gateways.linkSetter,
+		Retrier:    retrier,
+	}
+	gateways.bulkLinkSetter = retries.RetryingBulkLinkSetter{
+		BulkLinkSetter: gateways.bulkLinkSetter,
+		Retrier:        retrier,
+	}
+
+	return gateways
+}
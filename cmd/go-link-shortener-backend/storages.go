@@ -0,0 +1,214 @@
+package main
+
+import (
+	"github.com/go-log/log"
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/boltstorage"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/handlers"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/memory"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/metrics"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/sqlstorage"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/storage"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases"
+	mongooptions "go.mongodb.org/mongo-driver/mongo/options"
+
+	// register the PostgreSQL driver for the database/sql package
+	_ "github.com/lib/pq"
+)
+
+type storageGatewaySet struct {
+	linkByCodeGetter usecases.LinkGetter
+	linkByURLGetter  usecases.LinkGetter
+	linkIterator     usecases.LinkIterator
+	linkSetter       usecases.LinkSetter
+	bulkLinkSetter   usecases.BulkLinkSetter
+	linkDeleter      usecases.LinkDeleter
+	linkUpdater      usecases.LinkUpdater
+	clickSetter      usecases.ClickSetter
+	clickStatsGetter handlers.ClickStatsGetter
+}
+
+type driverSet struct {
+	storage string
+	counter string
+	cache   string
+}
+
+// a specified path of the Bolt database selects it by default for both
+// the storage and the counters, so the single option is enough for running
+// without any external services
+func resolveDrivers(boltPath string, drivers driverSet) (driverSet, error) {
+	defaultDrivers :=
+		driverSet{storage: "mongodb", counter: "etcd", cache: "redis"}
+	if boltPath != "" {
+		defaultDrivers = driverSet{storage: "bolt", counter: "bolt", cache: "none"}
+	}
+	if drivers.storage == "" {
+		drivers.storage = defaultDrivers.storage
+	}
+	if drivers.counter == "" {
+		drivers.counter = defaultDrivers.counter
+	}
+	if drivers.cache == "" {
+		drivers.cache = defaultDrivers.cache
+	}
+
+	usesBolt := drivers.storage == "bolt" || drivers.counter == "bolt"
+	switch {
+	case usesBolt && boltPath == "":
+		return driverSet{}, errors.New("the bolt driver requires the Bolt path")
+	case !usesBolt && boltPath != "":
+		return driverSet{},
+			errors.New("the Bolt path is specified, but no driver uses it")
+	}
+
+	return drivers, nil
+}
+
+func newBoltClient(path string, drivers ...string) (boltstorage.Client, error) {
+	for _, driver := range drivers {
+		if driver == "bolt" {
+			return boltstorage.NewClient(path)
+		}
+	}
+
+	return boltstorage.Client{}, nil
+}
+
+func closeBoltClient(client boltstorage.Client, logger log.Logger) {
+	if err := client.Close(); err != nil {
+		logger.Logf("error with closing the Bolt client: %v", err)
+	}
+}
+
+func newStorageGateways(
+	driver string,
+	address string,
+	boltClient boltstorage.Client,
+	operationMetrics metrics.OperationMetrics,
+) (storageGatewaySet, error) {
+	switch driver {
+	case "mongodb":
+		return newMongoDBGateways(address, operationMetrics)
+	case "postgres":
+		return newSQLGateways(driver, address)
+	case "memory":
+		return newMemoryGateways(), nil
+	case "bolt":
+		return newBoltGateways(boltClient), nil
+	default:
+		return storageGatewaySet{}, errors.Errorf("unknown storage driver %q", driver)
+	}
+}
+
+func newMongoDBGateways(
+	address string,
+	operationMetrics metrics.OperationMetrics,
+) (storageGatewaySet, error) {
+	client, err := storage.NewClient(
+		address,
+		storageDatabase,
+		storageCollection,
+		mongooptions.Client().SetMonitor(operationMetrics.MongoDBMonitor()),
+	)
+	if err != nil {
+		return storageGatewaySet{},
+			errors.Wrap(err, "unable to create the storage client")
+	}
+
+	clickClient, err := storage.NewClickClient(client, clickCollection)
+	if err != nil {
+		return storageGatewaySet{},
+			errors.Wrap(err, "unable to create the click client")
+	}
+
+	// the MongoDB link setter supports bulk operations natively
+	linkSetter := storage.LinkSetter{Client: client}
+	return storageGatewaySet{
+		linkByCodeGetter: storage.LinkGetter{
+			Client:   client,
+			KeyField: storage.CodeLinkField,
+		},
+		linkByURLGetter: storage.LinkGetter{
+			Client:   client,
+			KeyField: storage.URLLinkField,
+		},
+		linkIterator:     storage.LinkIterator{Client: client},
+		linkSetter:       linkSetter,
+		bulkLinkSetter:   linkSetter,
+		linkDeleter:      storage.LinkDeleter{Client: client},
+		linkUpdater:      storage.LinkUpdater{Client: client},
+		clickSetter:      storage.ClickSetter{Client: clickClient},
+		clickStatsGetter: storage.ClickStatsGetter{Client: clickClient},
+	}, nil
+}
+
+func newSQLGateways(driver string, address string) (storageGatewaySet, error) {
+	client, err := sqlstorage.NewClient(driver, address)
+	if err != nil {
+		return storageGatewaySet{},
+			errors.Wrap(err, "unable to create the SQL storage client")
+	}
+
+	linkSetter := sqlstorage.LinkSetter{Client: client}
+	return storageGatewaySet{
+		linkByCodeGetter: sqlstorage.LinkGetter{
+			Client:   client,
+			KeyField: sqlstorage.CodeLinkField,
+		},
+		linkByURLGetter: sqlstorage.LinkGetter{
+			Client:   client,
+			KeyField: sqlstorage.URLLinkField,
+		},
+		linkIterator:     sqlstorage.LinkIterator{Client: client},
+		linkSetter:       linkSetter,
+		bulkLinkSetter:   usecases.SequentialLinkSetter{LinkSetter: linkSetter},
+		linkDeleter:      sqlstorage.LinkDeleter{Client: client},
+		linkUpdater:      sqlstorage.LinkUpdater{Client: client},
+		clickSetter:      sqlstorage.ClickSetter{Client: client},
+		clickStatsGetter: sqlstorage.ClickStatsGetter{Client: client},
+	}, nil
+}
+
+func newMemoryGateways() storageGatewaySet {
+	client := memory.NewClient()
+	linkSetter := memory.LinkSetter{Client: client}
+	return storageGatewaySet{
+		linkByCodeGetter: memory.LinkGetter{
+			Client:   client,
+			KeyField: memory.CodeLinkField,
+		},
+		linkByURLGetter: memory.LinkGetter{
+			Client:   client,
+			KeyField: memory.URLLinkField,
+		},
+		linkIterator:     memory.LinkIterator{Client: client},
+		linkSetter:       linkSetter,
+		bulkLinkSetter:   usecases.SequentialLinkSetter{LinkSetter: linkSetter},
+		linkDeleter:      memory.LinkDeleter{Client: client},
+		linkUpdater:      memory.LinkUpdater{Client: client},
+		clickSetter:      memory.ClickSetter{Client: client},
+		clickStatsGetter: memory.ClickStatsGetter{Client: client},
+	}
+}
+
+func newBoltGateways(client boltstorage.Client) storageGatewaySet {
+	linkSetter := boltstorage.LinkSetter{Client: client}
+	return storageGatewaySet{
+		linkByCodeGetter: boltstorage.LinkGetter{
+			Client:   client,
+			KeyField: boltstorage.CodeLinkField,
+		},
+		linkByURLGetter: boltstorage.LinkGetter{
+			Client:   client,
+			KeyField: boltstorage.URLLinkField,
+		},
+		linkIterator:     boltstorage.LinkIterator{Client: client},
+		linkSetter:       linkSetter,
+		bulkLinkSetter:   usecases.SequentialLinkSetter{LinkSetter: linkSetter},
+		linkDeleter:      boltstorage.LinkDeleter{Client: client},
+		linkUpdater:      boltstorage.LinkUpdater{Client: client},
+		clickSetter:      boltstorage.ClickSetter{Client: client},
+		clickStatsGetter: boltstorage.ClickStatsGetter{Client: client},
+	}
+}
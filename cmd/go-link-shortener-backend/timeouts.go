@@ -0,0 +1,49 @@
+package main
+
+import (
+	"time"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/timeouts"
+)
+
+func limitStorageGateways(
+	gateways storageGatewaySet,
+	timeout time.Duration,
+) storageGatewaySet {
+	// the link iterator isn't limited, because it works as long as the whole
+	// storage is being read
+	gateways.linkByCodeGetter = timeouts.TimeLimitedLinkGetter{
+		LinkGetter: gateways.linkByCodeGetter,
+		Timeout:    timeout,
+	}
+	gateways.linkByURLGetter = timeouts.TimeLimitedLinkGetter{
+		LinkGetter: gateways.linkByURLGetter,
+		Timeout:    timeout,
+	}
+	gateways.linkSetter = timeouts.TimeLimitedLinkSetter{
+		LinkSetter: gateways.linkSetter,
+		Timeout:    timeout,
+	}
+	gateways.bulkLinkSetter = timeouts.TimeLimitedBulkLinkSetter{
+		BulkLinkSetter: gateways.bulkLinkSetter,
+		Timeout:        timeout,
+	}
+	gateways.linkDeleter = timeouts.TimeLimitedLinkDeleter{
+		LinkDeleter: gateways.linkDeleter,
+		Timeout:     timeout,
+	}
+	gateways.linkUpdater = timeouts.TimeLimitedLinkUpdater{
+		LinkUpdater: gateways.linkUpdater,
+		Timeout:     timeout,
+	}
+	gateways.clickSetter = timeouts.TimeLimitedClickSetter{
+		ClickSetter: gateways.clickSetter,
+		Timeout:     timeout,
+	}
+	gateways.clickStatsGetter = timeouts.TimeLimitedClickStatsGetter{
+		ClickStatsGetter: gateways.clickStatsGetter,
+		Timeout:          timeout,
+	}
+
+	return gateways
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-log/log"
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/tracing"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const tracingShutdownTimeout = 5 * time.Second
+
+type tracerProvider struct {
+	trace.TracerProvider
+
+	shutdown func(ctx context.Context) error
+}
+
+func newTracerProvider(
+	endpoint string,
+	serviceName string,
+	samplingRatio float64,
+) (tracerProvider, error) {
+	// the tracing is disabled, if the collector isn't specified
+	if endpoint == "" {
+		return tracerProvider{
+			TracerProvider: noop.NewTracerProvider(),
+			shutdown:       func(context.Context) error { return nil },
+		}, nil
+	}
+
+	// the exporter has its own timeout and retries of failed exports;
+	// it doesn't connect on creating, so the context isn't important
+	exporter, err := otlptracehttp.New(
+		context.Background(),
+		otlptracehttp.WithEndpointURL(endpoint),
+		otlptracehttp.WithCompression(otlptracehttp.GzipCompression),
+	)
+	if err != nil {
+		return tracerProvider{}, errors.Wrap(err, "unable to create the exporter")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		)),
+		// the decision of a caller is respected, if it's propagated
+		sdktrace.WithSampler(
+			sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRatio)),
+		),
+	)
+	providerWithShutdown :=
+		tracerProvider{TracerProvider: provider, shutdown: provider.Shutdown}
+	return providerWithShutdown, nil
+}
+
+// it flushes the remaining spans, so it should be called before exiting
+func (provider tracerProvider) Shutdown(logger log.Logger) {
+	ctx, cancel :=
+		context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+
+	if err := provider.shutdown(ctx); err != nil {
+		logger.Logf("error with shutting down the tracing: %v", err)
+	}
+}
+
+func traceStorageGateways(
+	gateways storageGatewaySet,
+	tracer trace.Tracer,
+	name string,
+) storageGatewaySet {
+	// the link iterator and the click setter aren't traced, because they work
+	// outside of requests, so their spans would be orphaned
+	gateways.linkByCodeGetter = tracing.TracedLinkGetter{
+		LinkGetter: gateways.linkByCodeGetter,
+		Tracer:     tracer,
+		Name:       name,
+	}
+	gateways.linkByURLGetter = tracing.TracedLinkGetter{
+		LinkGetter: gateways.linkByURLGetter,
+		Tracer:     tracer,
+		Name:       name,
+	}
+	gateways.linkSetter = tracing.TracedLinkSetter{
+		LinkSetter: gateways.linkSetter,
+		Tracer:     tracer,
+		Name:       name,
+	}
+	gateways.bulkLinkSetter = tracing.TracedBulkLinkSetter{
+		BulkLinkSetter: gateways.bulkLinkSetter,
+		Tracer:         tracer,
+		Name:           name,
+	}
+	gateways.linkDeleter = tracing.TracedLinkDeleter{
+		LinkDeleter: gateways.linkDeleter,
+		Tracer:      tracer,
+		Name:        name,
+	}
+	gateways.linkUpdater = tracing.TracedLinkUpdater{
+		LinkUpdater: gateways.linkUpdater,
+		Tracer:      tracer,
+		Name:        name,
+	}
+	gateways.clickStatsGetter = tracing.TracedClickStatsGetter{
+		ClickStatsGetter: gateways.clickStatsGetter,
+		Tracer:           tracer,
+		Name:             name,
+	}
+
+	return gateways
+}
+
+func traceCacheGateways(
+	gateways cacheGatewaySet,
+	tracer trace.Tracer,
+	name string,
+) cacheGatewaySet {
+	gateways.linkGetter = tracing.TracedLinkGetter{
+		LinkGetter: gateways.linkGetter,
+		Tracer:     tracer,
+		Name:       name,
+	}
+	gateways.linkSetter = tracing.TracedLinkSetter{
+		LinkSetter: gateways.linkSetter,
+		Tracer:     tracer,
+		Name:       name,
+	}
+	gateways.linkDeleter = tracing.TracedLinkDeleter{
+		LinkDeleter: gateways.linkDeleter,
+		Tracer:      tracer,
+		Name:        name,
+	}
+	gateways.linkUpdater = tracing.TracedLinkUpdater{
+		LinkUpdater: gateways.linkUpdater,
+		Tracer:      tracer,
+		Name:        name,
+	}
+	gateways.rawLinkGetter = tracing.TracedLinkGetter{
+		LinkGetter: gateways.rawLinkGetter,
+		Tracer:     tracer,
+		Name:       name,
+	}
+
+	return gateways
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/go-log/log"
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"github.com/thewizardplusplus/go-link-shortener-backend/gateways/transfer"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases"
+)
+
+// it means the standard input or output instead of a file
+const standardStreamPath = "-"
+
+type transferOptions struct {
+	format    string
+	skip      int
+	warmCache bool
+	path      string
+}
+
+func runImportCommand(
+	ctx context.Context,
+	arguments []string,
+	dependencies commandDependencies,
+) error {
+	options, err := parseTransferOptions("import", arguments)
+	if err != nil {
+		return err
+	}
+
+	storageGateways := dependencies.storageGateways
+	cacheGateways := dependencies.cacheGateways
+	linkSetter := usecases.LinkSetterGroup{storageGateways.linkSetter}
+	if options.warmCache {
+		linkSetter = append(linkSetter, cacheGateways.linkSetter)
+	}
+
+	return importLinks(ctx, options, usecases.LinkImporter{
+		LinkGetter: storageGateways.linkByURLGetter,
+		LinkSetter: linkSetter,
+		// the cache is updated in any case to get rid of stale links in it
+		LinkUpdater: usecases.LinkUpdaterGroup{
+			cacheGateways.linkUpdater,
+			storageGateways.linkUpdater,
+		},
+	}, dependencies.logger)
+}
+
+func runExportCommand(
+	ctx context.Context,
+	arguments []string,
+	dependencies commandDependencies,
+) error {
+	options, err := parseTransferOptions("export", arguments)
+	if err != nil {
+		return err
+	}
+
+	var linkSetter usecases.LinkSetter = usecases.LinkSetterGroup{}
+	if options.warmCache {
+		linkSetter = dependencies.cacheGateways.linkSetter
+	}
+
+	return exportLinks(ctx, options, usecases.LinkExporter{
+		LinkIterator: dependencies.storageGateways.linkIterator,
+		LinkSetter:   linkSetter,
+	}, dependencies.logger)
+}
+
+func parseTransferOptions(
+	command string,
+	arguments []string,
+) (transferOptions, error) {
+	var options transferOptions
+	flags := flag.NewFlagSet(command, flag.ContinueOnError)
+	flags.StringVar(
+		&options.format,
+		"format",
+		"",
+		"format of the file: csv or jsonl (default: by the file extension)",
+	)
+	if command == "import" {
+		flags.IntVar(
+			&options.skip,
+			"skip",
+			0,
+			"count of records skipped from the start (for resuming of an import)",
+		)
+	}
+	flags.BoolVar(
+		&options.warmCache,
+		"warm-cache",
+		false,
+		"put the links to the cache",
+	)
+	if err := flags.Parse(arguments); err != nil {
+		return transferOptions{}, errors.Wrap(err, "unable to parse the flags")
+	}
+
+	switch flags.NArg() {
+	case 0:
+		options.path = standardStreamPath
+	case 1:
+		options.path = flags.Arg(0)
+	default:
+		return transferOptions{}, errors.New("too many arguments")
+	}
+
+	if options.format == "" {
+		options.format = transfer.JSONLinesFormat
+		if filepath.Ext(options.path) == "."+transfer.CSVFormat {
+			options.format = transfer.CSVFormat
+		}
+	}
+
+	return options, nil
+}
+
+func importLinks(
+	ctx context.Context,
+	options transferOptions,
+	importer usecases.LinkImporter,
+	logger log.Logger,
+) error {
+	file := os.Stdin
+	if options.path != standardStreamPath {
+		var openingErr error
+		file, openingErr = os.Open(options.path)
+		if openingErr != nil {
+			return errors.Wrap(openingErr, "unable to open the file")
+		}
+		defer file.Close() // nolint: errcheck
+	}
+
+	reader, readerErr := transfer.NewLinkReader(options.format, file)
+	if readerErr != nil {
+		return errors.Wrap(readerErr, "unable to create the link reader")
+	}
+
+	var imported, present, conflicting, invalid int
+	// the records are numbered from one, so the number of the last processed
+	// record may be passed to the skip flag to resume the import
+	for record := 1; ; record++ {
+		link, err := reader.ReadLink()
+		if err == io.EOF {
+			break
+		}
+		// invalid records may be skipped, unlike other errors
+		if err != nil && errors.Cause(err) != entities.ErrInvalidLink {
+			return errors.Wrapf(err, "unable to read record #%d", record)
+		}
+		if record <= options.skip {
+			continue
+		}
+		if err == nil {
+			var ok bool
+			ok, err = importer.ImportLink(ctx, link)
+			if err == nil {
+				if ok {
+					imported++
+				} else {
+					present++
+				}
+
+				continue
+			}
+		}
+
+		switch errors.Cause(err) {
+		case entities.ErrInvalidLink:
+			invalid++
+			logger.Logf("record #%d is invalid: %v", record, err)
+		case entities.ErrLinkConflict:
+			conflicting++
+			logger.Logf("record #%d is conflicting: %v", record, err)
+		default:
+			return errors.Wrapf(
+				err,
+				"unable to import record #%d (%d records are processed)",
+				record,
+				record-1,
+			)
+		}
+	}
+
+	logger.Logf(
+		"links are imported: %d new, %d already present, %d conflicting, %d invalid",
+		imported,
+		present,
+		conflicting,
+		invalid,
+	)
+	return nil
+}
+
+func exportLinks(
+	ctx context.Context,
+	options transferOptions,
+	exporter usecases.LinkExporter,
+	logger log.Logger,
+) (err error) {
+	file := os.Stdout
+	if options.path != standardStreamPath {
+		file, err = os.Create(options.path)
+		if err != nil {
+			return errors.Wrap(err, "unable to create the file")
+		}
+		defer func() {
+			if closingErr := file.Close(); closingErr != nil && err == nil {
+				err = errors.Wrap(closingErr, "unable to close the file")
+			}
+		}()
+	}
+
+	writer, err := transfer.NewLinkWriter(options.format, file)
+	if err != nil {
+		return errors.Wrap(err, "unable to create the link writer")
+	}
+
+	var exported int
+	if err = exporter.ExportLinks(ctx, func(link entities.Link) error {
+		exported++
+		return writer.WriteLink(link)
+	}); err != nil {
+		return errors.Wrap(err, "unable to export the links")
+	}
+	if err = writer.Flush(); err != nil {
+		return errors.Wrap(err, "unable to flush the links")
+	}
+
+	logger.Logf("links are exported: %d", exported)
+	return nil
+}
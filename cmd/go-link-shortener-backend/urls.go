@@ -0,0 +1,28 @@
+package main
+
+import (
+	"net"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// without own hosts, the service wouldn't be protected from redirect loops,
+// so the hosts, under which it's surely reachable, are used by default
+func makeOwnHosts(ownHosts []string, serverAddress string) ([]string, error) {
+	if len(ownHosts) != 0 {
+		return ownHosts, nil
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get the hostname")
+	}
+
+	ownHosts = []string{hostname}
+	if host, _, err := net.SplitHostPort(serverAddress); err == nil && host != "" {
+		ownHosts = append(ownHosts, host)
+	}
+
+	return ownHosts, nil
+}
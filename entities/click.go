@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"time"
+)
+
+// Click ...
+type Click struct {
+	Code      string
+	Time      time.Time
+	Referrer  string `json:",omitempty" bson:",omitempty"`
+	UserAgent string `json:",omitempty" bson:",omitempty"`
+	IP        string `json:",omitempty" bson:",omitempty"`
+	Variant   string `json:",omitempty" bson:",omitempty"`
+}
+
+// ClickStats ...
+type ClickStats struct {
+	Code        string
+	TotalCount  uint64
+	DailyCounts []DailyClickCount
+}
+
+// DailyClickCount ...
+type DailyClickCount struct {
+	Date  string
+	Count uint64
+}
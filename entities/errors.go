@@ -0,0 +1,14 @@
+package entities
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ...
+var (
+	ErrInvalidLink  = errors.New("invalid link")
+	ErrLinkConflict = errors.New("link conflict")
+	ErrLinkExpired  = errors.New("link expired")
+	ErrLinkDisabled = errors.New("link disabled")
+	ErrLinkBlocked  = errors.New("link blocked")
+)
@@ -1,8 +1,180 @@
 package entities
 
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Query forwarding modes; they define which parameters win
+// if the same parameter is both in the link URL and in a request.
+const (
+	LinkQueryForwarding    = "link"
+	RequestQueryForwarding = "request"
+	MergeQueryForwarding   = "merge"
+)
+
 // Link ...
 type Link struct {
-	ServerID string `json:",omitempty"`
-	Code     string
-	URL      string
+	ServerID        string `json:",omitempty"`
+	Code            string
+	URL             string
+	ExpirationTime  *time.Time     `json:",omitempty" bson:",omitempty"`
+	Disabled        bool           `json:",omitempty" bson:",omitempty"`
+	RedirectCode    int            `json:",omitempty" bson:",omitempty"`
+	QueryForwarding string         `json:",omitempty" bson:",omitempty"`
+	PathForwarding  bool           `json:",omitempty" bson:",omitempty"`
+	Rules           []RedirectRule `json:",omitempty" bson:",omitempty"`
+	Variants        []Variant      `json:",omitempty" bson:",omitempty"`
+}
+
+// IsExpired ...
+func (link Link) IsExpired(now time.Time) bool {
+	return link.ExpirationTime != nil && !now.Before(*link.ExpirationTime)
+}
+
+// HasSameOptions ...
+//
+// It compares the options of the links, i.e. all the fields set on creating
+// except the code and the URL. Times are compared by the EqualTimes()
+// function.
+//
+func (link Link) HasSameOptions(other Link) bool {
+	if !EqualTimes(link.ExpirationTime, other.ExpirationTime) ||
+		link.RedirectCode != other.RedirectCode ||
+		link.QueryForwarding != other.QueryForwarding ||
+		link.PathForwarding != other.PathForwarding ||
+		len(link.Rules) != len(other.Rules) ||
+		len(link.Variants) != len(other.Variants) {
+		return false
+	}
+
+	for index, rule := range link.Rules {
+		if !rule.equal(other.Rules[index]) {
+			return false
+		}
+	}
+	for index, variant := range link.Variants {
+		if variant != other.Variants[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsRedirectCode ...
+//
+// It checks whether the status code is one of the supported redirect codes.
+// The zero redirect code of a link means the default one and isn't accepted
+// by this function.
+//
+func IsRedirectCode(statusCode int) bool {
+	switch statusCode {
+	case http.StatusMovedPermanently,
+		http.StatusFound,
+		http.StatusTemporaryRedirect,
+		http.StatusPermanentRedirect:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsQueryForwarding ...
+//
+// It checks whether the mode is one of the supported query forwarding modes.
+// The empty mode of a link means no forwarding and isn't accepted
+// by this function.
+//
+func IsQueryForwarding(mode string) bool {
+	switch mode {
+	case LinkQueryForwarding, RequestQueryForwarding, MergeQueryForwarding:
+		return true
+	default:
+		return false
+	}
+}
+
+// MakeTargetURL ...
+//
+// It forwards the request query and path suffix onto the link URL,
+// if the link allows that. The link query forwarding mode resolves conflicts
+// of parameters: the link mode keeps the link ones, the request mode replaces
+// them by the request ones, and the merge mode keeps both.
+//
+func (link Link) MakeTargetURL(
+	query url.Values,
+	pathSuffix string,
+) (string, error) {
+	forwardsQuery := link.QueryForwarding != "" && len(query) != 0
+	forwardsPath := link.PathForwarding && pathSuffix != ""
+	if !forwardsQuery && !forwardsPath {
+		return link.URL, nil
+	}
+
+	targetURL, err := url.Parse(link.URL)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to parse the link URL")
+	}
+
+	if forwardsQuery {
+		targetQuery := targetURL.Query()
+		for name, values := range query {
+			switch link.QueryForwarding {
+			case LinkQueryForwarding:
+				if _, ok := targetQuery[name]; !ok {
+					targetQuery[name] = values
+				}
+			case RequestQueryForwarding:
+				targetQuery[name] = values
+			case MergeQueryForwarding:
+				targetQuery[name] = append(targetQuery[name], values...)
+			default:
+				return "", errors.Errorf(
+					"unsupported query forwarding mode %q",
+					link.QueryForwarding,
+				)
+			}
+		}
+
+		targetURL.RawQuery = targetQuery.Encode()
+	}
+
+	if forwardsPath {
+		targetURL.Path = strings.TrimSuffix(targetURL.Path, "/") + "/" +
+			strings.TrimPrefix(pathSuffix, "/")
+		// the raw path has to be rebuilt from the new one
+		targetURL.RawPath = ""
+	}
+
+	return targetURL.String(), nil
+}
+
+// LinkResult ...
+//
+// It's an outcome of processing a single link of a batch: either the resulting
+// link or the error specific to that link.
+//
+type LinkResult struct {
+	Link Link
+	Err  error
+}
+
+// EqualTimes ...
+//
+// It compares the times as instants with the millisecond precision, because
+// the storages may round times: MongoDB keeps milliseconds and PostgreSQL keeps
+// microseconds. So the times may also differ in their locations.
+//
+func EqualTimes(one *time.Time, other *time.Time) bool {
+	if one == nil || other == nil {
+		return one == other
+	}
+
+	return one.Truncate(time.Millisecond).
+		Equal(other.Truncate(time.Millisecond))
 }
@@ -0,0 +1,484 @@
+package entities
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLink_IsExpired(test *testing.T) {
+	type fields struct {
+		ExpirationTime *time.Time
+	}
+	type args struct {
+		now time.Time
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+		want   bool
+	}{
+		{
+			name: "without an expiration time",
+			fields: fields{
+				ExpirationTime: nil,
+			},
+			args: args{time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)},
+			want: false,
+		},
+		{
+			name: "with an expiration time in the future",
+			fields: fields{
+				ExpirationTime: func() *time.Time {
+					expirationTime := time.Date(2006, time.January, 3, 15, 4, 5, 0, time.UTC)
+					return &expirationTime
+				}(),
+			},
+			args: args{time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)},
+			want: false,
+		},
+		{
+			name: "with an expiration time equal to the current one",
+			fields: fields{
+				ExpirationTime: func() *time.Time {
+					expirationTime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+					return &expirationTime
+				}(),
+			},
+			args: args{time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)},
+			want: true,
+		},
+		{
+			name: "with an expiration time in the past",
+			fields: fields{
+				ExpirationTime: func() *time.Time {
+					expirationTime := time.Date(2006, time.January, 1, 15, 4, 5, 0, time.UTC)
+					return &expirationTime
+				}(),
+			},
+			args: args{time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)},
+			want: true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := Link{
+				Code:           "code",
+				URL:            "url",
+				ExpirationTime: data.fields.ExpirationTime,
+			}
+			got := link.IsExpired(data.args.now)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestLink_HasSameOptions(test *testing.T) {
+	newTime := func(location *time.Location) *time.Time {
+		value := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC).
+			In(location)
+		return &value
+	}
+	newLink := func() Link {
+		return Link{
+			Code:            "code",
+			URL:             "url",
+			ExpirationTime:  newTime(time.UTC),
+			RedirectCode:    http.StatusFound,
+			QueryForwarding: MergeQueryForwarding,
+			PathForwarding:  true,
+			Rules: []RedirectRule{
+				{OSes: []string{"ios"}, StartTime: newTime(time.UTC), URL: "url #1"},
+			},
+			Variants: []Variant{{Name: "a", URL: "url #2", Weight: 1}},
+		}
+	}
+
+	for _, data := range []struct {
+		name   string
+		modify func(link *Link)
+		want   bool
+	}{
+		{
+			name:   "with the same options",
+			modify: func(link *Link) {},
+			want:   true,
+		},
+		{
+			name: "with another code and another disabling flag",
+			modify: func(link *Link) {
+				link.Code = "another code"
+				link.Disabled = true
+			},
+			want: true,
+		},
+		{
+			name: "with times in another location",
+			modify: func(link *Link) {
+				link.ExpirationTime = newTime(time.FixedZone("UTC+3", 3*60*60))
+				link.Rules[0].StartTime = newTime(time.FixedZone("UTC+3", 3*60*60))
+			},
+			want: true,
+		},
+		{
+			name: "with a nanosecond-precision expiration time",
+			modify: func(link *Link) {
+				// MongoDB returns times with the millisecond precision
+				expirationTime := newTime(time.UTC).Add(999 * time.Microsecond)
+				link.ExpirationTime = &expirationTime
+			},
+			want: true,
+		},
+		{
+			name: "with another expiration time in milliseconds",
+			modify: func(link *Link) {
+				expirationTime := newTime(time.UTC).Add(time.Millisecond)
+				link.ExpirationTime = &expirationTime
+			},
+			want: false,
+		},
+		{
+			name:   "without an expiration time",
+			modify: func(link *Link) { link.ExpirationTime = nil },
+			want:   false,
+		},
+		{
+			name:   "with another redirect code",
+			modify: func(link *Link) { link.RedirectCode = 0 },
+			want:   false,
+		},
+		{
+			name:   "with another query forwarding mode",
+			modify: func(link *Link) { link.QueryForwarding = LinkQueryForwarding },
+			want:   false,
+		},
+		{
+			name:   "with another path forwarding flag",
+			modify: func(link *Link) { link.PathForwarding = false },
+			want:   false,
+		},
+		{
+			name:   "without rules",
+			modify: func(link *Link) { link.Rules = nil },
+			want:   false,
+		},
+		{
+			name:   "with another rule condition",
+			modify: func(link *Link) { link.Rules[0].OSes = []string{"android"} },
+			want:   false,
+		},
+		{
+			name:   "with another rule time",
+			modify: func(link *Link) { link.Rules[0].StartTime = nil },
+			want:   false,
+		},
+		{
+			name:   "without variants",
+			modify: func(link *Link) { link.Variants = nil },
+			want:   false,
+		},
+		{
+			name:   "with another variant weight",
+			modify: func(link *Link) { link.Variants[0].Weight = 2 },
+			want:   false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link, other := newLink(), newLink()
+			data.modify(&other)
+			got := link.HasSameOptions(other)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestEqualTimes(test *testing.T) {
+	newTime := func(nanoseconds int, location *time.Location) *time.Time {
+		value := time.Date(2006, time.January, 2, 15, 4, 5, nanoseconds, time.UTC).
+			In(location)
+		return &value
+	}
+
+	type args struct {
+		one   *time.Time
+		other *time.Time
+	}
+
+	for _, data := range []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "without times",
+			args: args{one: nil, other: nil},
+			want: true,
+		},
+		{
+			name: "without one of the times",
+			args: args{one: newTime(0, time.UTC), other: nil},
+			want: false,
+		},
+		{
+			name: "with the same times",
+			args: args{one: newTime(0, time.UTC), other: newTime(0, time.UTC)},
+			want: true,
+		},
+		{
+			name: "with times in different locations",
+			args: args{
+				one:   newTime(0, time.UTC),
+				other: newTime(0, time.FixedZone("UTC+3", 3*60*60)),
+			},
+			want: true,
+		},
+		{
+			name: "with times of different precisions",
+			args: args{
+				one:   newTime(123456789, time.UTC),
+				other: newTime(123000000, time.UTC),
+			},
+			want: true,
+		},
+		{
+			name: "with different times",
+			args: args{
+				one:   newTime(123456789, time.UTC),
+				other: newTime(124000000, time.UTC),
+			},
+			want: false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := EqualTimes(data.args.one, data.args.other)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestIsRedirectCode(test *testing.T) {
+	for _, data := range []struct {
+		name       string
+		statusCode int
+		want       bool
+	}{
+		{name: "zero", statusCode: 0, want: false},
+		{name: "301", statusCode: http.StatusMovedPermanently, want: true},
+		{name: "302", statusCode: http.StatusFound, want: true},
+		{name: "303", statusCode: http.StatusSeeOther, want: false},
+		{name: "307", statusCode: http.StatusTemporaryRedirect, want: true},
+		{name: "308", statusCode: http.StatusPermanentRedirect, want: true},
+		{name: "200", statusCode: http.StatusOK, want: false},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := IsRedirectCode(data.statusCode)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestIsQueryForwarding(test *testing.T) {
+	for _, data := range []struct {
+		name string
+		mode string
+		want bool
+	}{
+		{name: "empty", mode: "", want: false},
+		{name: "link", mode: LinkQueryForwarding, want: true},
+		{name: "request", mode: RequestQueryForwarding, want: true},
+		{name: "merge", mode: MergeQueryForwarding, want: true},
+		{name: "unknown", mode: "unknown", want: false},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := IsQueryForwarding(data.mode)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestLink_MakeTargetURL(test *testing.T) {
+	type fields struct {
+		URL             string
+		QueryForwarding string
+		PathForwarding  bool
+	}
+	type args struct {
+		query      url.Values
+		pathSuffix string
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "without forwarding",
+			fields: fields{
+				URL: "http://example.com/path?one=1",
+			},
+			args: args{
+				query:      url.Values{"one": {"2"}, "two": {"3"}},
+				pathSuffix: "extra/path",
+			},
+			want:    "http://example.com/path?one=1",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "with forwarding and without a query and a path suffix",
+			fields: fields{
+				URL:             "http://example.com/path?b=2&a=1",
+				QueryForwarding: LinkQueryForwarding,
+				PathForwarding:  true,
+			},
+			args: args{
+				query:      url.Values{},
+				pathSuffix: "",
+			},
+			want:    "http://example.com/path?b=2&a=1",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "with query forwarding (link mode)",
+			fields: fields{
+				URL:             "http://example.com/path?one=1",
+				QueryForwarding: LinkQueryForwarding,
+			},
+			args: args{
+				query:      url.Values{"one": {"2"}, "two": {"3"}},
+				pathSuffix: "",
+			},
+			want:    "http://example.com/path?one=1&two=3",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "with query forwarding (request mode)",
+			fields: fields{
+				URL:             "http://example.com/path?one=1",
+				QueryForwarding: RequestQueryForwarding,
+			},
+			args: args{
+				query:      url.Values{"one": {"2"}, "two": {"3"}},
+				pathSuffix: "",
+			},
+			want:    "http://example.com/path?one=2&two=3",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "with query forwarding (merge mode)",
+			fields: fields{
+				URL:             "http://example.com/path?one=1",
+				QueryForwarding: MergeQueryForwarding,
+			},
+			args: args{
+				query:      url.Values{"one": {"2"}, "two": {"3"}},
+				pathSuffix: "",
+			},
+			want:    "http://example.com/path?one=1&one=2&two=3",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "with query forwarding and a fragment",
+			fields: fields{
+				URL:             "http://example.com/path#fragment",
+				QueryForwarding: LinkQueryForwarding,
+			},
+			args: args{
+				query:      url.Values{"one": {"1 2"}},
+				pathSuffix: "",
+			},
+			want:    "http://example.com/path?one=1+2#fragment",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "with path forwarding",
+			fields: fields{
+				URL:            "http://example.com/path?one=1",
+				PathForwarding: true,
+			},
+			args: args{
+				query:      url.Values{"two": {"2"}},
+				pathSuffix: "extra/path",
+			},
+			want:    "http://example.com/path/extra/path?one=1",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "with path forwarding and a trailing slash",
+			fields: fields{
+				URL:            "http://example.com/",
+				PathForwarding: true,
+			},
+			args: args{
+				query:      nil,
+				pathSuffix: "extra path",
+			},
+			want:    "http://example.com/extra%20path",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "with both forwardings",
+			fields: fields{
+				URL:             "http://example.com/path",
+				QueryForwarding: RequestQueryForwarding,
+				PathForwarding:  true,
+			},
+			args: args{
+				query:      url.Values{"one": {"1"}},
+				pathSuffix: "extra/path",
+			},
+			want:    "http://example.com/path/extra/path?one=1",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error with an unsupported query forwarding mode",
+			fields: fields{
+				URL:             "http://example.com/path",
+				QueryForwarding: "unknown",
+			},
+			args: args{
+				query:      url.Values{"one": {"1"}},
+				pathSuffix: "",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error with an incorrect link URL",
+			fields: fields{
+				URL:            ":",
+				PathForwarding: true,
+			},
+			args: args{
+				query:      nil,
+				pathSuffix: "extra/path",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := Link{
+				Code:            "code",
+				URL:             data.fields.URL,
+				QueryForwarding: data.fields.QueryForwarding,
+				PathForwarding:  data.fields.PathForwarding,
+			}
+			got, err := link.MakeTargetURL(data.args.query, data.args.pathSuffix)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package entities
+
+import (
+	"strings"
+	"time"
+)
+
+// Visitor ...
+//
+// It describes a client following a link. The browser and the OS are families
+// in lower case (e.g. "chrome" and "ios"). The languages are ordered
+// by preference. The country is an ISO 3166-1 alpha-2 code in upper case.
+//
+type Visitor struct {
+	Browser   string
+	OS        string
+	Languages []string
+	Country   string
+	Time      time.Time
+}
+
+// RedirectRule ...
+//
+// An empty condition matches any visitor. The time window includes its start
+// and excludes its end.
+//
+type RedirectRule struct {
+	Browsers  []string   `json:",omitempty" bson:",omitempty"`
+	OSes      []string   `json:",omitempty" bson:",omitempty"`
+	Languages []string   `json:",omitempty" bson:",omitempty"`
+	Countries []string   `json:",omitempty" bson:",omitempty"`
+	StartTime *time.Time `json:",omitempty" bson:",omitempty"`
+	EndTime   *time.Time `json:",omitempty" bson:",omitempty"`
+	URL       string
+}
+
+// Matches ...
+//
+// It requires the visitor to match all the rule conditions. The language
+// condition is met by any visitor language, and a language without a region
+// (e.g. "en") also covers all its regional variants (e.g. "en-US").
+//
+func (rule RedirectRule) Matches(visitor Visitor) bool {
+	if len(rule.Browsers) != 0 && !containsFold(rule.Browsers, visitor.Browser) {
+		return false
+	}
+	if len(rule.OSes) != 0 && !containsFold(rule.OSes, visitor.OS) {
+		return false
+	}
+	if len(rule.Countries) != 0 &&
+		!containsFold(rule.Countries, visitor.Country) {
+		return false
+	}
+	if len(rule.Languages) != 0 &&
+		!matchesLanguages(rule.Languages, visitor.Languages) {
+		return false
+	}
+	if rule.StartTime != nil && visitor.Time.Before(*rule.StartTime) {
+		return false
+	}
+	if rule.EndTime != nil && !visitor.Time.Before(*rule.EndTime) {
+		return false
+	}
+
+	return true
+}
+
+// MatchRule ...
+//
+// It returns the first rule matching the visitor. If there are no such rules,
+// the link URL (or one of its variants) should be used as a fallback.
+//
+func (link Link) MatchRule(visitor Visitor) (RedirectRule, bool) {
+	for _, rule := range link.Rules {
+		if rule.Matches(visitor) {
+			return rule, true
+		}
+	}
+
+	return RedirectRule{}, false
+}
+
+func containsFold(values []string, sample string) bool {
+	if sample == "" {
+		return false
+	}
+
+	for _, value := range values {
+		if strings.EqualFold(value, sample) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesLanguages(ruleLanguages []string, visitorLanguages []string) bool {
+	for _, visitorLanguage := range visitorLanguages {
+		for _, ruleLanguage := range ruleLanguages {
+			if strings.EqualFold(ruleLanguage, visitorLanguage) {
+				return true
+			}
+
+			prefix := ruleLanguage + "-"
+			if len(visitorLanguage) > len(prefix) &&
+				strings.EqualFold(visitorLanguage[:len(prefix)], prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (rule RedirectRule) equal(other RedirectRule) bool {
+	return equalStrings(rule.Browsers, other.Browsers) &&
+		equalStrings(rule.OSes, other.OSes) &&
+		equalStrings(rule.Languages, other.Languages) &&
+		equalStrings(rule.Countries, other.Countries) &&
+		EqualTimes(rule.StartTime, other.StartTime) &&
+		EqualTimes(rule.EndTime, other.EndTime) &&
+		rule.URL == other.URL
+}
+
+func equalStrings(one []string, other []string) bool {
+	if len(one) != len(other) {
+		return false
+	}
+
+	for index, value := range one {
+		if value != other[index] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,215 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedirectRule_Matches(test *testing.T) {
+	type fields struct {
+		Browsers  []string
+		OSes      []string
+		Languages []string
+		Countries []string
+		StartTime *time.Time
+		EndTime   *time.Time
+	}
+	type args struct {
+		visitor Visitor
+	}
+
+	startTime := time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2006, time.January, 3, 0, 0, 0, 0, time.UTC)
+	visitor := Visitor{
+		Browser:   "safari",
+		OS:        "ios",
+		Languages: []string{"de-AT", "en"},
+		Country:   "AT",
+		Time:      time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC),
+	}
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+		want   bool
+	}{
+		{
+			name:   "without conditions",
+			fields: fields{},
+			args:   args{visitor},
+			want:   true,
+		},
+		{
+			name: "with all the conditions matched",
+			fields: fields{
+				Browsers:  []string{"chrome", "Safari"},
+				OSes:      []string{"android", "iOS"},
+				Languages: []string{"de-at"},
+				Countries: []string{"de", "at"},
+				StartTime: &startTime,
+				EndTime:   &endTime,
+			},
+			args: args{visitor},
+			want: true,
+		},
+		{
+			name:   "with an unmatched browser",
+			fields: fields{Browsers: []string{"chrome"}},
+			args:   args{visitor},
+			want:   false,
+		},
+		{
+			name:   "with an unknown browser",
+			fields: fields{Browsers: []string{"chrome"}},
+			args:   args{Visitor{Browser: ""}},
+			want:   false,
+		},
+		{
+			name:   "with an unmatched OS",
+			fields: fields{OSes: []string{"android"}},
+			args:   args{visitor},
+			want:   false,
+		},
+		{
+			name:   "with a language without a region",
+			fields: fields{Languages: []string{"de"}},
+			args:   args{visitor},
+			want:   true,
+		},
+		{
+			name:   "with a less preferred language",
+			fields: fields{Languages: []string{"fr", "en"}},
+			args:   args{visitor},
+			want:   true,
+		},
+		{
+			name:   "with a language of another region",
+			fields: fields{Languages: []string{"de-DE"}},
+			args:   args{visitor},
+			want:   false,
+		},
+		{
+			name:   "with a language as a prefix of another one",
+			fields: fields{Languages: []string{"d"}},
+			args:   args{visitor},
+			want:   false,
+		},
+		{
+			name:   "with an unmatched country",
+			fields: fields{Countries: []string{"DE"}},
+			args:   args{visitor},
+			want:   false,
+		},
+		{
+			name:   "with a time before the start time",
+			fields: fields{StartTime: &endTime},
+			args:   args{visitor},
+			want:   false,
+		},
+		{
+			name:   "with a time equal to the start time",
+			fields: fields{StartTime: &startTime},
+			args:   args{Visitor{Time: startTime}},
+			want:   true,
+		},
+		{
+			name:   "with a time equal to the end time",
+			fields: fields{EndTime: &endTime},
+			args:   args{Visitor{Time: endTime}},
+			want:   false,
+		},
+		{
+			name:   "with a time after the end time",
+			fields: fields{EndTime: &startTime},
+			args:   args{visitor},
+			want:   false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			rule := RedirectRule{
+				Browsers:  data.fields.Browsers,
+				OSes:      data.fields.OSes,
+				Languages: data.fields.Languages,
+				Countries: data.fields.Countries,
+				StartTime: data.fields.StartTime,
+				EndTime:   data.fields.EndTime,
+				URL:       "url",
+			}
+			got := rule.Matches(data.args.visitor)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestLink_MatchRule(test *testing.T) {
+	type fields struct {
+		Rules []RedirectRule
+	}
+	type args struct {
+		visitor Visitor
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+		want   RedirectRule
+		wantOk bool
+	}{
+		{
+			name:   "without rules",
+			fields: fields{Rules: nil},
+			args:   args{Visitor{OS: "ios"}},
+			want:   RedirectRule{},
+			wantOk: false,
+		},
+		{
+			name: "with a matched rule",
+			fields: fields{
+				Rules: []RedirectRule{
+					{OSes: []string{"ios"}, URL: "url #1"},
+					{OSes: []string{"android"}, URL: "url #2"},
+				},
+			},
+			args:   args{Visitor{OS: "android"}},
+			want:   RedirectRule{OSes: []string{"android"}, URL: "url #2"},
+			wantOk: true,
+		},
+		{
+			name: "with several matched rules",
+			fields: fields{
+				Rules: []RedirectRule{
+					{OSes: []string{"ios"}, URL: "url #1"},
+					{Languages: []string{"en"}, URL: "url #2"},
+					{OSes: []string{"android"}, URL: "url #3"},
+				},
+			},
+			args:   args{Visitor{OS: "android", Languages: []string{"en"}}},
+			want:   RedirectRule{Languages: []string{"en"}, URL: "url #2"},
+			wantOk: true,
+		},
+		{
+			name: "without matched rules",
+			fields: fields{
+				Rules: []RedirectRule{
+					{OSes: []string{"ios"}, URL: "url #1"},
+					{OSes: []string{"android"}, URL: "url #2"},
+				},
+			},
+			args:   args{Visitor{OS: "windows"}},
+			want:   RedirectRule{},
+			wantOk: false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := Link{Code: "code", URL: "url", Rules: data.fields.Rules}
+			got, gotOk := link.MatchRule(data.args.visitor)
+
+			assert.Equal(test, data.want, got)
+			assert.Equal(test, data.wantOk, gotOk)
+		})
+	}
+}
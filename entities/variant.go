@@ -0,0 +1,47 @@
+package entities
+
+// Variant ...
+//
+// It's one of weighted destinations of a link, which spread traffic between
+// several URLs. The name identifies the variant in visitor cookies and clicks.
+//
+type Variant struct {
+	Name   string
+	URL    string
+	Weight int
+}
+
+// SelectVariant ...
+//
+// If the name is one of the link variants (e.g. it was stored in a cookie),
+// the same variant is returned to keep the assignment sticky. Otherwise,
+// a variant is selected randomly by the weights. The random source should
+// return a number in the range [0, n).
+//
+// The link should have variants, and their weights should be positive.
+//
+func (link Link) SelectVariant(
+	name string,
+	randomSource func(n int) int,
+) Variant {
+	totalWeight := 0
+	for _, variant := range link.Variants {
+		if name != "" && variant.Name == name {
+			return variant
+		}
+
+		totalWeight += variant.Weight
+	}
+
+	number := randomSource(totalWeight)
+	for _, variant := range link.Variants {
+		if number < variant.Weight {
+			return variant
+		}
+
+		number -= variant.Weight
+	}
+
+	// it's unreachable for positive weights
+	return link.Variants[len(link.Variants)-1]
+}
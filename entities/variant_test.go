@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLink_SelectVariant(test *testing.T) {
+	type args struct {
+		name   string
+		number int
+	}
+
+	variants := []Variant{
+		{Name: "a", URL: "url #1", Weight: 3},
+		{Name: "b", URL: "url #2", Weight: 1},
+	}
+	for _, data := range []struct {
+		name            string
+		args            args
+		want            Variant
+		wantTotalWeight int
+	}{
+		{
+			name:            "with the first number of the first variant",
+			args:            args{name: "", number: 0},
+			want:            variants[0],
+			wantTotalWeight: 4,
+		},
+		{
+			name:            "with the last number of the first variant",
+			args:            args{name: "", number: 2},
+			want:            variants[0],
+			wantTotalWeight: 4,
+		},
+		{
+			name:            "with a number of the second variant",
+			args:            args{name: "", number: 3},
+			want:            variants[1],
+			wantTotalWeight: 4,
+		},
+		{
+			name:            "with an unknown name",
+			args:            args{name: "unknown", number: 3},
+			want:            variants[1],
+			wantTotalWeight: 4,
+		},
+		{
+			name:            "with a known name",
+			args:            args{name: "b", number: 0},
+			want:            variants[1],
+			wantTotalWeight: 0,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var gotTotalWeight int
+			randomSource := func(n int) int {
+				gotTotalWeight = n
+				return data.args.number
+			}
+
+			link := Link{Code: "code", URL: "url", Variants: variants}
+			got := link.SelectVariant(data.args.name, randomSource)
+
+			assert.Equal(test, data.want, got)
+			assert.Equal(test, data.wantTotalWeight, gotTotalWeight)
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package blocklist
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-log/log"
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// Blocklist ...
+//
+// It loads rules from the local file and reloads them on changes
+// of the file. The file is watched, and it's also checked periodically
+// in case the watching misses changes (e.g. on network file systems).
+// If the path is empty, the blocklist is empty.
+//
+type Blocklist struct {
+	path          string
+	checkInterval time.Duration
+	logger        log.Logger
+	lock          sync.RWMutex
+	rules         rules
+	fileInfo      os.FileInfo
+	stop          chan struct{}
+	done          chan struct{}
+}
+
+// NewBlocklist ...
+func NewBlocklist(
+	path string,
+	checkInterval time.Duration,
+	logger log.Logger,
+) (*Blocklist, error) {
+	blocklist := &Blocklist{
+		path:          path,
+		checkInterval: checkInterval,
+		logger:        logger,
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+	if path != "" {
+		if _, err := blocklist.reload(); err != nil {
+			return nil, errors.Wrap(err, "unable to load the blocklist")
+		}
+	}
+
+	return blocklist, nil
+}
+
+// CheckURL ...
+func (blocklist *Blocklist) CheckURL(rawURL string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(
+			entities.ErrInvalidLink,
+			"unable to parse the URL: %v",
+			err,
+		)
+	}
+
+	blocklist.lock.RLock()
+	defer blocklist.lock.RUnlock()
+
+	host := strings.ToLower(parsedURL.Hostname())
+	if rule, ok := blocklist.rules.match(host, rawURL); ok {
+		return errors.Wrapf(
+			entities.ErrLinkBlocked,
+			"the URL matches the blocklist rule %q",
+			rule,
+		)
+	}
+
+	return nil
+}
+
+// Run ...
+//
+// It watches the file for changes and blocks until the Stop() method
+// is called. If the watching is unavailable, only the periodic checks
+// are used. Errors of reloading are logged, and the previous rules are kept.
+//
+func (blocklist *Blocklist) Run() {
+	defer close(blocklist.done)
+	if blocklist.path == "" {
+		<-blocklist.stop
+		return
+	}
+
+	// the channels are nil without the watcher, so they are never selected
+	var events <-chan fsnotify.Event
+	var watchingErrs <-chan error
+	watcher, err := newWatcher(blocklist.path)
+	if err != nil {
+		blocklist.logger.Logf("unable to watch the blocklist: %v", err)
+	} else {
+		defer watcher.Close() // nolint: errcheck
+
+		events, watchingErrs = watcher.Events, watcher.Errors
+	}
+
+	ticker := time.NewTicker(blocklist.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event := <-events:
+			if filepath.Clean(event.Name) == filepath.Clean(blocklist.path) {
+				blocklist.reloadWithLogging()
+			}
+		case err := <-watchingErrs:
+			blocklist.logger.Logf("unable to watch the blocklist: %v", err)
+		case <-ticker.C:
+			blocklist.reloadWithLogging()
+		case <-blocklist.stop:
+			return
+		}
+	}
+}
+
+// Stop ...
+//
+// It waits for the Run() method to finish.
+//
+func (blocklist *Blocklist) Stop() {
+	close(blocklist.stop)
+	<-blocklist.done
+}
+
+// the directory is watched instead of the file, because the file may be
+// replaced by renaming, which breaks the watching of the file itself
+func newWatcher(path string) (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create the watcher")
+	}
+
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close() // nolint: errcheck, gosec
+		return nil, errors.Wrap(err, "unable to watch the directory")
+	}
+
+	return watcher, nil
+}
+
+func (blocklist *Blocklist) reloadWithLogging() {
+	isReloaded, err := blocklist.reload()
+	if err != nil {
+		blocklist.logger.Logf("unable to reload the blocklist: %v", err)
+		return
+	}
+	if isReloaded {
+		blocklist.logger.Log("the blocklist has been reloaded")
+	}
+}
+
+func (blocklist *Blocklist) reload() (isReloaded bool, err error) {
+	file, err := os.Open(blocklist.path)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to open the file")
+	}
+	defer file.Close() // nolint: errcheck
+
+	info, err := file.Stat()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get the file info")
+	}
+
+	// the file may be replaced by another one, e.g. by renaming,
+	// so its identity is checked too
+	blocklist.lock.RLock()
+	previousInfo := blocklist.fileInfo
+	blocklist.lock.RUnlock()
+
+	isChanged := previousInfo == nil ||
+		!os.SameFile(info, previousInfo) ||
+		!info.ModTime().Equal(previousInfo.ModTime()) ||
+		info.Size() != previousInfo.Size()
+	if !isChanged {
+		return false, nil
+	}
+
+	rules, err := parseRules(file)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to parse the file")
+	}
+
+	blocklist.lock.Lock()
+	defer blocklist.lock.Unlock()
+
+	blocklist.rules = rules
+	blocklist.fileInfo = info
+
+	return true, nil
+}
@@ -0,0 +1,220 @@
+package blocklist
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func newTestFile(test *testing.T, text string) (path string, cleanup func()) {
+	directory, err := ioutil.TempDir("", "blocklist")
+	require.NoError(test, err)
+
+	path = filepath.Join(directory, "blocklist.txt")
+	err = ioutil.WriteFile(path, []byte(text), 0600)
+	require.NoError(test, err)
+
+	return path, func() { os.RemoveAll(directory) } // nolint: errcheck, gosec
+}
+
+// replaceTestFile ...
+//
+// It replaces the file atomically, so the blocklist never reads it partially.
+//
+func replaceTestFile(test *testing.T, path string, text string) {
+	err := ioutil.WriteFile(path+".tmp", []byte(text), 0600)
+	require.NoError(test, err)
+
+	err = os.Rename(path+".tmp", path)
+	require.NoError(test, err)
+}
+
+func TestNewBlocklist(test *testing.T) {
+	test.Run("success", func(test *testing.T) {
+		path, cleanup := newTestFile(test, "phishing.example\n")
+		defer cleanup()
+
+		logger := new(MockLogger)
+		got, err := NewBlocklist(path, time.Second, logger)
+
+		mock.AssertExpectationsForObjects(test, logger)
+		require.NoError(test, err)
+		assert.Equal(test, path, got.path)
+		assert.Equal(test, time.Second, got.checkInterval)
+		assert.Equal(test, logger, got.logger)
+		assert.Equal(test, rules{
+			hosts: map[string]struct{}{"phishing.example": {}},
+		}, got.rules)
+		assert.NotNil(test, got.stop)
+		assert.NotNil(test, got.done)
+	})
+
+	test.Run("success without the path", func(test *testing.T) {
+		got, err := NewBlocklist("", time.Second, new(MockLogger))
+
+		require.NoError(test, err)
+		assert.Equal(test, rules{}, got.rules)
+	})
+
+	test.Run("error without the file", func(test *testing.T) {
+		path, cleanup := newTestFile(test, "")
+		cleanup()
+
+		got, err := NewBlocklist(path, time.Second, new(MockLogger))
+
+		assert.Nil(test, got)
+		assert.Error(test, err)
+	})
+
+	test.Run("error with the rules", func(test *testing.T) {
+		path, cleanup := newTestFile(test, "/[/\n")
+		defer cleanup()
+
+		got, err := NewBlocklist(path, time.Second, new(MockLogger))
+
+		assert.Nil(test, got)
+		assert.Error(test, err)
+	})
+}
+
+func TestBlocklist_CheckURL(test *testing.T) {
+	path, cleanup := newTestFile(test, "phishing.example\n/login\\.php/\n")
+	defer cleanup()
+
+	blocklist, err := NewBlocklist(path, time.Second, new(MockLogger))
+	require.NoError(test, err)
+
+	wantErr := func(wantCause error) assert.ErrorAssertionFunc {
+		return func(test assert.TestingT, err error, args ...interface{}) bool {
+			return assert.Equal(test, wantCause, errors.Cause(err), args)
+		}
+	}
+	for _, data := range []struct {
+		name    string
+		rawURL  string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success",
+			rawURL:  "https://example.com/",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error with the host",
+			rawURL:  "https://Phishing.example:8080/",
+			wantErr: wantErr(entities.ErrLinkBlocked),
+		},
+		{
+			name:    "error with the pattern",
+			rawURL:  "https://example.com/login.php",
+			wantErr: wantErr(entities.ErrLinkBlocked),
+		},
+		{
+			name:    "error with an unparsable URL",
+			rawURL:  "https://example.com:port/",
+			wantErr: wantErr(entities.ErrInvalidLink),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotErr := blocklist.CheckURL(data.rawURL)
+
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func TestBlocklist_Run(test *testing.T) {
+	test.Run("success with reloading", func(test *testing.T) {
+		path, cleanup := newTestFile(test, "phishing.example\n")
+		defer cleanup()
+
+		logger := new(MockLogger)
+		logger.On("Log", "the blocklist has been reloaded").Return()
+
+		blocklist, err := NewBlocklist(path, time.Millisecond, logger)
+		require.NoError(test, err)
+
+		go blocklist.Run()
+		replaceTestFile(test, path, "scam.example\n")
+
+		assert.Eventually(test, func() bool {
+			return blocklist.CheckURL("https://scam.example/") != nil
+		}, time.Second, time.Millisecond)
+		blocklist.Stop()
+
+		mock.AssertExpectationsForObjects(test, logger)
+		assert.NoError(test, blocklist.CheckURL("https://phishing.example/"))
+	})
+
+	test.Run("success with watching", func(test *testing.T) {
+		path, cleanup := newTestFile(test, "phishing.example\n")
+		defer cleanup()
+
+		logger := new(MockLogger)
+		logger.On("Log", "the blocklist has been reloaded").Return()
+
+		// the periodic checks are never performed during the test
+		blocklist, err := NewBlocklist(path, time.Hour, logger)
+		require.NoError(test, err)
+
+		go blocklist.Run()
+		// the watcher is started asynchronously, so the file is edited
+		// until it's noticed
+		assert.Eventually(test, func() bool {
+			err := ioutil.WriteFile(path, []byte("scam.example\n"), 0600)
+			require.NoError(test, err)
+
+			return blocklist.CheckURL("https://scam.example/") != nil
+		}, time.Second, 10*time.Millisecond)
+		blocklist.Stop()
+
+		mock.AssertExpectationsForObjects(test, logger)
+		assert.NoError(test, blocklist.CheckURL("https://phishing.example/"))
+	})
+
+	test.Run("error with reloading", func(test *testing.T) {
+		path, cleanup := newTestFile(test, "phishing.example\n")
+		defer cleanup()
+
+		isLogged := make(chan struct{}, 1)
+		logger := new(MockLogger)
+		logger.
+			On("Logf", "unable to reload the blocklist: %v", mock.Anything).
+			Return().
+			Run(func(mock.Arguments) {
+				select {
+				case isLogged <- struct{}{}:
+				default:
+				}
+			})
+
+		blocklist, err := NewBlocklist(path, time.Millisecond, logger)
+		require.NoError(test, err)
+
+		go blocklist.Run()
+		replaceTestFile(test, path, "*.scam.example\n/[/\n")
+
+		<-isLogged
+		blocklist.Stop()
+
+		// the previous rules are kept
+		assert.Error(test, blocklist.CheckURL("https://phishing.example/"))
+		assert.NoError(test, blocklist.CheckURL("https://scam.example/"))
+	})
+
+	test.Run("success without the path", func(test *testing.T) {
+		blocklist, err := NewBlocklist("", time.Millisecond, new(MockLogger))
+		require.NoError(test, err)
+
+		go blocklist.Run()
+		blocklist.Stop()
+	})
+}
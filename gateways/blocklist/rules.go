@@ -0,0 +1,79 @@
+package blocklist
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type rules struct {
+	hosts    map[string]struct{}
+	suffixes []string
+	patterns []*regexp.Regexp
+}
+
+// parseRules ...
+//
+// Each line contains a single rule:
+//   - "example.com" matches the host exactly;
+//   - "*.example.com" matches the host and all its subdomains;
+//   - "/regexp/" matches the whole URL by the regular expression.
+//
+// Empty lines and lines starting with "#" are ignored.
+//
+func parseRules(reader io.Reader) (rules, error) {
+	parsedRules := rules{hosts: make(map[string]struct{})}
+	scanner := bufio.NewScanner(reader)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case len(line) > 1 &&
+			strings.HasPrefix(line, "/") &&
+			strings.HasSuffix(line, "/"):
+			pattern, err := regexp.Compile(line[1 : len(line)-1])
+			if err != nil {
+				return rules{}, errors.Wrapf(
+					err,
+					"unable to compile the regexp on the line %d",
+					lineNumber,
+				)
+			}
+
+			parsedRules.patterns = append(parsedRules.patterns, pattern)
+		case strings.HasPrefix(line, "*."):
+			suffix := strings.ToLower(strings.TrimPrefix(line, "*."))
+			parsedRules.suffixes = append(parsedRules.suffixes, suffix)
+		default:
+			parsedRules.hosts[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return rules{}, errors.Wrap(err, "unable to read the rules")
+	}
+
+	return parsedRules, nil
+}
+
+func (rules rules) match(host string, url string) (rule string, ok bool) {
+	if _, ok := rules.hosts[host]; ok {
+		return host, true
+	}
+
+	for _, suffix := range rules.suffixes {
+		if host == suffix || strings.HasSuffix(host, "."+suffix) {
+			return "*." + suffix, true
+		}
+	}
+
+	for _, pattern := range rules.patterns {
+		if pattern.MatchString(url) {
+			return "/" + pattern.String() + "/", true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,129 @@
+package blocklist
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRules(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		text      string
+		wantRules rules
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			text: "# comment\n" +
+				"Phishing.example\n" +
+				"\n" +
+				"  *.Scam.example  \n" +
+				"/^https?://[^/]+/login\\.php/\n",
+			wantRules: rules{
+				hosts:    map[string]struct{}{"phishing.example": {}},
+				suffixes: []string{"scam.example"},
+				patterns: []*regexp.Regexp{
+					regexp.MustCompile(`^https?://[^/]+/login\.php`),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "success without rules",
+			text:      "# comment\n\n",
+			wantRules: rules{hosts: map[string]struct{}{}},
+			wantErr:   assert.NoError,
+		},
+		{
+			name:      "error with an invalid regexp",
+			text:      "phishing.example\n/[/\n",
+			wantRules: rules{},
+			wantErr:   assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotRules, gotErr := parseRules(strings.NewReader(data.text))
+
+			assert.Equal(test, data.wantRules, gotRules)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func TestParseRules_withReadingError(test *testing.T) {
+	reader := iotest.TimeoutReader(strings.NewReader(""))
+	reader.Read(nil) // nolint: errcheck, gosec
+
+	gotRules, gotErr := parseRules(reader)
+
+	assert.Equal(test, rules{}, gotRules)
+	assert.Error(test, gotErr)
+}
+
+func TestRules_match(test *testing.T) {
+	testRules := rules{
+		hosts:    map[string]struct{}{"phishing.example": {}},
+		suffixes: []string{"scam.example"},
+		patterns: []*regexp.Regexp{regexp.MustCompile(`/login\.php`)},
+	}
+
+	type args struct {
+		host string
+		url  string
+	}
+
+	for _, data := range []struct {
+		name     string
+		args     args
+		wantRule string
+		wantOk   bool
+	}{
+		{
+			name:     "success with an exact host",
+			args:     args{"phishing.example", "https://phishing.example/"},
+			wantRule: "phishing.example",
+			wantOk:   true,
+		},
+		{
+			name:     "success with a suffix",
+			args:     args{"scam.example", "https://scam.example/"},
+			wantRule: "*.scam.example",
+			wantOk:   true,
+		},
+		{
+			name:     "success with a subdomain of a suffix",
+			args:     args{"www.scam.example", "https://www.scam.example/"},
+			wantRule: "*.scam.example",
+			wantOk:   true,
+		},
+		{
+			name:     "success with a pattern",
+			args:     args{"example.com", "https://example.com/login.php"},
+			wantRule: `//login\.php/`,
+			wantOk:   true,
+		},
+		{
+			name:     "failure with a subdomain of an exact host",
+			args:     args{"www.phishing.example", "https://www.phishing.example/"},
+			wantRule: "",
+			wantOk:   false,
+		},
+		{
+			name:     "failure with a similar host",
+			args:     args{"notscam.example", "https://notscam.example/"},
+			wantRule: "",
+			wantOk:   false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotRule, gotOk := testRules.match(data.args.host, data.args.url)
+
+			assert.Equal(test, data.wantRule, gotRule)
+			assert.Equal(test, data.wantOk, gotOk)
+		})
+	}
+}
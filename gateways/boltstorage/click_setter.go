@@ -0,0 +1,59 @@
+package boltstorage
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.etcd.io/bbolt"
+)
+
+// ClickSetter ...
+type ClickSetter struct {
+	Client Client
+}
+
+// SetClicks ...
+//
+// Clicks are stored in nested buckets per link code
+// and keyed by sequence numbers of these buckets.
+//
+func (setter ClickSetter) SetClicks(
+	_ context.Context,
+	clicks []entities.Click,
+) error {
+	err := setter.Client.innerClient.Update(func(transaction *bbolt.Tx) error {
+		for _, click := range clicks {
+			bucket, err := transaction.Bucket(clickBucket).
+				CreateBucketIfNotExists([]byte(click.Code))
+			if err != nil {
+				return errors.Wrap(err, "unable to create the bucket of the clicks")
+			}
+
+			sequence, err := bucket.NextSequence()
+			if err != nil {
+				return errors.Wrap(err, "unable to get the key of the click")
+			}
+
+			data, err := json.Marshal(click)
+			if err != nil {
+				return errors.Wrap(err, "unable to marshal the click")
+			}
+
+			key := make([]byte, 8)
+			binary.BigEndian.PutUint64(key, sequence)
+			if err := bucket.Put(key, data); err != nil {
+				return errors.Wrap(err, "unable to put the click")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to set the clicks in the Bolt database")
+	}
+
+	return nil
+}
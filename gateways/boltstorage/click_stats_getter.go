@@ -0,0 +1,60 @@
+package boltstorage
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.etcd.io/bbolt"
+)
+
+const clickDateLayout = "2006-01-02"
+
+// ClickStatsGetter ...
+type ClickStatsGetter struct {
+	Client Client
+}
+
+// GetClickStats ...
+func (getter ClickStatsGetter) GetClickStats(
+	_ context.Context,
+	code string,
+) (entities.ClickStats, error) {
+	dailyCounts := make(map[string]uint64)
+	err := getter.Client.innerClient.View(func(transaction *bbolt.Tx) error {
+		bucket := transaction.Bucket(clickBucket).Bucket([]byte(code))
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(key []byte, data []byte) error {
+			var click entities.Click
+			if err := json.Unmarshal(data, &click); err != nil {
+				return errors.Wrap(err, "unable to unmarshal the click")
+			}
+
+			dailyCounts[click.Time.UTC().Format(clickDateLayout)]++
+			return nil
+		})
+	})
+	if err != nil {
+		return entities.ClickStats{},
+			errors.Wrap(err, "unable to aggregate the clicks in the Bolt database")
+	}
+
+	stats := entities.ClickStats{Code: code}
+	for date, count := range dailyCounts {
+		stats.TotalCount += count
+		stats.DailyCounts = append(stats.DailyCounts, entities.DailyClickCount{
+			Date:  date,
+			Count: count,
+		})
+	}
+	sort.Slice(stats.DailyCounts, func(i int, j int) bool {
+		return stats.DailyCounts[i].Date < stats.DailyCounts[j].Date
+	})
+
+	return stats, nil
+}
@@ -0,0 +1,68 @@
+package boltstorage
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const openingTimeout = time.Second
+
+var (
+	linkBucket    = []byte("links")
+	urlBucket     = []byte("link_urls")
+	clickBucket   = []byte("clicks")
+	counterBucket = []byte("counters")
+)
+
+// Client ...
+//
+// All the data is stored in a single file. It's locked exclusively
+// while it's open, so the client should be shared by all the gateways.
+//
+type Client struct {
+	innerClient *bbolt.DB
+}
+
+// NewClient ...
+func NewClient(path string) (Client, error) {
+	innerClient, err :=
+		bbolt.Open(path, 0600, &bbolt.Options{Timeout: openingTimeout})
+	if err != nil {
+		return Client{}, errors.Wrap(err, "unable to open the Bolt database")
+	}
+
+	if err := innerClient.Update(func(transaction *bbolt.Tx) error {
+		for _, bucket := range [][]byte{
+			linkBucket,
+			urlBucket,
+			clickBucket,
+			counterBucket,
+		} {
+			if _, err := transaction.CreateBucketIfNotExists(bucket); err != nil {
+				return errors.Wrapf(err, "unable to create the bucket %q", bucket)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		innerClient.Close() // nolint: errcheck, gosec
+		return Client{}, errors.Wrap(err, "unable to prepare the Bolt database")
+	}
+
+	return Client{innerClient: innerClient}, nil
+}
+
+// Close ...
+//
+// It does nothing for the zero client, so the client may be closed
+// regardless of whether it was opened.
+//
+func (client Client) Close() error {
+	if client.innerClient == nil {
+		return nil
+	}
+
+	return client.innerClient.Close()
+}
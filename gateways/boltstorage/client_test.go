@@ -0,0 +1,159 @@
+package boltstorage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.etcd.io/bbolt"
+)
+
+func newTestClient(test *testing.T) (client Client, cleanup func()) {
+	directory, err := ioutil.TempDir("", "boltstorage")
+	require.NoError(test, err)
+
+	client, err = NewClient(filepath.Join(directory, "database.db"))
+	require.NoError(test, err)
+
+	return client, func() {
+		client.Close()          // nolint: errcheck, gosec
+		os.RemoveAll(directory) // nolint: errcheck, gosec
+	}
+}
+
+func setTestLinks(test *testing.T, client Client, links []entities.Link) {
+	err := client.innerClient.Update(func(transaction *bbolt.Tx) error {
+		for _, link := range links {
+			if err := putLink(transaction, link); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(test, err)
+}
+
+func getAllLinks(test *testing.T, client Client) (
+	linksByCode map[string]entities.Link,
+	codesByURL map[string]string,
+) {
+	linksByCode = make(map[string]entities.Link)
+	codesByURL = make(map[string]string)
+	err := client.innerClient.View(func(transaction *bbolt.Tx) error {
+		if err := transaction.Bucket(linkBucket).
+			ForEach(func(code []byte, _ []byte) error {
+				link, err := getLinkByCode(transaction, string(code))
+				linksByCode[string(code)] = link
+				return err
+			}); err != nil {
+			return err
+		}
+
+		return transaction.Bucket(urlBucket).
+			ForEach(func(url []byte, code []byte) error {
+				codesByURL[string(url)] = string(code)
+				return nil
+			})
+	})
+	require.NoError(test, err)
+
+	return linksByCode, codesByURL
+}
+
+func TestNewClient(test *testing.T) {
+	directory, err := ioutil.TempDir("", "boltstorage")
+	require.NoError(test, err)
+	defer os.RemoveAll(directory) // nolint: errcheck
+
+	type args struct {
+		path string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success",
+			args:    args{filepath.Join(directory, "database.db")},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with an existing database",
+			args:    args{filepath.Join(directory, "database.db")},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error",
+			args:    args{filepath.Join(directory, "unknown", "database.db")},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, err := NewClient(data.args.path)
+			data.wantErr(test, err)
+			if err != nil {
+				return
+			}
+			defer client.Close() // nolint: errcheck
+
+			err = client.innerClient.View(func(transaction *bbolt.Tx) error {
+				for _, bucket := range [][]byte{
+					linkBucket,
+					urlBucket,
+					clickBucket,
+					counterBucket,
+				} {
+					assert.NotNil(test, transaction.Bucket(bucket))
+				}
+
+				return nil
+			})
+			assert.NoError(test, err)
+		})
+	}
+}
+
+func TestClient_Close(test *testing.T) {
+	directory, err := ioutil.TempDir("", "boltstorage")
+	require.NoError(test, err)
+	defer os.RemoveAll(directory) // nolint: errcheck
+
+	path := filepath.Join(directory, "database.db")
+	for _, data := range []struct {
+		name       string
+		makeClient func(test *testing.T) Client
+	}{
+		{
+			name: "with the opened client",
+			makeClient: func(test *testing.T) Client {
+				client, err := NewClient(path)
+				require.NoError(test, err)
+
+				return client
+			},
+		},
+		{
+			name:       "with the zero client",
+			makeClient: func(test *testing.T) Client { return Client{} },
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client := data.makeClient(test)
+			err := client.Close()
+
+			assert.NoError(test, err)
+
+			// the database should be unlocked
+			reopenedClient, err := NewClient(path)
+			require.NoError(test, err)
+			reopenedClient.Close() // nolint: errcheck, gosec
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package boltstorage
+
+// ...
+const (
+	CodeLinkField = "code"
+	URLLinkField  = "url"
+)
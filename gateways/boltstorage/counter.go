@@ -0,0 +1,57 @@
+package boltstorage
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+// Counter ...
+type Counter struct {
+	Client Client
+	Name   string
+}
+
+// NextCountChunk ...
+func (counter Counter) NextCountChunk(_ context.Context) (uint64, error) {
+	var countChunk uint64
+	err := counter.Client.innerClient.Update(func(transaction *bbolt.Tx) error {
+		bucket := transaction.Bucket(counterBucket)
+		if data := bucket.Get([]byte(counter.Name)); data != nil {
+			countChunk = binary.BigEndian.Uint64(data)
+		}
+
+		data := make([]byte, 8)
+		binary.BigEndian.PutUint64(data, countChunk+1)
+		return bucket.Put([]byte(counter.Name), data)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to update the counter")
+	}
+
+	return countChunk, nil
+}
+
+// PeekCountChunk ...
+//
+// It returns the count chunk, which will be returned by the next call
+// of the NextCountChunk method, without reserving it.
+//
+func (counter Counter) PeekCountChunk(_ context.Context) (uint64, error) {
+	var countChunk uint64
+	err := counter.Client.innerClient.View(func(transaction *bbolt.Tx) error {
+		bucket := transaction.Bucket(counterBucket)
+		if data := bucket.Get([]byte(counter.Name)); data != nil {
+			countChunk = binary.BigEndian.Uint64(data)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get the counter")
+	}
+
+	return countChunk, nil
+}
@@ -0,0 +1,63 @@
+package boltstorage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounter_NextCountChunk(test *testing.T) {
+	directory, err := ioutil.TempDir("", "boltstorage")
+	require.NoError(test, err)
+	defer os.RemoveAll(directory) // nolint: errcheck
+
+	path := filepath.Join(directory, "database.db")
+	var gotCountChunks []uint64
+	// the database is reopened to check the persistence of the counters
+	for _, names := range [][]string{{"one", "one", "two"}, {"one", "two"}} {
+		client, err := NewClient(path)
+		require.NoError(test, err)
+
+		for _, name := range names {
+			countChunk, err := Counter{Client: client, Name: name}.NextCountChunk(context.Background())
+			assert.NoError(test, err)
+
+			gotCountChunks = append(gotCountChunks, countChunk)
+		}
+
+		require.NoError(test, client.Close())
+	}
+
+	assert.Equal(test, []uint64{0, 1, 0, 2, 1}, gotCountChunks)
+}
+
+func TestCounter_PeekCountChunk(test *testing.T) {
+	directory, err := ioutil.TempDir("", "boltstorage")
+	require.NoError(test, err)
+	defer os.RemoveAll(directory) // nolint: errcheck
+
+	client, err := NewClient(filepath.Join(directory, "database.db"))
+	require.NoError(test, err)
+	defer client.Close() // nolint: errcheck
+
+	counter := Counter{Client: client, Name: "one"}
+	var gotCountChunks []uint64
+	for _, action := range []func(ctx context.Context) (uint64, error){
+		counter.PeekCountChunk,
+		counter.NextCountChunk,
+		counter.PeekCountChunk,
+		counter.PeekCountChunk,
+	} {
+		countChunk, err := action(context.Background())
+		assert.NoError(test, err)
+
+		gotCountChunks = append(gotCountChunks, countChunk)
+	}
+
+	assert.Equal(test, []uint64{0, 0, 1, 1}, gotCountChunks)
+}
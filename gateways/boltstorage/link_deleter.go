@@ -0,0 +1,40 @@
+package boltstorage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.etcd.io/bbolt"
+)
+
+// LinkDeleter ...
+type LinkDeleter struct {
+	Client Client
+}
+
+// DeleteLink ...
+func (deleter LinkDeleter) DeleteLink(
+	_ context.Context,
+	link entities.Link,
+) error {
+	err := deleter.Client.innerClient.Update(func(transaction *bbolt.Tx) error {
+		// the URL of the passed link may be absent,
+		// so the stored link is used for deleting
+		existingLink, err := getLinkByCode(transaction, link.Code)
+		if err != nil {
+			return err
+		}
+
+		return deleteLink(transaction, existingLink)
+	})
+	switch err {
+	case nil:
+		return nil
+	case sql.ErrNoRows:
+		return sql.ErrNoRows
+	default:
+		return errors.Wrap(err, "unable to delete the link from the Bolt database")
+	}
+}
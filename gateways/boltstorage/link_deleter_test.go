@@ -0,0 +1,71 @@
+package boltstorage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkDeleter_DeleteLink(test *testing.T) {
+	type args struct {
+		link entities.Link
+	}
+
+	for _, data := range []struct {
+		name            string
+		args            args
+		wantLinksByCode map[string]entities.Link
+		wantCodesByURL  map[string]string
+		wantErr         assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				link: entities.Link{Code: "code #1"},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code #2": {Code: "code #2", URL: "url #2"},
+			},
+			wantCodesByURL: map[string]string{"url #2": "code #2"},
+			wantErr:        assert.NoError,
+		},
+		{
+			name: "error without the link",
+			args: args{
+				link: entities.Link{Code: "code #3"},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code #1": {Code: "code #1", URL: "url #1"},
+				"code #2": {Code: "code #2", URL: "url #2"},
+			},
+			wantCodesByURL: map[string]string{
+				"url #1": "code #1",
+				"url #2": "code #2",
+			},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			setTestLinks(test, client, []entities.Link{
+				{Code: "code #1", URL: "url #1"},
+				{Code: "code #2", URL: "url #2"},
+			})
+
+			deleter := LinkDeleter{Client: client}
+			gotErr := deleter.DeleteLink(context.Background(), data.args.link)
+
+			gotLinksByCode, gotCodesByURL := getAllLinks(test, client)
+			assert.Equal(test, data.wantLinksByCode, gotLinksByCode)
+			assert.Equal(test, data.wantCodesByURL, gotCodesByURL)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package boltstorage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.etcd.io/bbolt"
+)
+
+// LinkGetter ...
+type LinkGetter struct {
+	Client   Client
+	KeyField string
+}
+
+// GetLink ...
+func (getter LinkGetter) GetLink(
+	_ context.Context,
+	query string,
+) (entities.Link, error) {
+	var link entities.Link
+	err := getter.Client.innerClient.View(func(transaction *bbolt.Tx) error {
+		var err error
+		switch getter.KeyField {
+		case CodeLinkField:
+			link, err = getLinkByCode(transaction, query)
+		case URLLinkField:
+			link, err = getLinkByURL(transaction, query)
+		default:
+			err = errors.Errorf("unknown key field %q", getter.KeyField)
+		}
+
+		return err
+	})
+	switch err {
+	case nil:
+		// the Bolt database doesn't purge expired links at all,
+		// so they should be filtered out explicitly
+		if link.IsExpired(time.Now()) {
+			return entities.Link{}, entities.ErrLinkExpired
+		}
+
+		return link, nil
+	case sql.ErrNoRows:
+		return entities.Link{}, sql.ErrNoRows
+	default:
+		return entities.Link{},
+			errors.Wrap(err, "unable to get the link from the Bolt database")
+	}
+}
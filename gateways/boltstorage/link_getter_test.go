@@ -0,0 +1,120 @@
+package boltstorage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkGetter_GetLink(test *testing.T) {
+	type fields struct {
+		KeyField string
+	}
+	type args struct {
+		query string
+	}
+
+	expirationTime := time.Now().Add(time.Hour).UTC().Round(time.Second)
+	expiredTime := time.Now().Add(-time.Hour)
+	for _, data := range []struct {
+		name     string
+		links    []entities.Link
+		fields   fields
+		args     args
+		wantLink entities.Link
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "success by the code",
+			links:    []entities.Link{{Code: "code", URL: "url", Disabled: true}},
+			fields:   fields{KeyField: CodeLinkField},
+			args:     args{"code"},
+			wantLink: entities.Link{Code: "code", URL: "url", Disabled: true},
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "success by the URL",
+			links:    []entities.Link{{Code: "code", URL: "url"}},
+			fields:   fields{KeyField: URLLinkField},
+			args:     args{"url"},
+			wantLink: entities.Link{Code: "code", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with an expiration time",
+			links: []entities.Link{
+				{Code: "code", URL: "url", ExpirationTime: &expirationTime},
+			},
+			fields: fields{KeyField: CodeLinkField},
+			args:   args{"code"},
+			wantLink: entities.Link{
+				Code:           "code",
+				URL:            "url",
+				ExpirationTime: &expirationTime,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "error without the link by the code",
+			links:    []entities.Link{{Code: "code", URL: "url"}},
+			fields:   fields{KeyField: CodeLinkField},
+			args:     args{"url"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+		{
+			name:     "error without the link by the URL",
+			links:    []entities.Link{{Code: "code", URL: "url"}},
+			fields:   fields{KeyField: URLLinkField},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+		{
+			name: "error with an expired link",
+			links: []entities.Link{
+				{Code: "code", URL: "url", ExpirationTime: &expiredTime},
+			},
+			fields:   fields{KeyField: CodeLinkField},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkExpired, err, args)
+			},
+		},
+		{
+			name:     "error with the key field",
+			links:    []entities.Link{{Code: "code", URL: "url"}},
+			fields:   fields{KeyField: "unknown"},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr:  assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			setTestLinks(test, client, data.links)
+
+			getter := LinkGetter{Client: client, KeyField: data.fields.KeyField}
+			gotLink, gotErr := getter.GetLink(context.Background(), data.args.query)
+
+			wantTime, gotTime := data.wantLink.ExpirationTime, gotLink.ExpirationTime
+			if wantTime != nil && gotTime != nil {
+				assert.True(test, wantTime.Equal(*gotTime))
+				gotLink.ExpirationTime = wantTime
+			}
+			assert.Equal(test, data.wantLink, gotLink)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
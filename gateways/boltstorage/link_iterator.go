@@ -0,0 +1,54 @@
+package boltstorage
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.etcd.io/bbolt"
+)
+
+// LinkIterator ...
+type LinkIterator struct {
+	Client Client
+}
+
+// IterateLinks ...
+//
+// It skips expired links, because they are considered as absent. The handler
+// is called inside a read transaction, so it shouldn't write to the same
+// database.
+//
+func (iterator LinkIterator) IterateLinks(
+	_ context.Context,
+	handler func(link entities.Link) error,
+) error {
+	now := time.Now()
+	err := iterator.Client.innerClient.View(func(transaction *bbolt.Tx) error {
+		return transaction.Bucket(linkBucket).ForEach(func(_, data []byte) error {
+			var link entities.Link
+			if err := json.Unmarshal(data, &link); err != nil {
+				return errors.Wrap(err, "unable to unmarshal the link")
+			}
+			if link.IsExpired(now) {
+				return nil
+			}
+
+			if err := handler(link); err != nil {
+				return errors.Wrap(err, "unable to handle the link")
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return errors.Wrap(
+			err,
+			"unable to iterate over the links in the Bolt database",
+		)
+	}
+
+	return nil
+}
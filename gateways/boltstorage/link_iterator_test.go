@@ -0,0 +1,78 @@
+package boltstorage
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkIterator_IterateLinks(test *testing.T) {
+	type args struct {
+		handlerErr error
+	}
+
+	expirationTime := time.Now().Add(time.Hour).UTC().Round(time.Second)
+	expiredTime := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	for _, data := range []struct {
+		name      string
+		links     []entities.Link
+		args      args
+		wantLinks []entities.Link
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			links: []entities.Link{
+				{Code: "code #1", URL: "url #1", Disabled: true},
+				{Code: "code #2", URL: "url #2", ExpirationTime: &expirationTime},
+				{Code: "code #3", URL: "url #3", ExpirationTime: &expiredTime},
+			},
+			args: args{handlerErr: nil},
+			wantLinks: []entities.Link{
+				{Code: "code #1", URL: "url #1", Disabled: true},
+				{Code: "code #2", URL: "url #2", ExpirationTime: &expirationTime},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "success without links",
+			links:     nil,
+			args:      args{handlerErr: nil},
+			wantLinks: nil,
+			wantErr:   assert.NoError,
+		},
+		{
+			name:      "error with the handler",
+			links:     []entities.Link{{Code: "code", URL: "url"}},
+			args:      args{handlerErr: iotest.ErrTimeout},
+			wantLinks: []entities.Link{{Code: "code", URL: "url"}},
+			wantErr:   assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			setTestLinks(test, client, data.links)
+
+			var gotLinks []entities.Link
+			iterator := LinkIterator{Client: client}
+			gotErr := iterator.IterateLinks(context.Background(), func(link entities.Link) error {
+				if link.ExpirationTime != nil {
+					expirationTime := link.ExpirationTime.UTC()
+					link.ExpirationTime = &expirationTime
+				}
+
+				gotLinks = append(gotLinks, link)
+				return data.args.handlerErr
+			})
+
+			assert.ElementsMatch(test, data.wantLinks, gotLinks)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
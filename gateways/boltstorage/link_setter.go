@@ -0,0 +1,69 @@
+package boltstorage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.etcd.io/bbolt"
+)
+
+// LinkSetter ...
+type LinkSetter struct {
+	Client Client
+}
+
+// SetLink ...
+//
+// It repeats the upsert semantics of the MongoDB storage: if the link URL
+// is already present, nothing is changed.
+//
+func (setter LinkSetter) SetLink(
+	_ context.Context,
+	link entities.Link,
+) error {
+	err := setter.Client.innerClient.Update(func(transaction *bbolt.Tx) error {
+		// expired links are never purged automatically,
+		// so they should be removed explicitly; otherwise,
+		// they would block their URLs and codes
+		now := time.Now()
+		for _, lookup := range []struct {
+			getLink func(transaction *bbolt.Tx, key string) (entities.Link, error)
+			key     string
+		}{
+			{getLink: getLinkByURL, key: link.URL},
+			{getLink: getLinkByCode, key: link.Code},
+		} {
+			existingLink, err := lookup.getLink(transaction, lookup.key)
+			switch err {
+			case nil:
+				if !existingLink.IsExpired(now) {
+					continue
+				}
+
+				if err := deleteLink(transaction, existingLink); err != nil {
+					return errors.Wrap(err, "unable to remove the expired link")
+				}
+			case sql.ErrNoRows:
+			default:
+				return errors.Wrap(err, "unable to check the link")
+			}
+		}
+
+		if transaction.Bucket(urlBucket).Get([]byte(link.URL)) != nil {
+			return nil
+		}
+		if transaction.Bucket(linkBucket).Get([]byte(link.Code)) != nil {
+			return entities.ErrLinkConflict
+		}
+
+		return putLink(transaction, link)
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to set the link in the Bolt database")
+	}
+
+	return nil
+}
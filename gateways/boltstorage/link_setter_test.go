@@ -0,0 +1,95 @@
+package boltstorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkSetter_SetLink(test *testing.T) {
+	type args struct {
+		link entities.Link
+	}
+
+	expiredTime := time.Now().Add(-time.Hour)
+	for _, data := range []struct {
+		name            string
+		links           []entities.Link
+		args            args
+		wantLinksByCode map[string]entities.Link
+		wantCodesByURL  map[string]string
+		wantErr         assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success with creating",
+			args: args{
+				link: entities.Link{ServerID: "server", Code: "code", URL: "url"},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code": {Code: "code", URL: "url"},
+			},
+			wantCodesByURL: map[string]string{"url": "code"},
+			wantErr:        assert.NoError,
+		},
+		{
+			name:  "success with an existing URL",
+			links: []entities.Link{{Code: "code #1", URL: "url"}},
+			args: args{
+				link: entities.Link{Code: "code #2", URL: "url"},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code #1": {Code: "code #1", URL: "url"},
+			},
+			wantCodesByURL: map[string]string{"url": "code #1"},
+			wantErr:        assert.NoError,
+		},
+		{
+			name: "success with replacing of expired links",
+			links: []entities.Link{
+				{Code: "code #1", URL: "url #1", ExpirationTime: &expiredTime},
+				{Code: "code #2", URL: "url #2", ExpirationTime: &expiredTime},
+			},
+			args: args{
+				link: entities.Link{Code: "code #1", URL: "url #2"},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code #1": {Code: "code #1", URL: "url #2"},
+			},
+			wantCodesByURL: map[string]string{"url #2": "code #1"},
+			wantErr:        assert.NoError,
+		},
+		{
+			name:  "error with an existing code",
+			links: []entities.Link{{Code: "code", URL: "url #1"}},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url #2"},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code": {Code: "code", URL: "url #1"},
+			},
+			wantCodesByURL: map[string]string{"url #1": "code"},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkConflict, errors.Cause(err), args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			setTestLinks(test, client, data.links)
+
+			setter := LinkSetter{Client: client}
+			gotErr := setter.SetLink(context.Background(), data.args.link)
+
+			gotLinksByCode, gotCodesByURL := getAllLinks(test, client)
+			assert.Equal(test, data.wantLinksByCode, gotLinksByCode)
+			assert.Equal(test, data.wantCodesByURL, gotCodesByURL)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
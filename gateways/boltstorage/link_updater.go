@@ -0,0 +1,42 @@
+package boltstorage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.etcd.io/bbolt"
+)
+
+// LinkUpdater ...
+type LinkUpdater struct {
+	Client Client
+}
+
+// UpdateLink ...
+//
+// Only mutable fields of the link are updated; now it's the disabling flag.
+//
+func (updater LinkUpdater) UpdateLink(
+	_ context.Context,
+	link entities.Link,
+) error {
+	err := updater.Client.innerClient.Update(func(transaction *bbolt.Tx) error {
+		existingLink, err := getLinkByCode(transaction, link.Code)
+		if err != nil {
+			return err
+		}
+
+		existingLink.Disabled = link.Disabled
+		return putLink(transaction, existingLink)
+	})
+	switch err {
+	case nil:
+		return nil
+	case sql.ErrNoRows:
+		return sql.ErrNoRows
+	default:
+		return errors.Wrap(err, "unable to update the link in the Bolt database")
+	}
+}
@@ -0,0 +1,61 @@
+package boltstorage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkUpdater_UpdateLink(test *testing.T) {
+	type args struct {
+		link entities.Link
+	}
+
+	for _, data := range []struct {
+		name            string
+		args            args
+		wantLinksByCode map[string]entities.Link
+		wantErr         assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				link: entities.Link{Code: "code", Disabled: true},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code": {Code: "code", URL: "url", Disabled: true},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error without the link",
+			args: args{
+				link: entities.Link{Code: "unknown", Disabled: true},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code": {Code: "code", URL: "url"},
+			},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			setTestLinks(test, client, []entities.Link{{Code: "code", URL: "url"}})
+
+			updater := LinkUpdater{Client: client}
+			gotErr := updater.UpdateLink(context.Background(), data.args.link)
+
+			gotLinksByCode, gotCodesByURL := getAllLinks(test, client)
+			assert.Equal(test, data.wantLinksByCode, gotLinksByCode)
+			assert.Equal(test, map[string]string{"url": "code"}, gotCodesByURL)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package boltstorage
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.etcd.io/bbolt"
+)
+
+func getLinkByCode(
+	transaction *bbolt.Tx,
+	code string,
+) (entities.Link, error) {
+	data := transaction.Bucket(linkBucket).Get([]byte(code))
+	if data == nil {
+		return entities.Link{}, sql.ErrNoRows
+	}
+
+	var link entities.Link
+	if err := json.Unmarshal(data, &link); err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to unmarshal the link")
+	}
+
+	return link, nil
+}
+
+func getLinkByURL(transaction *bbolt.Tx, url string) (entities.Link, error) {
+	code := transaction.Bucket(urlBucket).Get([]byte(url))
+	if code == nil {
+		return entities.Link{}, sql.ErrNoRows
+	}
+
+	return getLinkByCode(transaction, string(code))
+}
+
+func putLink(transaction *bbolt.Tx, link entities.Link) error {
+	// the server ID is added by presenters and shouldn't be stored
+	link.ServerID = ""
+
+	data, err := json.Marshal(link)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal the link")
+	}
+	if err := transaction.Bucket(linkBucket).
+		Put([]byte(link.Code), data); err != nil {
+		return errors.Wrap(err, "unable to put the link by its code")
+	}
+	if err := transaction.Bucket(urlBucket).
+		Put([]byte(link.URL), []byte(link.Code)); err != nil {
+		return errors.Wrap(err, "unable to put the link by its URL")
+	}
+
+	return nil
+}
+
+func deleteLink(transaction *bbolt.Tx, link entities.Link) error {
+	if err := transaction.Bucket(linkBucket).
+		Delete([]byte(link.Code)); err != nil {
+		return errors.Wrap(err, "unable to delete the link by its code")
+	}
+	if err := transaction.Bucket(urlBucket).
+		Delete([]byte(link.URL)); err != nil {
+		return errors.Wrap(err, "unable to delete the link by its URL")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// count of keys requested from Redis at once
+const keyBatchSize = 100
+
+// KeyIterator ...
+//
+// It iterates over all the keys in Redis incrementally, so it doesn't block
+// Redis. A key may be passed to the handler more than once, and keys
+// added or deleted during the iteration may be missed.
+//
+type KeyIterator struct {
+	Client Client
+}
+
+// IterateKeys ...
+func (iterator KeyIterator) IterateKeys(
+	ctx context.Context,
+	handler func(key string) error,
+) error {
+	var cursor uint64
+	for {
+		keys, nextCursor, err := iterator.Client.innerClient.
+			WithContext(ctx).
+			Scan(cursor, "", keyBatchSize).
+			Result()
+		if err != nil {
+			return errors.Wrap(err, "unable to scan the keys in Redis")
+		}
+
+		for _, key := range keys {
+			if err := handler(key); err != nil {
+				return errors.Wrap(err, "unable to handle the key")
+			}
+		}
+
+		if nextCursor == 0 {
+			return nil
+		}
+
+		cursor = nextCursor
+	}
+}
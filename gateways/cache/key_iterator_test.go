@@ -0,0 +1,57 @@
+// +build integration
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"testing/iotest"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyIterator_IterateKeys(test *testing.T) {
+	type options struct {
+		CacheAddress string `env:"CACHE_ADDRESS" envDefault:"localhost:6379"`
+	}
+
+	var opts options
+	err := env.Parse(&opts)
+	require.NoError(test, err)
+
+	client := NewClient(opts.CacheAddress)
+	// there are more keys than in a single batch
+	var wantKeys []string
+	for i := 0; i < keyBatchSize*2+1; i++ {
+		key := fmt.Sprintf("iterated_key_%d", i)
+		err := client.innerClient.Set(key, `{"Code":"code","URL":"url"}`, 0).Err()
+		require.NoError(test, err)
+
+		wantKeys = append(wantKeys, key)
+	}
+
+	test.Run("success", func(test *testing.T) {
+		gotKeys := make(map[string]struct{})
+		err := KeyIterator{Client: client}.IterateKeys(context.Background(), func(key string) error {
+			gotKeys[key] = struct{}{}
+			return nil
+		})
+
+		assert.NoError(test, err)
+		for _, key := range wantKeys {
+			assert.Contains(test, gotKeys, key)
+		}
+	})
+
+	test.Run("error", func(test *testing.T) {
+		err := KeyIterator{Client: client}.IterateKeys(context.Background(), func(key string) error {
+			return iotest.ErrTimeout
+		})
+
+		assert.Equal(test, iotest.ErrTimeout, errors.Cause(err))
+	})
+}
@@ -0,0 +1,30 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkDeleter ...
+type LinkDeleter struct {
+	KeyExtractor KeyExtractor
+	Client       Client
+}
+
+// DeleteLink ...
+func (deleter LinkDeleter) DeleteLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	key := deleter.KeyExtractor(link)
+	if err := deleter.Client.innerClient.
+		WithContext(ctx).
+		Del(key).
+		Err(); err != nil {
+		return errors.Wrap(err, "unable to delete the link from Redis")
+	}
+
+	return nil
+}
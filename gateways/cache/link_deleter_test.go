@@ -0,0 +1,86 @@
+// +build integration
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkDeleter_DeleteLink(test *testing.T) {
+	type options struct {
+		CacheAddress string `env:"CACHE_ADDRESS" envDefault:"localhost:6379"`
+	}
+	type fields struct {
+		KeyExtractor KeyExtractor
+		Client       Client
+	}
+	type args struct {
+		link entities.Link
+	}
+
+	var opts options
+	err := env.Parse(&opts)
+	require.NoError(test, err)
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		prepare func(test *testing.T, client Client)
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success with an existing link",
+			fields: fields{
+				KeyExtractor: func(link entities.Link) string { return "key" },
+				Client:       NewClient(opts.CacheAddress),
+			},
+			prepare: func(test *testing.T, client Client) {
+				err := client.innerClient.
+					Set("key", `{"Code":"code","URL":"url"}`, 0).
+					Err()
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success without an existing link",
+			fields: fields{
+				KeyExtractor: func(link entities.Link) string { return "key" },
+				Client:       NewClient(opts.CacheAddress),
+			},
+			prepare: func(test *testing.T, client Client) {
+				err := client.innerClient.Del("key").Err()
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			data.prepare(test, data.fields.Client)
+
+			cache := LinkDeleter{
+				KeyExtractor: data.fields.KeyExtractor,
+				Client:       data.fields.Client,
+			}
+			gotErr := cache.DeleteLink(context.Background(), data.args.link)
+
+			_, err := data.fields.Client.innerClient.Get("key").Result()
+			assert.Equal(test, redis.Nil, err)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
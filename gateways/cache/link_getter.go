@@ -1,8 +1,10 @@
 package cache
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/pkg/errors"
@@ -15,8 +17,14 @@ type LinkGetter struct {
 }
 
 // GetLink ...
-func (getter LinkGetter) GetLink(query string) (entities.Link, error) {
-	data, err := getter.Client.innerClient.Get(query).Result()
+func (getter LinkGetter) GetLink(
+	ctx context.Context,
+	query string,
+) (entities.Link, error) {
+	data, err := getter.Client.innerClient.
+		WithContext(ctx).
+		Get(query).
+		Result()
 	switch err {
 	case nil:
 	case redis.Nil:
@@ -30,6 +38,9 @@ func (getter LinkGetter) GetLink(query string) (entities.Link, error) {
 		return entities.Link{},
 			errors.Wrap(err, "unable to unmarshal the link from Redis")
 	}
+	if link.IsExpired(time.Now()) {
+		return entities.Link{}, entities.ErrLinkExpired
+	}
 
 	return link, nil
 }
@@ -3,10 +3,11 @@
 package cache
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
-	"github.com/caarlos0/env"
+	"github.com/caarlos0/env/v6"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
@@ -78,6 +79,27 @@ func TestLinkGetter_GetLink(test *testing.T) {
 			wantLink: entities.Link{},
 			wantErr:  assert.Error,
 		},
+		{
+			name: "error with an expired link",
+			fields: fields{
+				Client: NewClient(opts.CacheAddress),
+			},
+			prepare: func(test *testing.T, client Client) {
+				err := client.innerClient.
+					Set(
+						"query",
+						`{"Code":"code","URL":"url","ExpirationTime":"2006-01-02T15:04:05Z"}`,
+						0,
+					).
+					Err()
+				require.NoError(test, err)
+			},
+			args:     args{"query"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkExpired, err, args)
+			},
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			data.prepare(test, data.fields.Client)
@@ -85,7 +107,7 @@ func TestLinkGetter_GetLink(test *testing.T) {
 			cache := LinkGetter{
 				Client: data.fields.Client,
 			}
-			gotLink, gotErr := cache.GetLink(data.args.query)
+			gotLink, gotErr := cache.GetLink(context.Background(), data.args.query)
 
 			assert.Equal(test, data.wantLink, gotLink)
 			data.wantErr(test, gotErr)
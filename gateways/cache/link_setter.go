@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -19,7 +20,27 @@ type LinkSetter struct {
 }
 
 // SetLink ...
-func (setter LinkSetter) SetLink(link entities.Link) error {
+//
+// The expiration of the link in Redis is capped at its remaining lifetime.
+//
+func (setter LinkSetter) SetLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	expiration := setter.Expiration
+	if link.ExpirationTime != nil {
+		remainingLifetime := time.Until(*link.ExpirationTime)
+		if remainingLifetime <= 0 {
+			// there is no sense to cache an expired link
+			return nil
+		}
+
+		// zero expiration means no expiration
+		if expiration == 0 || expiration > remainingLifetime {
+			expiration = remainingLifetime
+		}
+	}
+
 	data, err := json.Marshal(link)
 	if err != nil {
 		return errors.Wrap(err, "unable to marshal the link for Redis")
@@ -27,7 +48,8 @@ func (setter LinkSetter) SetLink(link entities.Link) error {
 
 	key := setter.KeyExtractor(link)
 	if err := setter.Client.innerClient.
-		Set(key, string(data), setter.Expiration).
+		WithContext(ctx).
+		Set(key, string(data), expiration).
 		Err(); err != nil {
 		return errors.Wrap(err, "unable to set the link in Redis")
 	}
@@ -3,10 +3,12 @@
 package cache
 
 import (
+	"context"
 	"testing"
 	"time"
 
-	"github.com/caarlos0/env"
+	"github.com/caarlos0/env/v6"
+	"github.com/go-redis/redis"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
@@ -63,6 +65,91 @@ func TestLinkSetter_SetLink(test *testing.T) {
 				assert.InDelta(test, time.Hour, duration, float64(10*time.Second))
 			},
 		},
+		{
+			name: "success with a link expiration less than the cache one",
+			fields: fields{
+				KeyExtractor: func(link entities.Link) string { return "key" },
+				Client:       NewClient(opts.CacheAddress),
+				Expiration:   time.Hour,
+			},
+			prepare: func(test *testing.T, client Client) {
+				err := client.innerClient.Del("key").Err()
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{
+					Code: "code",
+					URL:  "url",
+					ExpirationTime: func() *time.Time {
+						expirationTime := time.Now().Add(time.Minute)
+						return &expirationTime
+					}(),
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, client Client) {
+				duration, err := client.innerClient.TTL("key").Result()
+				require.NoError(test, err)
+
+				assert.InDelta(test, time.Minute, duration, float64(10*time.Second))
+			},
+		},
+		{
+			name: "success with a link expiration greater than the cache one",
+			fields: fields{
+				KeyExtractor: func(link entities.Link) string { return "key" },
+				Client:       NewClient(opts.CacheAddress),
+				Expiration:   time.Hour,
+			},
+			prepare: func(test *testing.T, client Client) {
+				err := client.innerClient.Del("key").Err()
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{
+					Code: "code",
+					URL:  "url",
+					ExpirationTime: func() *time.Time {
+						expirationTime := time.Now().Add(24 * time.Hour)
+						return &expirationTime
+					}(),
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, client Client) {
+				duration, err := client.innerClient.TTL("key").Result()
+				require.NoError(test, err)
+
+				assert.InDelta(test, time.Hour, duration, float64(10*time.Second))
+			},
+		},
+		{
+			name: "success with an expired link",
+			fields: fields{
+				KeyExtractor: func(link entities.Link) string { return "key" },
+				Client:       NewClient(opts.CacheAddress),
+				Expiration:   time.Hour,
+			},
+			prepare: func(test *testing.T, client Client) {
+				err := client.innerClient.Del("key").Err()
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{
+					Code: "code",
+					URL:  "url",
+					ExpirationTime: func() *time.Time {
+						expirationTime := time.Now().Add(-time.Minute)
+						return &expirationTime
+					}(),
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, client Client) {
+				_, err := client.innerClient.Get("key").Result()
+				assert.Equal(test, redis.Nil, err)
+			},
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			data.prepare(test, data.fields.Client)
@@ -72,7 +159,7 @@ func TestLinkSetter_SetLink(test *testing.T) {
 				Client:       data.fields.Client,
 				Expiration:   data.fields.Expiration,
 			}
-			gotErr := cache.SetLink(data.args.link)
+			gotErr := cache.SetLink(context.Background(), data.args.link)
 
 			data.wantErr(test, gotErr)
 			data.check(test, data.fields.Client)
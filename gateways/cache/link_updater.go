@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkUpdater ...
+type LinkUpdater struct {
+	KeyExtractor KeyExtractor
+	Client       Client
+}
+
+// UpdateLink ...
+//
+// It just invalidates the link in Redis, so the updated one will be got
+// from the storage.
+//
+func (updater LinkUpdater) UpdateLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	deleter := LinkDeleter{
+		KeyExtractor: updater.KeyExtractor,
+		Client:       updater.Client,
+	}
+	if err := deleter.DeleteLink(ctx, link); err != nil {
+		return errors.Wrap(err, "unable to invalidate the link in Redis")
+	}
+
+	return nil
+}
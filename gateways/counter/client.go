@@ -2,7 +2,7 @@ package counter
 
 import (
 	"github.com/pkg/errors"
-	"go.etcd.io/etcd/clientv3"
+	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 // Client ...
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
-	"go.etcd.io/etcd/clientv3"
+	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 // Counter ...
@@ -14,9 +14,9 @@ type Counter struct {
 }
 
 // NextCountChunk ...
-func (counter Counter) NextCountChunk() (uint64, error) {
+func (counter Counter) NextCountChunk(ctx context.Context) (uint64, error) {
 	response, err := counter.Client.innerClient.
-		Put(context.Background(), counter.Name, "", clientv3.WithPrevKV())
+		Put(ctx, counter.Name, "", clientv3.WithPrevKV())
 	if err != nil {
 		return 0, errors.Wrap(err, "unable to update the counter")
 	}
@@ -26,3 +26,21 @@ func (counter Counter) NextCountChunk() (uint64, error) {
 
 	return uint64(response.PrevKv.Version) + 1, nil
 }
+
+// PeekCountChunk ...
+//
+// It returns the count chunk, which will be returned by the next call
+// of the NextCountChunk method, without reserving it.
+//
+func (counter Counter) PeekCountChunk(ctx context.Context) (uint64, error) {
+	response, err := counter.Client.innerClient.
+		Get(ctx, counter.Name)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get the counter")
+	}
+	if len(response.Kvs) == 0 {
+		return 0, nil
+	}
+
+	return uint64(response.Kvs[0].Version) + 1, nil
+}
@@ -6,7 +6,7 @@ import (
 	"context"
 	"testing"
 
-	"github.com/caarlos0/env"
+	"github.com/caarlos0/env/v6"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -67,10 +67,39 @@ func TestCounter_NextCountChunk(test *testing.T) {
 				Name:   data.fields.name,
 			}
 			preparedData := data.prepare(test, counter)
-			gotChunk, gotErr := counter.NextCountChunk()
+			gotChunk, gotErr := counter.NextCountChunk(context.Background())
 
 			data.wantErr(test, gotErr)
 			data.check(test, preparedData, gotChunk)
 		})
 	}
 }
+
+func TestCounter_PeekCountChunk(test *testing.T) {
+	type options struct {
+		CounterAddress string `env:"COUNTER_ADDRESS" envDefault:"localhost:2379"`
+	}
+
+	var opts options
+	err := env.Parse(&opts)
+	require.NoError(test, err)
+
+	client, err := NewClient(opts.CounterAddress)
+	require.NoError(test, err)
+
+	counter := Counter{Client: client, Name: "counter"}
+	_, err = counter.NextCountChunk(context.Background())
+	require.NoError(test, err)
+
+	peekedChunk, err := counter.PeekCountChunk(context.Background())
+	assert.NoError(test, err)
+
+	// peeking shouldn't reserve the chunk
+	repeatedChunk, err := counter.PeekCountChunk(context.Background())
+	assert.NoError(test, err)
+	assert.Equal(test, peekedChunk, repeatedChunk)
+
+	gotChunk, err := counter.NextCountChunk(context.Background())
+	assert.NoError(test, err)
+	assert.Equal(test, peekedChunk, gotChunk)
+}
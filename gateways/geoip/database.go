@@ -0,0 +1,70 @@
+package geoip
+
+import (
+	"net"
+
+	"github.com/oschwald/geoip2-golang"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=countryReader --inpackage --case=underscore --testonly
+
+type countryReader interface {
+	Country(ip net.IP) (*geoip2.Country, error)
+	Close() error
+}
+
+// Database ...
+type Database struct {
+	reader countryReader
+}
+
+// NewDatabase ...
+//
+// It opens a local MaxMind database of the GeoIP2 or GeoLite2 format
+// with countries (e.g. GeoLite2-Country.mmdb or GeoLite2-City.mmdb).
+// If the path is empty, the database resolves no countries at all.
+//
+func NewDatabase(path string) (*Database, error) {
+	if path == "" {
+		return &Database{}, nil
+	}
+
+	reader, err := geoip2.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open the GeoIP database")
+	}
+
+	return &Database{reader: reader}, nil
+}
+
+// ResolveCountry ...
+//
+// It returns an ISO 3166-1 alpha-2 code of the country of the IP address
+// or an empty string if the country is unknown.
+//
+func (database *Database) ResolveCountry(ip net.IP) (string, error) {
+	if database.reader == nil {
+		return "", nil
+	}
+
+	country, err := database.reader.Country(ip)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to find the IP in the GeoIP database")
+	}
+
+	return country.Country.IsoCode, nil
+}
+
+// Close ...
+func (database *Database) Close() error {
+	if database.reader == nil {
+		return nil
+	}
+
+	if err := database.reader.Close(); err != nil {
+		return errors.Wrap(err, "unable to close the GeoIP database")
+	}
+
+	return nil
+}
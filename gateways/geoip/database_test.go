@@ -0,0 +1,163 @@
+package geoip
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/iotest"
+
+	"github.com/oschwald/geoip2-golang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDatabase(test *testing.T) {
+	test.Run("success without a path", func(test *testing.T) {
+		database, err := NewDatabase("")
+		require.NoError(test, err)
+
+		country, err := database.ResolveCountry(net.ParseIP("192.0.2.42"))
+		assert.Equal(test, "", country)
+		assert.NoError(test, err)
+
+		assert.NoError(test, database.Close())
+	})
+
+	test.Run("error with a missing file", func(test *testing.T) {
+		database, err := NewDatabase(filepath.Join(os.TempDir(), "missing.mmdb"))
+
+		assert.Nil(test, database)
+		assert.Error(test, err)
+	})
+
+	test.Run("error with an incorrect file", func(test *testing.T) {
+		file, err := ioutil.TempFile("", "database-*.mmdb")
+		require.NoError(test, err)
+		defer os.Remove(file.Name()) // nolint: errcheck
+
+		_, err = file.WriteString("incorrect")
+		require.NoError(test, err)
+		require.NoError(test, file.Close())
+
+		database, err := NewDatabase(file.Name())
+
+		assert.Nil(test, database)
+		assert.Error(test, err)
+	})
+}
+
+func TestDatabase_ResolveCountry(test *testing.T) {
+	type fields struct {
+		reader countryReader
+	}
+	type args struct {
+		ip net.IP
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				reader: func() countryReader {
+					country := new(geoip2.Country)
+					country.Country.IsoCode = "AT"
+
+					reader := new(mockCountryReader)
+					reader.On("Country", net.ParseIP("192.0.2.42")).Return(country, nil)
+
+					return reader
+				}(),
+			},
+			args:    args{net.ParseIP("192.0.2.42")},
+			want:    "AT",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with an unknown country",
+			fields: fields{
+				reader: func() countryReader {
+					reader := new(mockCountryReader)
+					reader.
+						On("Country", net.ParseIP("192.0.2.42")).
+						Return(new(geoip2.Country), nil)
+
+					return reader
+				}(),
+			},
+			args:    args{net.ParseIP("192.0.2.42")},
+			want:    "",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			fields: fields{
+				reader: func() countryReader {
+					reader := new(mockCountryReader)
+					reader.
+						On("Country", net.ParseIP("192.0.2.42")).
+						Return(nil, iotest.ErrTimeout)
+
+					return reader
+				}(),
+			},
+			args:    args{net.ParseIP("192.0.2.42")},
+			want:    "",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			database := &Database{reader: data.fields.reader}
+			got, gotErr := database.ResolveCountry(data.args.ip)
+
+			mock.AssertExpectationsForObjects(test, data.fields.reader)
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func TestDatabase_Close(test *testing.T) {
+	for _, data := range []struct {
+		name    string
+		reader  countryReader
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			reader: func() countryReader {
+				reader := new(mockCountryReader)
+				reader.On("Close").Return(nil)
+
+				return reader
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			reader: func() countryReader {
+				reader := new(mockCountryReader)
+				reader.On("Close").Return(iotest.ErrTimeout)
+
+				return reader
+			}(),
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			database := &Database{reader: data.reader}
+			gotErr := database.Close()
+
+			mock.AssertExpectationsForObjects(test, data.reader)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
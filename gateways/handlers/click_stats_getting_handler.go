@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	httputils "github.com/thewizardplusplus/go-http-utils"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=ClickStatsGetter --inpackage --case=underscore --testonly
+
+// ClickStatsGetter ...
+type ClickStatsGetter interface {
+	GetClickStats(ctx context.Context, code string) (entities.ClickStats, error)
+}
+
+//go:generate mockery --name=ClickStatsPresenter --inpackage --case=underscore --testonly
+
+// ClickStatsPresenter ...
+type ClickStatsPresenter interface {
+	PresentClickStats(
+		writer http.ResponseWriter,
+		request *http.Request,
+		stats entities.ClickStats,
+	)
+}
+
+// ClickStatsGettingHandler ...
+//
+// Clicks are stored independently of links, so stats are available
+// even for expired links; for unknown codes, they are just empty.
+//
+type ClickStatsGettingHandler struct {
+	ClickStatsGetter    ClickStatsGetter
+	ClickStatsPresenter ClickStatsPresenter
+	ErrorPresenter      ErrorPresenter
+}
+
+// @router /links/{serverID}:{code}/stats [GET]
+// @param serverID path string true "server ID"
+// @param code path string true "link code"
+// @produce json
+// @success 200 {object} entities.ClickStats
+// @failure 400 {object} presenters.ErrorResponse
+// @failure 500 {object} presenters.ErrorResponse
+// @failure 503 {object} presenters.ErrorResponse
+// @failure 504 {object} presenters.ErrorResponse
+func (handler ClickStatsGettingHandler) _(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+}
+
+// ServeHTTP ...
+//   @router /links/{code}/stats [GET]
+//   @param code path string true "link code"
+//   @produce json
+//   @success 200 {object} entities.ClickStats
+//   @failure 400 {object} presenters.ErrorResponse
+//   @failure 500 {object} presenters.ErrorResponse
+//   @failure 503 {object} presenters.ErrorResponse
+//   @failure 504 {object} presenters.ErrorResponse
+func (handler ClickStatsGettingHandler) ServeHTTP(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	var code string
+	if err := httputils.ParsePathParameter(request, "code", &code); err != nil {
+		const statusCode = http.StatusBadRequest
+		err = errors.Wrap(err, "unable to decode the path parameter")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+
+		return
+	}
+
+	stats, err :=
+		handler.ClickStatsGetter.GetClickStats(request.Context(), code)
+	if err != nil {
+		statusCode := failureStatusCode(err)
+		err = errors.Wrap(err, "unable to get the click stats")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+
+		return
+	}
+
+	handler.ClickStatsPresenter.PresentClickStats(writer, request, stats)
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestClickStatsGettingHandler_ServeHTTP(test *testing.T) {
+	type fields struct {
+		ClickStatsGetter    ClickStatsGetter
+		ClickStatsPresenter ClickStatsPresenter
+		ErrorPresenter      ErrorPresenter
+	}
+	type args struct {
+		request *http.Request
+	}
+
+	makeRequest := func() *http.Request {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+		request = mux.SetURLVars(request, map[string]string{"code": "code"})
+
+		return request
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		{
+			name: "success",
+			fields: fields{
+				ClickStatsGetter: func() ClickStatsGetter {
+					getter := new(MockClickStatsGetter)
+					getter.
+						On("GetClickStats", mock.Anything, "code").
+						Return(entities.ClickStats{Code: "code", TotalCount: 1}, nil)
+
+					return getter
+				}(),
+				ClickStatsPresenter: func() ClickStatsPresenter {
+					presenter := new(MockClickStatsPresenter)
+					presenter.On(
+						"PresentClickStats",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						makeRequest(),
+						entities.ClickStats{Code: "code", TotalCount: 1},
+					)
+
+					return presenter
+				}(),
+				ErrorPresenter: new(MockErrorPresenter),
+			},
+			args: args{
+				request: makeRequest(),
+			},
+		},
+		{
+			name: "error with path parameter decoding",
+			fields: fields{
+				ClickStatsGetter:    new(MockClickStatsGetter),
+				ClickStatsPresenter: new(MockClickStatsPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusBadRequest,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: httptest.NewRequest(http.MethodGet, "http://example.com/", nil),
+			},
+		},
+		{
+			name: "error with getting",
+			fields: fields{
+				ClickStatsGetter: func() ClickStatsGetter {
+					getter := new(MockClickStatsGetter)
+					getter.
+						On("GetClickStats", mock.Anything, "code").
+						Return(entities.ClickStats{}, iotest.ErrTimeout)
+
+					return getter
+				}(),
+				ClickStatsPresenter: new(MockClickStatsPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						makeRequest(),
+						http.StatusInternalServerError,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: makeRequest(),
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			writer := httptest.NewRecorder()
+			handler := ClickStatsGettingHandler{
+				ClickStatsGetter:    data.fields.ClickStatsGetter,
+				ClickStatsPresenter: data.fields.ClickStatsPresenter,
+				ErrorPresenter:      data.fields.ErrorPresenter,
+			}
+			handler.ServeHTTP(writer, data.args.request)
+
+			response := writer.Result()
+			responseBody, _ := ioutil.ReadAll(response.Body)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.ClickStatsGetter,
+				data.fields.ClickStatsPresenter,
+				data.fields.ErrorPresenter,
+			)
+			assert.Empty(test, responseBody)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
+)
+
+// it selects a status code for an unexpected error; timeouts, cancellations
+// and open circuits of backend calls are reported apart from other failures,
+// so clients are able to retry them
+func failureStatusCode(err error) int {
+	switch errors.Cause(err) {
+	case context.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case context.Canceled, breakers.ErrOpenCircuit:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
+)
+
+func Test_failureStatusCode(test *testing.T) {
+	for _, data := range []struct {
+		name string
+		err  error
+		want int
+	}{
+		{
+			name: "timeout",
+			err:  errors.Wrap(context.DeadlineExceeded, "dummy"),
+			want: http.StatusGatewayTimeout,
+		},
+		{
+			name: "cancellation",
+			err:  errors.Wrap(context.Canceled, "dummy"),
+			want: http.StatusServiceUnavailable,
+		},
+		{
+			name: "open circuit",
+			err:  errors.Wrap(breakers.ErrOpenCircuit, "dummy"),
+			want: http.StatusServiceUnavailable,
+		},
+		{
+			name: "other error",
+			err:  errors.Wrap(iotest.ErrTimeout, "dummy"),
+			want: http.StatusInternalServerError,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := failureStatusCode(data.err)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
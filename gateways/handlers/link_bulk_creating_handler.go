@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	httputils "github.com/thewizardplusplus/go-http-utils"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=LinkBulkCreator --inpackage --case=underscore --testonly
+
+// LinkBulkCreator ...
+type LinkBulkCreator interface {
+	CreateLinks(
+		ctx context.Context,
+		links []entities.Link,
+	) ([]entities.LinkResult, error)
+}
+
+//go:generate mockery --name=LinkResultsPresenter --inpackage --case=underscore --testonly
+
+// LinkResultsPresenter ...
+type LinkResultsPresenter interface {
+	PresentLinkResults(
+		writer http.ResponseWriter,
+		request *http.Request,
+		results []entities.LinkResult,
+	)
+}
+
+// LinkBulkCreatingHandler ...
+type LinkBulkCreatingHandler struct {
+	LinkBulkCreator      LinkBulkCreator
+	LinkResultsPresenter LinkResultsPresenter
+	ErrorPresenter       ErrorPresenter
+}
+
+// LinkBulkCreatingRequest ...
+//
+// It's public only for docs generating.
+type LinkBulkCreatingRequest struct {
+	Links []LinkCreatingRequest
+}
+
+// ServeHTTP ...
+//
+// The results follow the order of the requested links. Errors of particular
+// links are returned in their results, so the whole request succeeds even if
+// some links aren't created.
+//
+//   @router /links/bulk [POST]
+//   @accept json
+//   @param data body handlers.LinkBulkCreatingRequest true "links data"
+//   @produce json
+//   @success 200 {array} presenters.LinkResultResponse
+//   @failure 400 {object} presenters.ErrorResponse
+//   @failure 500 {object} presenters.ErrorResponse
+//   @failure 503 {object} presenters.ErrorResponse
+//   @failure 504 {object} presenters.ErrorResponse
+func (handler LinkBulkCreatingHandler) ServeHTTP(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	var data LinkBulkCreatingRequest
+	if err := httputils.ReadJSON(request.Body, &data); err != nil {
+		const statusCode = http.StatusBadRequest
+		err = errors.Wrap(err, "unable to decode the request body")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+
+		return
+	}
+
+	links := make([]entities.Link, 0, len(data.Links))
+	for _, linkData := range data.Links {
+		links = append(links, linkData.makeLink())
+	}
+
+	results, err :=
+		handler.LinkBulkCreator.CreateLinks(request.Context(), links)
+	if err != nil {
+		statusCode := failureStatusCode(err)
+		if errors.Cause(err) == entities.ErrInvalidLink {
+			statusCode = http.StatusBadRequest
+		}
+
+		err = errors.Wrap(err, "unable to create the links")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+
+		return
+	}
+
+	handler.LinkResultsPresenter.PresentLinkResults(writer, request, results)
+}
@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkBulkCreatingHandler_ServeHTTP(test *testing.T) {
+	type fields struct {
+		LinkBulkCreator      LinkBulkCreator
+		LinkResultsPresenter LinkResultsPresenter
+		ErrorPresenter       ErrorPresenter
+	}
+	type args struct {
+		request *http.Request
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		{
+			name: "success",
+			fields: fields{
+				LinkBulkCreator: func() LinkBulkCreator {
+					creator := new(MockLinkBulkCreator)
+					creator.
+						On("CreateLinks", mock.Anything, []entities.Link{{URL: "url #1"}, {Code: "alias", URL: "url #2"}}).
+						Return([]entities.LinkResult{
+							{Link: entities.Link{Code: "code", URL: "url #1"}},
+							{Err: entities.ErrLinkConflict},
+						}, nil)
+
+					return creator
+				}(),
+				LinkResultsPresenter: func() LinkResultsPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/api/v1/links/bulk",
+						bytes.NewBufferString(`{"Links":[{"URL":"url #1"},{"URL":"url #2","Code":"alias"}]}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockLinkResultsPresenter)
+					presenter.On(
+						"PresentLinkResults",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						[]entities.LinkResult{
+							{Link: entities.Link{Code: "code", URL: "url #1"}},
+							{Err: entities.ErrLinkConflict},
+						},
+					)
+
+					return presenter
+				}(),
+				ErrorPresenter: new(MockErrorPresenter),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/api/v1/links/bulk",
+					bytes.NewBufferString(`{"Links":[{"URL":"url #1"},{"URL":"url #2","Code":"alias"}]}`),
+				),
+			},
+		},
+		{
+			name: "error with decoding",
+			fields: fields{
+				LinkBulkCreator:      new(MockLinkBulkCreator),
+				LinkResultsPresenter: new(MockLinkResultsPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/api/v1/links/bulk",
+						bytes.NewBufferString("incorrect"),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusBadRequest,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/api/v1/links/bulk",
+					bytes.NewBufferString("incorrect"),
+				),
+			},
+		},
+		{
+			name: "error with creating (with too many links)",
+			fields: fields{
+				LinkBulkCreator: func() LinkBulkCreator {
+					creator := new(MockLinkBulkCreator)
+					creator.
+						On("CreateLinks", mock.Anything, []entities.Link{{URL: "url #1"}, {Code: "alias", URL: "url #2"}}).
+						Return(nil, errors.Wrap(entities.ErrInvalidLink, "too many links"))
+
+					return creator
+				}(),
+				LinkResultsPresenter: new(MockLinkResultsPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/api/v1/links/bulk",
+						bytes.NewBufferString(`{"Links":[{"URL":"url #1"},{"URL":"url #2","Code":"alias"}]}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusBadRequest,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/api/v1/links/bulk",
+					bytes.NewBufferString(`{"Links":[{"URL":"url #1"},{"URL":"url #2","Code":"alias"}]}`),
+				),
+			},
+		},
+		{
+			name: "error with creating (with an unknown error)",
+			fields: fields{
+				LinkBulkCreator: func() LinkBulkCreator {
+					creator := new(MockLinkBulkCreator)
+					creator.
+						On("CreateLinks", mock.Anything, []entities.Link{{URL: "url #1"}, {Code: "alias", URL: "url #2"}}).
+						Return(nil, iotest.ErrTimeout)
+
+					return creator
+				}(),
+				LinkResultsPresenter: new(MockLinkResultsPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/api/v1/links/bulk",
+						bytes.NewBufferString(`{"Links":[{"URL":"url #1"},{"URL":"url #2","Code":"alias"}]}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusInternalServerError,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/api/v1/links/bulk",
+					bytes.NewBufferString(`{"Links":[{"URL":"url #1"},{"URL":"url #2","Code":"alias"}]}`),
+				),
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			writer := httptest.NewRecorder()
+			handler := LinkBulkCreatingHandler{
+				LinkBulkCreator:      data.fields.LinkBulkCreator,
+				LinkResultsPresenter: data.fields.LinkResultsPresenter,
+				ErrorPresenter:       data.fields.ErrorPresenter,
+			}
+			handler.ServeHTTP(writer, data.args.request)
+
+			response := writer.Result()
+			responseBody, _ := ioutil.ReadAll(response.Body)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkBulkCreator,
+				data.fields.LinkResultsPresenter,
+				data.fields.ErrorPresenter,
+			)
+			assert.Empty(test, responseBody)
+		})
+	}
+}
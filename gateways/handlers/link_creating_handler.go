@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	httputils "github.com/thewizardplusplus/go-http-utils"
@@ -12,7 +14,7 @@ import (
 
 // LinkCreator ...
 type LinkCreator interface {
-	CreateLink(url string) (entities.Link, error)
+	CreateLink(ctx context.Context, link entities.Link) (entities.Link, error)
 }
 
 // LinkCreatingHandler ...
@@ -26,7 +28,27 @@ type LinkCreatingHandler struct {
 //
 // It's public only for docs generating.
 type LinkCreatingRequest struct {
-	URL string
+	URL             string
+	Code            string                  `json:",omitempty"`
+	ExpirationTime  *time.Time              `json:",omitempty"`
+	RedirectCode    int                     `json:",omitempty"`
+	QueryForwarding string                  `json:",omitempty"`
+	PathForwarding  bool                    `json:",omitempty"`
+	Rules           []entities.RedirectRule `json:",omitempty"`
+	Variants        []entities.Variant      `json:",omitempty"`
+}
+
+func (data LinkCreatingRequest) makeLink() entities.Link {
+	return entities.Link{
+		Code:            data.Code,
+		URL:             data.URL,
+		ExpirationTime:  data.ExpirationTime,
+		RedirectCode:    data.RedirectCode,
+		QueryForwarding: data.QueryForwarding,
+		PathForwarding:  data.PathForwarding,
+		Rules:           data.Rules,
+		Variants:        data.Variants,
+	}
 }
 
 // ServeHTTP ...
@@ -36,7 +58,12 @@ type LinkCreatingRequest struct {
 //   @produce json
 //   @success 200 {object} entities.Link
 //   @failure 400 {object} presenters.ErrorResponse
+//   @failure 403 {object} presenters.ErrorResponse
+//   @failure 409 {object} presenters.ErrorResponse
+//   @failure 410 {object} presenters.ErrorResponse
 //   @failure 500 {object} presenters.ErrorResponse
+//   @failure 503 {object} presenters.ErrorResponse
+//   @failure 504 {object} presenters.ErrorResponse
 func (handler LinkCreatingHandler) ServeHTTP(
 	writer http.ResponseWriter,
 	request *http.Request,
@@ -50,9 +77,23 @@ func (handler LinkCreatingHandler) ServeHTTP(
 		return
 	}
 
-	link, err := handler.LinkCreator.CreateLink(data.URL)
+	link, err :=
+		handler.LinkCreator.CreateLink(request.Context(), data.makeLink())
 	if err != nil {
-		const statusCode = http.StatusInternalServerError
+		var statusCode int
+		switch errors.Cause(err) {
+		case entities.ErrInvalidLink:
+			statusCode = http.StatusBadRequest
+		case entities.ErrLinkConflict:
+			statusCode = http.StatusConflict
+		case entities.ErrLinkBlocked:
+			statusCode = http.StatusForbidden
+		case entities.ErrLinkDisabled:
+			statusCode = http.StatusGone
+		default:
+			statusCode = failureStatusCode(err)
+		}
+
 		err = errors.Wrap(err, "unable to create the link")
 		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
 
@@ -2,12 +2,15 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"testing/iotest"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
@@ -34,7 +37,7 @@ func TestLinkCreatingHandler_ServeHTTP(test *testing.T) {
 				LinkCreator: func() LinkCreator {
 					creator := new(MockLinkCreator)
 					creator.
-						On("CreateLink", "url").
+						On("CreateLink", mock.Anything, entities.Link{URL: "url"}).
 						Return(entities.Link{Code: "code", URL: "url"}, nil)
 
 					return creator
@@ -70,6 +73,272 @@ func TestLinkCreatingHandler_ServeHTTP(test *testing.T) {
 				),
 			},
 		},
+		{
+			name: "success with an alias",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{Code: "alias", URL: "url"}).
+						Return(entities.Link{Code: "alias", URL: "url"}, nil)
+
+					return creator
+				}(),
+				LinkPresenter: func() LinkPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(`{"URL":"url","Code":"alias"}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockLinkPresenter)
+					presenter.On(
+						"PresentLink",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						entities.Link{Code: "alias", URL: "url"},
+					)
+
+					return presenter
+				}(),
+				ErrorPresenter: new(MockErrorPresenter),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(`{"URL":"url","Code":"alias"}`),
+				),
+			},
+		},
+		{
+			name: "success with a redirect code",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{Code: "alias", URL: "url", RedirectCode: http.StatusFound}).
+						Return(entities.Link{Code: "alias", URL: "url", RedirectCode: http.StatusFound}, nil)
+
+					return creator
+				}(),
+				LinkPresenter: func() LinkPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(`{"URL":"url","Code":"alias","RedirectCode":302}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockLinkPresenter)
+					presenter.On(
+						"PresentLink",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						entities.Link{Code: "alias", URL: "url", RedirectCode: http.StatusFound},
+					)
+
+					return presenter
+				}(),
+				ErrorPresenter: new(MockErrorPresenter),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(`{"URL":"url","Code":"alias","RedirectCode":302}`),
+				),
+			},
+		},
+		{
+			name: "success with forwarding",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{Code: "alias", URL: "url", QueryForwarding: entities.MergeQueryForwarding, PathForwarding: true}).
+						Return(entities.Link{Code: "alias", URL: "url", QueryForwarding: entities.MergeQueryForwarding, PathForwarding: true}, nil)
+
+					return creator
+				}(),
+				LinkPresenter: func() LinkPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(`{"URL":"url","Code":"alias","QueryForwarding":"merge","PathForwarding":true}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockLinkPresenter)
+					presenter.On(
+						"PresentLink",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						entities.Link{Code: "alias", URL: "url", QueryForwarding: entities.MergeQueryForwarding, PathForwarding: true},
+					)
+
+					return presenter
+				}(),
+				ErrorPresenter: new(MockErrorPresenter),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(`{"URL":"url","Code":"alias","QueryForwarding":"merge","PathForwarding":true}`),
+				),
+			},
+		},
+		{
+			name: "success with rules",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{Code: "alias", URL: "url", Rules: []entities.RedirectRule{{OSes: []string{"ios"}, URL: "url #1"}}}).
+						Return(entities.Link{Code: "alias", URL: "url", Rules: []entities.RedirectRule{{OSes: []string{"ios"}, URL: "url #1"}}}, nil)
+
+					return creator
+				}(),
+				LinkPresenter: func() LinkPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(`{"URL":"url","Code":"alias","Rules":[{"OSes":["ios"],"URL":"url #1"}]}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockLinkPresenter)
+					presenter.On(
+						"PresentLink",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						entities.Link{Code: "alias", URL: "url", Rules: []entities.RedirectRule{{OSes: []string{"ios"}, URL: "url #1"}}},
+					)
+
+					return presenter
+				}(),
+				ErrorPresenter: new(MockErrorPresenter),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(`{"URL":"url","Code":"alias","Rules":[{"OSes":["ios"],"URL":"url #1"}]}`),
+				),
+			},
+		},
+		{
+			name: "success with variants",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{Code: "alias", URL: "url", Variants: []entities.Variant{{Name: "a", URL: "url #1", Weight: 1}}}).
+						Return(entities.Link{Code: "alias", URL: "url", Variants: []entities.Variant{{Name: "a", URL: "url #1", Weight: 1}}}, nil)
+
+					return creator
+				}(),
+				LinkPresenter: func() LinkPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(`{"URL":"url","Code":"alias","Variants":[{"Name":"a","URL":"url #1","Weight":1}]}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockLinkPresenter)
+					presenter.On(
+						"PresentLink",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						entities.Link{Code: "alias", URL: "url", Variants: []entities.Variant{{Name: "a", URL: "url #1", Weight: 1}}},
+					)
+
+					return presenter
+				}(),
+				ErrorPresenter: new(MockErrorPresenter),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(`{"URL":"url","Code":"alias","Variants":[{"Name":"a","URL":"url #1","Weight":1}]}`),
+				),
+			},
+		},
+		{
+			name: "success with an expiration time",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					expirationTime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{
+							URL:            "url",
+							ExpirationTime: &expirationTime,
+						}).
+						Return(
+							entities.Link{Code: "code", URL: "url", ExpirationTime: &expirationTime},
+							nil,
+						)
+
+					return creator
+				}(),
+				LinkPresenter: func() LinkPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(
+							`{"URL":"url","ExpirationTime":"2006-01-02T15:04:05Z"}`,
+						),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					expirationTime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+					presenter := new(MockLinkPresenter)
+					presenter.On(
+						"PresentLink",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						entities.Link{Code: "code", URL: "url", ExpirationTime: &expirationTime},
+					)
+
+					return presenter
+				}(),
+				ErrorPresenter: new(MockErrorPresenter),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(
+						`{"URL":"url","ExpirationTime":"2006-01-02T15:04:05Z"}`,
+					),
+				),
+			},
+		},
 		{
 			name: "error with decoding",
 			fields: fields{
@@ -111,7 +380,9 @@ func TestLinkCreatingHandler_ServeHTTP(test *testing.T) {
 			fields: fields{
 				LinkCreator: func() LinkCreator {
 					creator := new(MockLinkCreator)
-					creator.On("CreateLink", "url").Return(entities.Link{}, iotest.ErrTimeout)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{URL: "url"}).
+						Return(entities.Link{}, iotest.ErrTimeout)
 
 					return creator
 				}(),
@@ -147,6 +418,221 @@ func TestLinkCreatingHandler_ServeHTTP(test *testing.T) {
 				),
 			},
 		},
+		{
+			name: "error with creating (timeout)",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{URL: "url"}).
+						Return(entities.Link{}, errors.Wrap(context.DeadlineExceeded, "dummy"))
+
+					return creator
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(`{"URL":"url"}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusGatewayTimeout,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(`{"URL":"url"}`),
+				),
+			},
+		},
+		{
+			name: "error with creating (invalid link)",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{Code: "alias", URL: "url"}).
+						Return(entities.Link{}, errors.Wrap(entities.ErrInvalidLink, "unable to check the code"))
+
+					return creator
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(`{"URL":"url","Code":"alias"}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusBadRequest,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(`{"URL":"url","Code":"alias"}`),
+				),
+			},
+		},
+		{
+			name: "error with creating (link conflict)",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{Code: "alias", URL: "url"}).
+						Return(entities.Link{}, errors.Wrap(entities.ErrLinkConflict, "unable to check the code"))
+
+					return creator
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(`{"URL":"url","Code":"alias"}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusConflict,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(`{"URL":"url","Code":"alias"}`),
+				),
+			},
+		},
+		{
+			name: "error with creating (blocked link)",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{URL: "url"}).
+						Return(entities.Link{}, errors.Wrap(entities.ErrLinkBlocked, "unable to check the URL"))
+
+					return creator
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(`{"URL":"url"}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusForbidden,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(`{"URL":"url"}`),
+				),
+			},
+		},
+		{
+			name: "error with creating (disabled link)",
+			fields: fields{
+				LinkCreator: func() LinkCreator {
+					creator := new(MockLinkCreator)
+					creator.
+						On("CreateLink", mock.Anything, entities.Link{URL: "url"}).
+						Return(entities.Link{}, errors.Wrap(entities.ErrLinkDisabled, "unable to get the link"))
+
+					return creator
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/",
+						bytes.NewBufferString(`{"URL":"url"}`),
+					)
+
+					// we should read the request body
+					// to set up the request to the required state
+					ioutil.ReadAll(request.Body)
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusGone,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/",
+					bytes.NewBufferString(`{"URL":"url"}`),
+				),
+			},
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			writer := httptest.NewRecorder()
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/pkg/errors"
+	httputils "github.com/thewizardplusplus/go-http-utils"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=LinkRemover --inpackage --case=underscore --testonly
+
+// LinkRemover ...
+type LinkRemover interface {
+	RemoveLink(ctx context.Context, code string) (entities.Link, error)
+}
+
+// LinkDeletingHandler ...
+type LinkDeletingHandler struct {
+	LinkRemover    LinkRemover
+	LinkPresenter  LinkPresenter
+	ErrorPresenter ErrorPresenter
+}
+
+// @router /links/{serverID}:{code} [DELETE]
+// @param serverID path string true "server ID"
+// @param code path string true "link code"
+// @produce json
+// @success 200 {object} entities.Link
+// @failure 400 {object} presenters.ErrorResponse
+// @failure 404 {object} presenters.ErrorResponse
+// @failure 410 {object} presenters.ErrorResponse
+// @failure 500 {object} presenters.ErrorResponse
+// @failure 503 {object} presenters.ErrorResponse
+// @failure 504 {object} presenters.ErrorResponse
+func (handler LinkDeletingHandler) _(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+}
+
+// ServeHTTP ...
+//   @router /links/{code} [DELETE]
+//   @param code path string true "link code"
+//   @produce json
+//   @success 200 {object} entities.Link
+//   @failure 400 {object} presenters.ErrorResponse
+//   @failure 404 {object} presenters.ErrorResponse
+//   @failure 410 {object} presenters.ErrorResponse
+//   @failure 500 {object} presenters.ErrorResponse
+//   @failure 503 {object} presenters.ErrorResponse
+//   @failure 504 {object} presenters.ErrorResponse
+func (handler LinkDeletingHandler) ServeHTTP(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	var code string
+	if err := httputils.ParsePathParameter(request, "code", &code); err != nil {
+		const statusCode = http.StatusBadRequest
+		err = errors.Wrap(err, "unable to decode the path parameter")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+
+		return
+	}
+
+	link, err := handler.LinkRemover.RemoveLink(request.Context(), code)
+	switch errors.Cause(err) {
+	case nil:
+		handler.LinkPresenter.PresentLink(writer, request, link)
+	case sql.ErrNoRows:
+		const statusCode = http.StatusNotFound
+		err = errors.New("unable to find the link")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+	case entities.ErrLinkExpired:
+		const statusCode = http.StatusGone
+		err = errors.New("the link has expired")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+	default:
+		statusCode := failureStatusCode(err)
+		err = errors.Wrap(err, "unable to delete the link")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+	}
+}
@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"database/sql"
 	"net/http"
 
+	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	httputils "github.com/thewizardplusplus/go-http-utils"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
@@ -13,7 +15,7 @@ import (
 
 // LinkGetter ...
 type LinkGetter interface {
-	GetLink(code string) (entities.Link, error)
+	GetLink(ctx context.Context, code string) (entities.Link, error)
 }
 
 //go:generate mockery --name=LinkPresenter --inpackage --case=underscore --testonly
@@ -53,7 +55,10 @@ type LinkGettingHandler struct {
 // @success 200 {object} entities.Link
 // @failure 400 {object} presenters.ErrorResponse
 // @failure 404 {object} presenters.ErrorResponse
+// @failure 410 {object} presenters.ErrorResponse
 // @failure 500 {object} presenters.ErrorResponse
+// @failure 503 {object} presenters.ErrorResponse
+// @failure 504 {object} presenters.ErrorResponse
 func (handler LinkGettingHandler) _(
 	writer http.ResponseWriter,
 	request *http.Request,
@@ -67,7 +72,10 @@ func (handler LinkGettingHandler) _(
 //   @success 200 {object} entities.Link
 //   @failure 400 {object} presenters.ErrorResponse
 //   @failure 404 {object} presenters.ErrorResponse
+//   @failure 410 {object} presenters.ErrorResponse
 //   @failure 500 {object} presenters.ErrorResponse
+//   @failure 503 {object} presenters.ErrorResponse
+//   @failure 504 {object} presenters.ErrorResponse
 func (handler LinkGettingHandler) ServeHTTP(
 	writer http.ResponseWriter,
 	request *http.Request,
@@ -81,16 +89,40 @@ func (handler LinkGettingHandler) ServeHTTP(
 		return
 	}
 
-	link, err := handler.LinkGetter.GetLink(code)
-	switch err {
+	link, err := handler.LinkGetter.GetLink(request.Context(), code)
+	switch errors.Cause(err) {
 	case nil:
+		if link.Disabled {
+			const statusCode = http.StatusGone
+			err = errors.New("the link has been disabled")
+			handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+
+			return
+		}
+		// the path suffix is allowed only if the link forwards it
+		if mux.Vars(request)["path"] != "" && !link.PathForwarding {
+			const statusCode = http.StatusNotFound
+			err = errors.New("the link doesn't forward the path")
+			handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+
+			return
+		}
+
 		handler.LinkPresenter.PresentLink(writer, request, link)
 	case sql.ErrNoRows:
 		const statusCode = http.StatusNotFound
 		err = errors.New("unable to find the link")
 		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+	case entities.ErrLinkExpired:
+		const statusCode = http.StatusGone
+		err = errors.New("the link has expired")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+	case entities.ErrLinkBlocked:
+		const statusCode = http.StatusForbidden
+		err = errors.New("the link has been blocked")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
 	default:
-		const statusCode = http.StatusInternalServerError
+		statusCode := failureStatusCode(err)
 		err = errors.Wrap(err, "unable to get the link")
 		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
 	}
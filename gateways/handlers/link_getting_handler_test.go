@@ -9,6 +9,7 @@ import (
 	"testing/iotest"
 
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
@@ -35,7 +36,7 @@ func TestLinkGettingHandler_ServeHTTP(test *testing.T) {
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
 					getter.
-						On("GetLink", "code").
+						On("GetLink", mock.Anything, "code").
 						Return(entities.Link{Code: "code", URL: "url"}, nil)
 
 					return getter
@@ -65,6 +66,42 @@ func TestLinkGettingHandler_ServeHTTP(test *testing.T) {
 				}(),
 			},
 		},
+		{
+			name: "success with a path suffix",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", mock.Anything, "code").
+						Return(entities.Link{Code: "code", URL: "url", PathForwarding: true}, nil)
+
+					return getter
+				}(),
+				LinkPresenter: func() LinkPresenter {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+					request = mux.SetURLVars(request, map[string]string{"code": "code", "path": "extra/path"})
+
+					presenter := new(MockLinkPresenter)
+					presenter.On(
+						"PresentLink",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						entities.Link{Code: "code", URL: "url", PathForwarding: true},
+					)
+
+					return presenter
+				}(),
+				ErrorPresenter: new(MockErrorPresenter),
+			},
+			args: args{
+				request: func() *http.Request {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+					request = mux.SetURLVars(request, map[string]string{"code": "code", "path": "extra/path"})
+
+					return request
+				}(),
+			},
+		},
 		{
 			name: "error with path parameter decoding",
 			fields: fields{
@@ -94,7 +131,7 @@ func TestLinkGettingHandler_ServeHTTP(test *testing.T) {
 			fields: fields{
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
-					getter.On("GetLink", "code").Return(entities.Link{}, sql.ErrNoRows)
+					getter.On("GetLink", mock.Anything, "code").Return(entities.Link{}, sql.ErrNoRows)
 
 					return getter
 				}(),
@@ -124,12 +161,166 @@ func TestLinkGettingHandler_ServeHTTP(test *testing.T) {
 				}(),
 			},
 		},
+		{
+			name: "error with expiration",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", mock.Anything, "code").
+						Return(
+							entities.Link{},
+							errors.Wrap(entities.ErrLinkExpired, "unable to get the link"),
+						)
+
+					return getter
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+					request = mux.SetURLVars(request, map[string]string{"code": "code"})
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusGone,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: func() *http.Request {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+					request = mux.SetURLVars(request, map[string]string{"code": "code"})
+
+					return request
+				}(),
+			},
+		},
+		{
+			name: "error with blocking",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", mock.Anything, "code").
+						Return(
+							entities.Link{},
+							errors.Wrap(entities.ErrLinkBlocked, "unable to check the link URL"),
+						)
+
+					return getter
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+					request = mux.SetURLVars(request, map[string]string{"code": "code"})
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusForbidden,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: func() *http.Request {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+					request = mux.SetURLVars(request, map[string]string{"code": "code"})
+
+					return request
+				}(),
+			},
+		},
+		{
+			name: "error with disabling",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", mock.Anything, "code").
+						Return(entities.Link{Code: "code", URL: "url", Disabled: true}, nil)
+
+					return getter
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+					request = mux.SetURLVars(request, map[string]string{"code": "code"})
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusGone,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: func() *http.Request {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+					request = mux.SetURLVars(request, map[string]string{"code": "code"})
+
+					return request
+				}(),
+			},
+		},
+		{
+			name: "error with a path suffix",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", mock.Anything, "code").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: func() ErrorPresenter {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+					request = mux.SetURLVars(request, map[string]string{"code": "code", "path": "extra/path"})
+
+					presenter := new(MockErrorPresenter)
+					presenter.On(
+						"PresentError",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						request,
+						http.StatusNotFound,
+						mock.MatchedBy(func(error) bool { return true }),
+					)
+
+					return presenter
+				}(),
+			},
+			args: args{
+				request: func() *http.Request {
+					request := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+					request = mux.SetURLVars(request, map[string]string{"code": "code", "path": "extra/path"})
+
+					return request
+				}(),
+			},
+		},
 		{
 			name: "error with getting",
 			fields: fields{
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
-					getter.On("GetLink", "code").Return(entities.Link{}, iotest.ErrTimeout)
+					getter.On("GetLink", mock.Anything, "code").Return(entities.Link{}, iotest.ErrTimeout)
 
 					return getter
 				}(),
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/pkg/errors"
+	httputils "github.com/thewizardplusplus/go-http-utils"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=LinkDisabler --inpackage --case=underscore --testonly
+
+// LinkDisabler ...
+type LinkDisabler interface {
+	DisableLink(
+		ctx context.Context,
+		code string,
+		disabled bool,
+	) (entities.Link, error)
+}
+
+// LinkUpdatingHandler ...
+type LinkUpdatingHandler struct {
+	LinkDisabler   LinkDisabler
+	LinkPresenter  LinkPresenter
+	ErrorPresenter ErrorPresenter
+}
+
+// LinkUpdatingRequest ...
+//
+// It's public only for docs generating.
+type LinkUpdatingRequest struct {
+	Disabled *bool
+}
+
+// @router /links/{serverID}:{code} [PATCH]
+// @param serverID path string true "server ID"
+// @param code path string true "link code"
+// @accept json
+// @param data body handlers.LinkUpdatingRequest true "link data"
+// @produce json
+// @success 200 {object} entities.Link
+// @failure 400 {object} presenters.ErrorResponse
+// @failure 404 {object} presenters.ErrorResponse
+// @failure 410 {object} presenters.ErrorResponse
+// @failure 500 {object} presenters.ErrorResponse
+// @failure 503 {object} presenters.ErrorResponse
+// @failure 504 {object} presenters.ErrorResponse
+func (handler LinkUpdatingHandler) _(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+}
+
+// ServeHTTP ...
+//   @router /links/{code} [PATCH]
+//   @param code path string true "link code"
+//   @accept json
+//   @param data body handlers.LinkUpdatingRequest true "link data"
+//   @produce json
+//   @success 200 {object} entities.Link
+//   @failure 400 {object} presenters.ErrorResponse
+//   @failure 404 {object} presenters.ErrorResponse
+//   @failure 410 {object} presenters.ErrorResponse
+//   @failure 500 {object} presenters.ErrorResponse
+//   @failure 503 {object} presenters.ErrorResponse
+//   @failure 504 {object} presenters.ErrorResponse
+func (handler LinkUpdatingHandler) ServeHTTP(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	var code string
+	if err := httputils.ParsePathParameter(request, "code", &code); err != nil {
+		const statusCode = http.StatusBadRequest
+		err = errors.Wrap(err, "unable to decode the path parameter")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+
+		return
+	}
+
+	var data LinkUpdatingRequest
+	if err := httputils.ReadJSON(request.Body, &data); err != nil {
+		const statusCode = http.StatusBadRequest
+		err = errors.Wrap(err, "unable to decode the request body")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+
+		return
+	}
+	if data.Disabled == nil {
+		const statusCode = http.StatusBadRequest
+		err := errors.New("the disabling flag isn't specified")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+
+		return
+	}
+
+	link, err := handler.LinkDisabler.
+		DisableLink(request.Context(), code, *data.Disabled)
+	switch errors.Cause(err) {
+	case nil:
+		handler.LinkPresenter.PresentLink(writer, request, link)
+	case sql.ErrNoRows:
+		const statusCode = http.StatusNotFound
+		err = errors.New("unable to find the link")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+	case entities.ErrLinkExpired:
+		const statusCode = http.StatusGone
+		err = errors.New("the link has expired")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+	default:
+		statusCode := failureStatusCode(err)
+		err = errors.Wrap(err, "unable to update the link")
+		handler.ErrorPresenter.PresentError(writer, request, statusCode, err)
+	}
+}
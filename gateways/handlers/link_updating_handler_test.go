@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkUpdatingHandler_ServeHTTP(test *testing.T) {
+	type fields struct {
+		LinkDisabler   LinkDisabler
+		LinkPresenter  LinkPresenter
+		ErrorPresenter ErrorPresenter
+	}
+	type args struct {
+		request *http.Request
+	}
+
+	makeRequest := func(body string, withCode bool, isRead bool) *http.Request {
+		request := httptest.NewRequest(
+			http.MethodPatch,
+			"http://example.com/",
+			bytes.NewBufferString(body),
+		)
+		if withCode {
+			request = mux.SetURLVars(request, map[string]string{"code": "code"})
+		}
+
+		// we should read the request body
+		// to set up the request to the required state
+		if isRead {
+			ioutil.ReadAll(request.Body)
+		}
+
+		return request
+	}
+	makeErrorPresenter := func(
+		request *http.Request,
+		statusCode int,
+	) ErrorPresenter {
+		presenter := new(MockErrorPresenter)
+		presenter.On(
+			"PresentError",
+			mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+			request,
+			statusCode,
+			mock.MatchedBy(func(error) bool { return true }),
+		)
+
+		return presenter
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		{
+			name: "success",
+			fields: fields{
+				LinkDisabler: func() LinkDisabler {
+					disabler := new(MockLinkDisabler)
+					disabler.
+						On("DisableLink", mock.Anything, "code", true).
+						Return(entities.Link{Code: "code", URL: "url", Disabled: true}, nil)
+
+					return disabler
+				}(),
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.On(
+						"PresentLink",
+						mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+						makeRequest(`{"Disabled":true}`, true, true),
+						entities.Link{Code: "code", URL: "url", Disabled: true},
+					)
+
+					return presenter
+				}(),
+				ErrorPresenter: new(MockErrorPresenter),
+			},
+			args: args{
+				request: makeRequest(`{"Disabled":true}`, true, false),
+			},
+		},
+		{
+			name: "error with path parameter decoding",
+			fields: fields{
+				LinkDisabler:  new(MockLinkDisabler),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: makeErrorPresenter(
+					makeRequest(`{"Disabled":true}`, false, false),
+					http.StatusBadRequest,
+				),
+			},
+			args: args{
+				request: makeRequest(`{"Disabled":true}`, false, false),
+			},
+		},
+		{
+			name: "error with body decoding",
+			fields: fields{
+				LinkDisabler:  new(MockLinkDisabler),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: makeErrorPresenter(
+					makeRequest("incorrect", true, true),
+					http.StatusBadRequest,
+				),
+			},
+			args: args{
+				request: makeRequest("incorrect", true, false),
+			},
+		},
+		{
+			name: "error without the disabling flag",
+			fields: fields{
+				LinkDisabler:  new(MockLinkDisabler),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: makeErrorPresenter(
+					makeRequest("{}", true, true),
+					http.StatusBadRequest,
+				),
+			},
+			args: args{
+				request: makeRequest("{}", true, false),
+			},
+		},
+		{
+			name: "error with searching",
+			fields: fields{
+				LinkDisabler: func() LinkDisabler {
+					disabler := new(MockLinkDisabler)
+					disabler.
+						On("DisableLink", mock.Anything, "code", true).
+						Return(entities.Link{}, sql.ErrNoRows)
+
+					return disabler
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: makeErrorPresenter(
+					makeRequest(`{"Disabled":true}`, true, true),
+					http.StatusNotFound,
+				),
+			},
+			args: args{
+				request: makeRequest(`{"Disabled":true}`, true, false),
+			},
+		},
+		{
+			name: "error with expiration",
+			fields: fields{
+				LinkDisabler: func() LinkDisabler {
+					disabler := new(MockLinkDisabler)
+					disabler.
+						On("DisableLink", mock.Anything, "code", true).
+						Return(
+							entities.Link{},
+							errors.Wrap(entities.ErrLinkExpired, "unable to get the link"),
+						)
+
+					return disabler
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: makeErrorPresenter(
+					makeRequest(`{"Disabled":true}`, true, true),
+					http.StatusGone,
+				),
+			},
+			args: args{
+				request: makeRequest(`{"Disabled":true}`, true, false),
+			},
+		},
+		{
+			name: "error with updating",
+			fields: fields{
+				LinkDisabler: func() LinkDisabler {
+					disabler := new(MockLinkDisabler)
+					disabler.
+						On("DisableLink", mock.Anything, "code", true).
+						Return(entities.Link{}, iotest.ErrTimeout)
+
+					return disabler
+				}(),
+				LinkPresenter: new(MockLinkPresenter),
+				ErrorPresenter: makeErrorPresenter(
+					makeRequest(`{"Disabled":true}`, true, true),
+					http.StatusInternalServerError,
+				),
+			},
+			args: args{
+				request: makeRequest(`{"Disabled":true}`, true, false),
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			writer := httptest.NewRecorder()
+			handler := LinkUpdatingHandler{
+				LinkDisabler:   data.fields.LinkDisabler,
+				LinkPresenter:  data.fields.LinkPresenter,
+				ErrorPresenter: data.fields.ErrorPresenter,
+			}
+			handler.ServeHTTP(writer, data.args.request)
+
+			response := writer.Result()
+			responseBody, _ := ioutil.ReadAll(response.Body)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkDisabler,
+				data.fields.LinkPresenter,
+				data.fields.ErrorPresenter,
+			)
+			assert.Empty(test, responseBody)
+		})
+	}
+}
@@ -3,6 +3,8 @@
 package handlers
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 	entities "github.com/thewizardplusplus/go-link-shortener-backend/entities"
 )
@@ -12,20 +14,20 @@ type MockLinkCreator struct {
 	mock.Mock
 }
 
-// CreateLink provides a mock function with given fields: url
-func (_m *MockLinkCreator) CreateLink(url string) (entities.Link, error) {
-	ret := _m.Called(url)
+// CreateLink provides a mock function with given fields: ctx, link
+func (_m *MockLinkCreator) CreateLink(ctx context.Context, link entities.Link) (entities.Link, error) {
+	ret := _m.Called(ctx, link)
 
 	var r0 entities.Link
-	if rf, ok := ret.Get(0).(func(string) entities.Link); ok {
-		r0 = rf(url)
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Link) entities.Link); ok {
+		r0 = rf(ctx, link)
 	} else {
 		r0 = ret.Get(0).(entities.Link)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(url)
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Link) error); ok {
+		r1 = rf(ctx, link)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -20,6 +20,16 @@ type ErrorResponse struct {
 	Error string
 }
 
+// LinkResultResponse ...
+//
+// It contains either the link or the error.
+//
+// It's public only for docs generating.
+type LinkResultResponse struct {
+	Link  *entities.Link `json:",omitempty"`
+	Error string         `json:",omitempty"`
+}
+
 // PresentLink ...
 func (presenter JSONPresenter) PresentLink(
 	writer http.ResponseWriter,
@@ -35,6 +45,45 @@ func (presenter JSONPresenter) PresentLink(
 	return nil
 }
 
+// PresentLinkResults ...
+func (presenter JSONPresenter) PresentLinkResults(
+	writer http.ResponseWriter,
+	request *http.Request,
+	results []entities.LinkResult,
+) error {
+	responses := make([]LinkResultResponse, 0, len(results))
+	for _, result := range results {
+		if result.Err != nil {
+			response := LinkResultResponse{Error: result.Err.Error()}
+			responses = append(responses, response)
+			continue
+		}
+
+		link := result.Link
+		link.ServerID = presenter.ServerID
+		responses = append(responses, LinkResultResponse{Link: &link})
+	}
+
+	if err := httputils.WriteJSON(writer, http.StatusOK, responses); err != nil {
+		return errors.Wrap(err, "unable to present the link results in JSON")
+	}
+
+	return nil
+}
+
+// PresentClickStats ...
+func (presenter JSONPresenter) PresentClickStats(
+	writer http.ResponseWriter,
+	request *http.Request,
+	stats entities.ClickStats,
+) error {
+	if err := httputils.WriteJSON(writer, http.StatusOK, stats); err != nil {
+		return errors.Wrap(err, "unable to present the click stats in JSON")
+	}
+
+	return nil
+}
+
 // PresentError ...
 func (presenter JSONPresenter) PresentError(
 	writer http.ResponseWriter,
@@ -111,6 +111,203 @@ func TestJSONPresenter_PresentLink(test *testing.T) {
 	}
 }
 
+func TestJSONPresenter_PresentLinkResults(test *testing.T) {
+	type fields struct {
+		ServerID string
+	}
+	type args struct {
+		writer  http.ResponseWriter
+		request *http.Request
+		results []entities.LinkResult
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.ErrorAssertionFunc
+		check   func(test *testing.T, writer http.ResponseWriter)
+	}{
+		{
+			name: "success",
+			fields: fields{
+				ServerID: "server-id",
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/api/v1/links/bulk",
+					nil,
+				),
+				results: []entities.LinkResult{
+					{Link: entities.Link{Code: "code", URL: "url"}},
+					{Err: iotest.ErrTimeout},
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+				responseBody, _ := ioutil.ReadAll(response.Body)
+
+				assert.Equal(test, http.StatusOK, response.StatusCode)
+				assert.Equal(test, "application/json", response.Header.Get("Content-Type"))
+				assert.Equal(
+					test,
+					`[{"Link":{"ServerID":"server-id","Code":"code","URL":"url"}},`+
+						`{"Error":"timeout"}]`,
+					string(responseBody),
+				)
+			},
+		},
+		{
+			name: "success without results",
+			fields: fields{
+				ServerID: "server-id",
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/api/v1/links/bulk",
+					nil,
+				),
+				results: nil,
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+				responseBody, _ := ioutil.ReadAll(response.Body)
+
+				assert.Equal(test, http.StatusOK, response.StatusCode)
+				assert.Equal(test, "application/json", response.Header.Get("Content-Type"))
+				assert.Equal(test, `[]`, string(responseBody))
+			},
+		},
+		{
+			name: "error",
+			fields: fields{
+				ServerID: "server-id",
+			},
+			args: args{
+				writer: NewTimeoutResponseRecorder(),
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/api/v1/links/bulk",
+					nil,
+				),
+				results: []entities.LinkResult{
+					{Link: entities.Link{Code: "code", URL: "url"}},
+				},
+			},
+			wantErr: assert.Error,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(TimeoutResponseRecorder).Result()
+				responseBody, _ := ioutil.ReadAll(response.Body)
+
+				assert.Equal(test, http.StatusOK, response.StatusCode)
+				assert.Equal(test, "application/json", response.Header.Get("Content-Type"))
+				assert.Empty(test, responseBody)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			presenter := JSONPresenter{
+				ServerID: data.fields.ServerID,
+			}
+			gotErr := presenter.PresentLinkResults(
+				data.args.writer,
+				data.args.request,
+				data.args.results,
+			)
+
+			data.wantErr(test, gotErr)
+			data.check(test, data.args.writer)
+		})
+	}
+}
+
+func TestJSONPresenter_PresentClickStats(test *testing.T) {
+	type args struct {
+		writer  http.ResponseWriter
+		request *http.Request
+		stats   entities.ClickStats
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		wantErr assert.ErrorAssertionFunc
+		check   func(test *testing.T, writer http.ResponseWriter)
+	}{
+		{
+			name: "success",
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/code/stats",
+					nil,
+				),
+				stats: entities.ClickStats{
+					Code:       "code",
+					TotalCount: 2,
+					DailyCounts: []entities.DailyClickCount{
+						{Date: "2019-12-01", Count: 2},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+				responseBody, _ := ioutil.ReadAll(response.Body)
+
+				assert.Equal(test, http.StatusOK, response.StatusCode)
+				assert.Equal(test, "application/json", response.Header.Get("Content-Type"))
+				assert.Equal(
+					test,
+					`{"Code":"code","TotalCount":2,`+
+						`"DailyCounts":[{"Date":"2019-12-01","Count":2}]}`,
+					string(responseBody),
+				)
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				writer: NewTimeoutResponseRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/code/stats",
+					nil,
+				),
+				stats: entities.ClickStats{Code: "code"},
+			},
+			wantErr: assert.Error,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(TimeoutResponseRecorder).Result()
+				responseBody, _ := ioutil.ReadAll(response.Body)
+
+				assert.Equal(test, http.StatusOK, response.StatusCode)
+				assert.Equal(test, "application/json", response.Header.Get("Content-Type"))
+				assert.Empty(test, responseBody)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var presenter JSONPresenter
+			gotErr := presenter.PresentClickStats(
+				data.args.writer,
+				data.args.request,
+				data.args.stats,
+			)
+
+			data.wantErr(test, gotErr)
+			data.check(test, data.args.writer)
+		})
+	}
+}
+
 func TestJSONPresenter_PresentError(test *testing.T) {
 	type args struct {
 		writer     http.ResponseWriter
@@ -0,0 +1,79 @@
+package presenters
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=ClickRecorder --inpackage --case=underscore --testonly
+
+// ClickRecorder ...
+type ClickRecorder interface {
+	RecordClick(click entities.Click)
+}
+
+// RecordingLinkPresenter ...
+type RecordingLinkPresenter struct {
+	LinkPresenter LinkPresenter
+	ClickRecorder ClickRecorder
+}
+
+// PresentLink ...
+//
+// It records a click only if the link has been presented successfully.
+// The link variant is recorded only if it's the single one, i.e. it has been
+// selected by the previous presenters.
+//
+func (presenter RecordingLinkPresenter) PresentLink(
+	writer http.ResponseWriter,
+	request *http.Request,
+	link entities.Link,
+) error {
+	err := presenter.LinkPresenter.PresentLink(writer, request, link)
+	if err != nil {
+		return err
+	}
+
+	presenter.ClickRecorder.RecordClick(entities.Click{
+		Code:      link.Code,
+		Time:      time.Now(),
+		Referrer:  request.Referer(),
+		UserAgent: request.UserAgent(),
+		IP:        anonymizeIP(request.RemoteAddr),
+		Variant:   servedVariant(link),
+	})
+
+	return nil
+}
+
+// it zeroes the host part of an IP address: the last octet for IPv4
+// and the last 80 bits for IPv6; if an address can't be parsed,
+// it returns an empty string, so the raw address is never stored
+func anonymizeIP(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return ""
+	}
+
+	if ipV4 := ip.To4(); ipV4 != nil {
+		return ipV4.Mask(net.CIDRMask(24, 32)).String()
+	}
+
+	return ip.Mask(net.CIDRMask(48, 128)).String()
+}
+
+func servedVariant(link entities.Link) string {
+	if len(link.Variants) != 1 {
+		return ""
+	}
+
+	return link.Variants[0].Name
+}
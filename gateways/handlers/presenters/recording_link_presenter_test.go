@@ -0,0 +1,213 @@
+package presenters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestRecordingLinkPresenter_PresentLink(test *testing.T) {
+	type fields struct {
+		LinkPresenter LinkPresenter
+		ClickRecorder ClickRecorder
+	}
+	type args struct {
+		writer  http.ResponseWriter
+		request *http.Request
+		link    entities.Link
+	}
+
+	makeRequest := func() *http.Request {
+		request :=
+			httptest.NewRequest(http.MethodGet, "http://example.com/code", nil)
+		request.RemoteAddr = "192.0.2.42:12345"
+		request.Header.Set("Referer", "http://example.com/")
+		request.Header.Set("User-Agent", "user-agent")
+
+		return request
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(),
+							entities.Link{Code: "code", URL: "url"},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				ClickRecorder: func() ClickRecorder {
+					recorder := new(MockClickRecorder)
+					recorder.On(
+						"RecordClick",
+						mock.MatchedBy(func(click entities.Click) bool {
+							return click.Code == "code" &&
+								time.Since(click.Time) < time.Minute &&
+								click.Referrer == "http://example.com/" &&
+								click.UserAgent == "user-agent" &&
+								click.IP == "192.0.2.0" &&
+								click.Variant == ""
+						}),
+					)
+
+					return recorder
+				}(),
+			},
+			args: args{
+				writer:  new(MockResponseWriter),
+				request: makeRequest(),
+				link:    entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with a variant",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(),
+							entities.Link{
+								Code:     "code",
+								URL:      "url",
+								Variants: []entities.Variant{{Name: "a", URL: "url", Weight: 1}},
+							},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				ClickRecorder: func() ClickRecorder {
+					recorder := new(MockClickRecorder)
+					recorder.On(
+						"RecordClick",
+						mock.MatchedBy(func(click entities.Click) bool {
+							return click.Code == "code" &&
+								time.Since(click.Time) < time.Minute &&
+								click.Referrer == "http://example.com/" &&
+								click.UserAgent == "user-agent" &&
+								click.IP == "192.0.2.0" &&
+								click.Variant == "a"
+						}),
+					)
+
+					return recorder
+				}(),
+			},
+			args: args{
+				writer:  new(MockResponseWriter),
+				request: makeRequest(),
+				link: entities.Link{
+					Code:     "code",
+					URL:      "url",
+					Variants: []entities.Variant{{Name: "a", URL: "url", Weight: 1}},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(),
+							entities.Link{Code: "code", URL: "url"},
+						).
+						Return(iotest.ErrTimeout)
+
+					return presenter
+				}(),
+				ClickRecorder: new(MockClickRecorder),
+			},
+			args: args{
+				writer:  new(MockResponseWriter),
+				request: makeRequest(),
+				link:    entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			presenter := RecordingLinkPresenter{
+				LinkPresenter: data.fields.LinkPresenter,
+				ClickRecorder: data.fields.ClickRecorder,
+			}
+			gotErr :=
+				presenter.PresentLink(data.args.writer, data.args.request, data.args.link)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkPresenter,
+				data.fields.ClickRecorder,
+				data.args.writer,
+			)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func Test_anonymizeIP(test *testing.T) {
+	for _, data := range []struct {
+		name    string
+		address string
+		want    string
+	}{
+		{
+			name:    "IPv4 with a port",
+			address: "192.0.2.42:12345",
+			want:    "192.0.2.0",
+		},
+		{
+			name:    "IPv4 without a port",
+			address: "192.0.2.42",
+			want:    "192.0.2.0",
+		},
+		{
+			name:    "IPv6 with a port",
+			address: "[2001:db8:1234:5678::1]:12345",
+			want:    "2001:db8:1234::",
+		},
+		{
+			name:    "IPv6 without a port",
+			address: "2001:db8:1234:5678::1",
+			want:    "2001:db8:1234::",
+		},
+		{
+			name:    "invalid address",
+			address: "invalid",
+			want:    "",
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := anonymizeIP(data.address)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
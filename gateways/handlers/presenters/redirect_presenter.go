@@ -2,18 +2,33 @@ package presenters
 
 // nolint: lll
 import (
+	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/go-log/log"
+	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	httputils "github.com/thewizardplusplus/go-http-utils"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
 )
 
 // RedirectPresenter ...
+//
+// The redirect code is used for links without their own one; if it's zero,
+// the 301 Moved Permanently code is used. The max age limits caching
+// of permanent redirects; temporary ones and ones of links with rules
+// or variants are never cached.
+//
+// The request query and path suffix are forwarded onto the link URL,
+// if the link allows that.
+//
 type RedirectPresenter struct {
-	ErrorURL string
-	Logger   log.Logger
+	ErrorURL     string
+	RedirectCode int
+	MaxAge       time.Duration
+	Logger       log.Logger
 }
 
 // PresentLink ...
@@ -22,8 +37,24 @@ func (presenter RedirectPresenter) PresentLink(
 	request *http.Request,
 	link entities.Link,
 ) error {
-	url, statusCode := link.URL, http.StatusMovedPermanently
-	err := httputils.CatchingRedirect(writer, request, url, statusCode)
+	statusCode := link.RedirectCode
+	if statusCode == 0 {
+		statusCode = presenter.RedirectCode
+	}
+	if statusCode == 0 {
+		statusCode = http.StatusMovedPermanently
+	}
+
+	cacheControl := presenter.makeCacheControl(link, statusCode, time.Now())
+	writer.Header().Set("Cache-Control", cacheControl)
+
+	query, pathSuffix := request.URL.Query(), mux.Vars(request)["path"]
+	url, err := link.MakeTargetURL(query, pathSuffix)
+	if err != nil {
+		return errors.Wrap(err, "unable to make the target URL")
+	}
+
+	err = httputils.CatchingRedirect(writer, request, url, statusCode)
 	if err != nil {
 		return errors.Wrap(err, "unable to redirect to the link")
 	}
@@ -31,13 +62,54 @@ func (presenter RedirectPresenter) PresentLink(
 	return nil
 }
 
+const blockedPage = `<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="utf-8" />
+    <title>Blocked link</title>
+  </head>
+  <body>
+    <h1>Blocked link</h1>
+    <p>The link has been blocked, because its target is considered unsafe.</p>
+  </body>
+</html>
+`
+
 // PresentError ...
+//
+// If the link is gone, it responds with the corresponding status code
+// instead of redirecting. If the link is forbidden, it responds
+// with the dedicated page about blocking.
+//
 func (presenter RedirectPresenter) PresentError(
 	writer http.ResponseWriter,
 	request *http.Request,
 	statusCode int,
 	err error,
 ) error {
+	if statusCode == http.StatusForbidden {
+		writer.Header().Set("Content-Type", "text/html; charset=utf-8")
+		writer.WriteHeader(statusCode)
+
+		_, err2 := io.WriteString(writer, blockedPage)
+		if err2 != nil {
+			return errors.Wrap(err2, "unable to present the blocked link")
+		}
+
+		return nil
+	}
+	if statusCode == http.StatusGone {
+		writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		writer.WriteHeader(statusCode)
+
+		_, err2 := io.WriteString(writer, http.StatusText(statusCode))
+		if err2 != nil {
+			return errors.Wrap(err2, "unable to present the gone link")
+		}
+
+		return nil
+	}
+
 	url, statusCode2 := presenter.ErrorURL, http.StatusFound
 	err2 := httputils.CatchingRedirect(writer, request, url, statusCode2)
 	if err2 != nil {
@@ -47,3 +119,31 @@ func (presenter RedirectPresenter) PresentError(
 	presenter.Logger.Logf("redirect because of the error: %v", err)
 	return nil
 }
+
+func (presenter RedirectPresenter) makeCacheControl(
+	link entities.Link,
+	statusCode int,
+	now time.Time,
+) string {
+	if statusCode != http.StatusMovedPermanently &&
+		statusCode != http.StatusPermanentRedirect {
+		return "no-store"
+	}
+	// the target of the link depends on its visitor
+	if len(link.Rules) != 0 || len(link.Variants) != 0 {
+		return "no-store"
+	}
+
+	// the link shouldn't be cached longer than it lives
+	maxAge := presenter.MaxAge
+	if link.ExpirationTime != nil {
+		if lifetime := link.ExpirationTime.Sub(now); lifetime < maxAge {
+			maxAge = lifetime
+		}
+	}
+	if maxAge < time.Second {
+		return "no-store"
+	}
+
+	return fmt.Sprintf("public, max-age=%d", maxAge/time.Second)
+}
@@ -1,12 +1,15 @@
 package presenters
 
 import (
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"testing/iotest"
+	"time"
 
 	"github.com/go-log/log"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
@@ -14,8 +17,10 @@ import (
 
 func TestRedirectPresenter_PresentLink(test *testing.T) {
 	type fields struct {
-		ErrorURL string
-		Logger   log.Logger
+		ErrorURL     string
+		RedirectCode int
+		MaxAge       time.Duration
+		Logger       log.Logger
 	}
 	type args struct {
 		writer  http.ResponseWriter
@@ -57,6 +62,284 @@ func TestRedirectPresenter_PresentLink(test *testing.T) {
 				)
 			},
 		},
+		{
+			name: "success with the default redirect code",
+			fields: fields{
+				ErrorURL:     "/error",
+				RedirectCode: http.StatusTemporaryRedirect,
+				MaxAge:       time.Hour,
+				Logger:       new(MockLogger),
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code",
+					nil,
+				),
+				link: entities.Link{Code: "code", URL: "https://www.google.com/"},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+
+				assert.Equal(test, http.StatusTemporaryRedirect, response.StatusCode)
+				assert.Equal(
+					test,
+					"https://www.google.com/",
+					response.Header.Get("Location"),
+				)
+				assert.Equal(test, "no-store", response.Header.Get("Cache-Control"))
+			},
+		},
+		{
+			name: "success with the redirect code of the link",
+			fields: fields{
+				ErrorURL:     "/error",
+				RedirectCode: http.StatusFound,
+				MaxAge:       time.Hour,
+				Logger:       new(MockLogger),
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code",
+					nil,
+				),
+				link: entities.Link{
+					Code:         "code",
+					URL:          "https://www.google.com/",
+					RedirectCode: http.StatusPermanentRedirect,
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+
+				assert.Equal(test, http.StatusPermanentRedirect, response.StatusCode)
+				assert.Equal(
+					test,
+					"https://www.google.com/",
+					response.Header.Get("Location"),
+				)
+				assert.Equal(
+					test,
+					"public, max-age=3600",
+					response.Header.Get("Cache-Control"),
+				)
+			},
+		},
+		{
+			name: "success with forwarding",
+			fields: fields{
+				ErrorURL: "/error",
+				Logger:   new(MockLogger),
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: func() *http.Request {
+					request := httptest.NewRequest(
+						http.MethodGet,
+						"http://example.com/redirect/code/extra/path?ref=newsletter",
+						nil,
+					)
+					request = mux.SetURLVars(request, map[string]string{
+						"code": "code",
+						"path": "extra/path",
+					})
+
+					return request
+				}(),
+				link: entities.Link{
+					Code:            "code",
+					URL:             "https://www.google.com/search?q=test",
+					QueryForwarding: entities.LinkQueryForwarding,
+					PathForwarding:  true,
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+
+				assert.Equal(test, http.StatusMovedPermanently, response.StatusCode)
+				assert.Equal(
+					test,
+					"https://www.google.com/search/extra/path?q=test&ref=newsletter",
+					response.Header.Get("Location"),
+				)
+			},
+		},
+		{
+			name: "success without forwarding",
+			fields: fields{
+				ErrorURL: "/error",
+				Logger:   new(MockLogger),
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code?ref=newsletter",
+					nil,
+				),
+				link: entities.Link{Code: "code", URL: "https://www.google.com/"},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+
+				assert.Equal(test, http.StatusMovedPermanently, response.StatusCode)
+				assert.Equal(
+					test,
+					"https://www.google.com/",
+					response.Header.Get("Location"),
+				)
+			},
+		},
+		{
+			name: "success with rules",
+			fields: fields{
+				ErrorURL:     "/error",
+				RedirectCode: http.StatusMovedPermanently,
+				MaxAge:       time.Hour,
+				Logger:       new(MockLogger),
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code",
+					nil,
+				),
+				link: entities.Link{
+					Code: "code",
+					URL:  "https://www.google.com/",
+					Rules: []entities.RedirectRule{
+						{OSes: []string{"ios"}, URL: "https://www.apple.com/"},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+
+				assert.Equal(test, http.StatusMovedPermanently, response.StatusCode)
+				assert.Equal(
+					test,
+					"https://www.google.com/",
+					response.Header.Get("Location"),
+				)
+				assert.Equal(test, "no-store", response.Header.Get("Cache-Control"))
+			},
+		},
+		{
+			name: "success with variants",
+			fields: fields{
+				ErrorURL:     "/error",
+				RedirectCode: http.StatusMovedPermanently,
+				MaxAge:       time.Hour,
+				Logger:       new(MockLogger),
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code",
+					nil,
+				),
+				link: entities.Link{
+					Code: "code",
+					URL:  "https://www.google.com/",
+					Variants: []entities.Variant{
+						{Name: "a", URL: "https://www.google.com/", Weight: 1},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+
+				assert.Equal(test, http.StatusMovedPermanently, response.StatusCode)
+				assert.Equal(
+					test,
+					"https://www.google.com/",
+					response.Header.Get("Location"),
+				)
+				assert.Equal(test, "no-store", response.Header.Get("Cache-Control"))
+			},
+		},
+		{
+			name: "success with an expiring link",
+			fields: fields{
+				ErrorURL:     "/error",
+				RedirectCode: http.StatusMovedPermanently,
+				MaxAge:       time.Hour,
+				Logger:       new(MockLogger),
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code",
+					nil,
+				),
+				link: entities.Link{
+					Code: "code",
+					URL:  "https://www.google.com/",
+					ExpirationTime: func() *time.Time {
+						expirationTime := time.Now().Add(10 * time.Minute)
+						return &expirationTime
+					}(),
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+
+				assert.Equal(test, http.StatusMovedPermanently, response.StatusCode)
+				assert.Equal(
+					test,
+					"https://www.google.com/",
+					response.Header.Get("Location"),
+				)
+				assert.Regexp(
+					test,
+					`^public, max-age=(599|600)$`,
+					response.Header.Get("Cache-Control"),
+				)
+			},
+		},
+		{
+			name: "success without a max age",
+			fields: fields{
+				ErrorURL:     "/error",
+				RedirectCode: http.StatusMovedPermanently,
+				MaxAge:       0,
+				Logger:       new(MockLogger),
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code",
+					nil,
+				),
+				link: entities.Link{Code: "code", URL: "https://www.google.com/"},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+
+				assert.Equal(test, http.StatusMovedPermanently, response.StatusCode)
+				assert.Equal(
+					test,
+					"https://www.google.com/",
+					response.Header.Get("Location"),
+				)
+				assert.Equal(test, "no-store", response.Header.Get("Cache-Control"))
+			},
+		},
 		{
 			name: "error",
 			fields: fields{
@@ -87,8 +370,10 @@ func TestRedirectPresenter_PresentLink(test *testing.T) {
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			presenter := RedirectPresenter{
-				ErrorURL: data.fields.ErrorURL,
-				Logger:   data.fields.Logger,
+				ErrorURL:     data.fields.ErrorURL,
+				RedirectCode: data.fields.RedirectCode,
+				MaxAge:       data.fields.MaxAge,
+				Logger:       data.fields.Logger,
 			}
 			gotErr :=
 				presenter.PresentLink(data.args.writer, data.args.request, data.args.link)
@@ -154,6 +439,63 @@ func TestRedirectPresenter_PresentError(test *testing.T) {
 				assert.Equal(test, "/error", response.Header.Get("Location"))
 			},
 		},
+		{
+			name: "success with a gone link",
+			fields: fields{
+				ErrorURL: "/error",
+				Logger:   new(MockLogger),
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code",
+					nil,
+				),
+				statusCode: http.StatusGone,
+				err:        iotest.ErrTimeout,
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+				responseBody, _ := ioutil.ReadAll(response.Body)
+
+				assert.Equal(test, http.StatusGone, response.StatusCode)
+				assert.Empty(test, response.Header.Get("Location"))
+				assert.Equal(test, http.StatusText(http.StatusGone), string(responseBody))
+			},
+		},
+		{
+			name: "success with a blocked link",
+			fields: fields{
+				ErrorURL: "/error",
+				Logger:   new(MockLogger),
+			},
+			args: args{
+				writer: httptest.NewRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code",
+					nil,
+				),
+				statusCode: http.StatusForbidden,
+				err:        iotest.ErrTimeout,
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(*httptest.ResponseRecorder).Result()
+				responseBody, _ := ioutil.ReadAll(response.Body)
+
+				assert.Equal(test, http.StatusForbidden, response.StatusCode)
+				assert.Empty(test, response.Header.Get("Location"))
+				assert.Equal(
+					test,
+					"text/html; charset=utf-8",
+					response.Header.Get("Content-Type"),
+				)
+				assert.Equal(test, blockedPage, string(responseBody))
+			},
+		},
 		{
 			name: "error",
 			fields: fields{
@@ -178,6 +520,54 @@ func TestRedirectPresenter_PresentError(test *testing.T) {
 				assert.Equal(test, "/error", response.Header.Get("Location"))
 			},
 		},
+		{
+			name: "error with a gone link",
+			fields: fields{
+				ErrorURL: "/error",
+				Logger:   new(MockLogger),
+			},
+			args: args{
+				writer: NewTimeoutResponseRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code",
+					nil,
+				),
+				statusCode: http.StatusGone,
+				err:        iotest.ErrTimeout,
+			},
+			wantErr: assert.Error,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(TimeoutResponseRecorder).Result()
+
+				assert.Equal(test, http.StatusGone, response.StatusCode)
+				assert.Empty(test, response.Header.Get("Location"))
+			},
+		},
+		{
+			name: "error with a blocked link",
+			fields: fields{
+				ErrorURL: "/error",
+				Logger:   new(MockLogger),
+			},
+			args: args{
+				writer: NewTimeoutResponseRecorder(),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code",
+					nil,
+				),
+				statusCode: http.StatusForbidden,
+				err:        iotest.ErrTimeout,
+			},
+			wantErr: assert.Error,
+			check: func(test *testing.T, writer http.ResponseWriter) {
+				response := writer.(TimeoutResponseRecorder).Result()
+
+				assert.Equal(test, http.StatusForbidden, response.StatusCode)
+				assert.Empty(test, response.Header.Get("Location"))
+			},
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			presenter := RedirectPresenter{
@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"net/http"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=VisitorDetector --inpackage --case=underscore --testonly
+
+// VisitorDetector ...
+type VisitorDetector interface {
+	DetectVisitor(request *http.Request) entities.Visitor
+}
+
+// RuleLinkPresenter ...
+type RuleLinkPresenter struct {
+	LinkPresenter   LinkPresenter
+	VisitorDetector VisitorDetector
+}
+
+// PresentLink ...
+//
+// It replaces the link URL by the one of the first link rule matching
+// the visitor; in this case, the link variants are dropped. The rules are
+// kept in the link, so the next presenters are able to know that the link
+// depends on its visitor.
+//
+func (presenter RuleLinkPresenter) PresentLink(
+	writer http.ResponseWriter,
+	request *http.Request,
+	link entities.Link,
+) error {
+	// the visitor detection may be expensive, so it's done only if needed
+	if len(link.Rules) != 0 {
+		visitor := presenter.VisitorDetector.DetectVisitor(request)
+		if rule, ok := link.MatchRule(visitor); ok {
+			link.URL = rule.URL
+			// the rule takes precedence over the variants
+			link.Variants = nil
+		}
+	}
+
+	return presenter.LinkPresenter.PresentLink(writer, request, link)
+}
@@ -0,0 +1,261 @@
+package presenters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestRuleLinkPresenter_PresentLink(test *testing.T) {
+	type fields struct {
+		LinkPresenter   LinkPresenter
+		VisitorDetector VisitorDetector
+	}
+	type args struct {
+		writer  http.ResponseWriter
+		request *http.Request
+		link    entities.Link
+	}
+
+	rules := []entities.RedirectRule{
+		{OSes: []string{"ios"}, URL: "url #1"},
+		{OSes: []string{"android"}, URL: "url #2"},
+	}
+	variants := []entities.Variant{
+		{Name: "a", URL: "url #3", Weight: 1},
+		{Name: "b", URL: "url #4", Weight: 1},
+	}
+	makeRequest := func() *http.Request {
+		return httptest.NewRequest(http.MethodGet, "http://example.com/code", nil)
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success without rules",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(),
+							entities.Link{Code: "code", URL: "url"},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				VisitorDetector: new(MockVisitorDetector),
+			},
+			args: args{
+				writer:  new(MockResponseWriter),
+				request: makeRequest(),
+				link:    entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with a matched rule",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(),
+							entities.Link{Code: "code", URL: "url #2", Rules: rules},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				VisitorDetector: func() VisitorDetector {
+					detector := new(MockVisitorDetector)
+					detector.
+						On("DetectVisitor", makeRequest()).
+						Return(entities.Visitor{OS: "android"})
+
+					return detector
+				}(),
+			},
+			args: args{
+				writer:  new(MockResponseWriter),
+				request: makeRequest(),
+				link:    entities.Link{Code: "code", URL: "url", Rules: rules},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with a matched rule and variants",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(),
+							entities.Link{Code: "code", URL: "url #2", Rules: rules},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				VisitorDetector: func() VisitorDetector {
+					detector := new(MockVisitorDetector)
+					detector.
+						On("DetectVisitor", makeRequest()).
+						Return(entities.Visitor{OS: "android"})
+
+					return detector
+				}(),
+			},
+			args: args{
+				writer:  new(MockResponseWriter),
+				request: makeRequest(),
+				link: entities.Link{
+					Code:     "code",
+					URL:      "url",
+					Rules:    rules,
+					Variants: variants,
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success without matched rules",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(),
+							entities.Link{Code: "code", URL: "url", Rules: rules},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				VisitorDetector: func() VisitorDetector {
+					detector := new(MockVisitorDetector)
+					detector.
+						On("DetectVisitor", makeRequest()).
+						Return(entities.Visitor{OS: "windows"})
+
+					return detector
+				}(),
+			},
+			args: args{
+				writer:  new(MockResponseWriter),
+				request: makeRequest(),
+				link:    entities.Link{Code: "code", URL: "url", Rules: rules},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success without matched rules and with variants",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(),
+							entities.Link{
+								Code:     "code",
+								URL:      "url",
+								Rules:    rules,
+								Variants: variants,
+							},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				VisitorDetector: func() VisitorDetector {
+					detector := new(MockVisitorDetector)
+					detector.
+						On("DetectVisitor", makeRequest()).
+						Return(entities.Visitor{OS: "windows"})
+
+					return detector
+				}(),
+			},
+			args: args{
+				writer:  new(MockResponseWriter),
+				request: makeRequest(),
+				link: entities.Link{
+					Code:     "code",
+					URL:      "url",
+					Rules:    rules,
+					Variants: variants,
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(),
+							entities.Link{Code: "code", URL: "url #1", Rules: rules},
+						).
+						Return(iotest.ErrTimeout)
+
+					return presenter
+				}(),
+				VisitorDetector: func() VisitorDetector {
+					detector := new(MockVisitorDetector)
+					detector.
+						On("DetectVisitor", makeRequest()).
+						Return(entities.Visitor{OS: "ios"})
+
+					return detector
+				}(),
+			},
+			args: args{
+				writer:  new(MockResponseWriter),
+				request: makeRequest(),
+				link:    entities.Link{Code: "code", URL: "url", Rules: rules},
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			presenter := RuleLinkPresenter{
+				LinkPresenter:   data.fields.LinkPresenter,
+				VisitorDetector: data.fields.VisitorDetector,
+			}
+			gotErr :=
+				presenter.PresentLink(data.args.writer, data.args.request, data.args.link)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkPresenter,
+				data.fields.VisitorDetector,
+				data.args.writer,
+			)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package presenters
+
+import (
+	"net/http"
+
+	"github.com/go-log/log"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=ClickStatsPresenter --inpackage --case=underscore --testonly
+
+// ClickStatsPresenter ...
+type ClickStatsPresenter interface {
+	PresentClickStats(
+		writer http.ResponseWriter,
+		request *http.Request,
+		stats entities.ClickStats,
+	) error
+}
+
+// SilentClickStatsPresenter ...
+type SilentClickStatsPresenter struct {
+	ClickStatsPresenter ClickStatsPresenter
+	Logger              log.Logger
+}
+
+// PresentClickStats ...
+func (presenter SilentClickStatsPresenter) PresentClickStats(
+	writer http.ResponseWriter,
+	request *http.Request,
+	stats entities.ClickStats,
+) {
+	err := presenter.ClickStatsPresenter.PresentClickStats(writer, request, stats)
+	if err != nil {
+		presenter.Logger.Logf("unable to present the click stats: %v", err)
+	}
+}
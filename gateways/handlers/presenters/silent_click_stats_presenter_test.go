@@ -0,0 +1,129 @@
+package presenters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+
+	"github.com/go-log/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestSilentClickStatsPresenter_PresentClickStats(test *testing.T) {
+	type fields struct {
+		ClickStatsPresenter ClickStatsPresenter
+		Logger              log.Logger
+	}
+	type args struct {
+		writer  http.ResponseWriter
+		request *http.Request
+		stats   entities.ClickStats
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		{
+			name: "success",
+			fields: fields{
+				ClickStatsPresenter: func() ClickStatsPresenter {
+					request := httptest.NewRequest(
+						http.MethodGet,
+						"http://example.com/code/stats",
+						nil,
+					)
+
+					presenter := new(MockClickStatsPresenter)
+					presenter.
+						On(
+							"PresentClickStats",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							request,
+							entities.ClickStats{Code: "code", TotalCount: 1},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				Logger: new(MockLogger),
+			},
+			args: args{
+				writer: new(MockResponseWriter),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/code/stats",
+					nil,
+				),
+				stats: entities.ClickStats{Code: "code", TotalCount: 1},
+			},
+		},
+		{
+			name: "error",
+			fields: fields{
+				ClickStatsPresenter: func() ClickStatsPresenter {
+					request := httptest.NewRequest(
+						http.MethodGet,
+						"http://example.com/code/stats",
+						nil,
+					)
+
+					presenter := new(MockClickStatsPresenter)
+					presenter.
+						On(
+							"PresentClickStats",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							request,
+							entities.ClickStats{Code: "code", TotalCount: 1},
+						).
+						Return(iotest.ErrTimeout)
+
+					return presenter
+				}(),
+				Logger: func() log.Logger {
+					logger := new(MockLogger)
+					logger.
+						On(
+							"Logf",
+							mock.MatchedBy(func(string) bool { return true }),
+							iotest.ErrTimeout,
+						).
+						Return()
+
+					return logger
+				}(),
+			},
+			args: args{
+				writer: new(MockResponseWriter),
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/code/stats",
+					nil,
+				),
+				stats: entities.ClickStats{Code: "code", TotalCount: 1},
+			},
+		},
+	} {
+		test.Run(data.name, func(t *testing.T) {
+			presenter := SilentClickStatsPresenter{
+				ClickStatsPresenter: data.fields.ClickStatsPresenter,
+				Logger:              data.fields.Logger,
+			}
+			presenter.PresentClickStats(
+				data.args.writer,
+				data.args.request,
+				data.args.stats,
+			)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.ClickStatsPresenter,
+				data.fields.Logger,
+				data.args.writer,
+			)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package presenters
+
+import (
+	"net/http"
+
+	"github.com/go-log/log"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=LinkResultsPresenter --inpackage --case=underscore --testonly
+
+// LinkResultsPresenter ...
+type LinkResultsPresenter interface {
+	PresentLinkResults(
+		writer http.ResponseWriter,
+		request *http.Request,
+		results []entities.LinkResult,
+	) error
+}
+
+// SilentLinkResultsPresenter ...
+type SilentLinkResultsPresenter struct {
+	LinkResultsPresenter LinkResultsPresenter
+	Logger               log.Logger
+}
+
+// PresentLinkResults ...
+func (presenter SilentLinkResultsPresenter) PresentLinkResults(
+	writer http.ResponseWriter,
+	request *http.Request,
+	results []entities.LinkResult,
+) {
+	err := presenter.LinkResultsPresenter.
+		PresentLinkResults(writer, request, results)
+	if err != nil {
+		presenter.Logger.Logf("unable to present the link results: %v", err)
+	}
+}
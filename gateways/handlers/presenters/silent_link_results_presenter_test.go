@@ -0,0 +1,129 @@
+package presenters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+
+	"github.com/go-log/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestSilentLinkResultsPresenter_PresentLinkResults(test *testing.T) {
+	type fields struct {
+		LinkResultsPresenter LinkResultsPresenter
+		Logger               log.Logger
+	}
+	type args struct {
+		writer  http.ResponseWriter
+		request *http.Request
+		results []entities.LinkResult
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+	}{
+		{
+			name: "success",
+			fields: fields{
+				LinkResultsPresenter: func() LinkResultsPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/api/v1/links/bulk",
+						nil,
+					)
+
+					presenter := new(MockLinkResultsPresenter)
+					presenter.
+						On(
+							"PresentLinkResults",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							request,
+							[]entities.LinkResult{{Link: entities.Link{Code: "code", URL: "url"}}},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				Logger: new(MockLogger),
+			},
+			args: args{
+				writer: new(MockResponseWriter),
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/api/v1/links/bulk",
+					nil,
+				),
+				results: []entities.LinkResult{{Link: entities.Link{Code: "code", URL: "url"}}},
+			},
+		},
+		{
+			name: "error",
+			fields: fields{
+				LinkResultsPresenter: func() LinkResultsPresenter {
+					request := httptest.NewRequest(
+						http.MethodPost,
+						"http://example.com/api/v1/links/bulk",
+						nil,
+					)
+
+					presenter := new(MockLinkResultsPresenter)
+					presenter.
+						On(
+							"PresentLinkResults",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							request,
+							[]entities.LinkResult{{Link: entities.Link{Code: "code", URL: "url"}}},
+						).
+						Return(iotest.ErrTimeout)
+
+					return presenter
+				}(),
+				Logger: func() log.Logger {
+					logger := new(MockLogger)
+					logger.
+						On(
+							"Logf",
+							mock.MatchedBy(func(string) bool { return true }),
+							iotest.ErrTimeout,
+						).
+						Return()
+
+					return logger
+				}(),
+			},
+			args: args{
+				writer: new(MockResponseWriter),
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/api/v1/links/bulk",
+					nil,
+				),
+				results: []entities.LinkResult{{Link: entities.Link{Code: "code", URL: "url"}}},
+			},
+		},
+	} {
+		test.Run(data.name, func(t *testing.T) {
+			presenter := SilentLinkResultsPresenter{
+				LinkResultsPresenter: data.fields.LinkResultsPresenter,
+				Logger:               data.fields.Logger,
+			}
+			presenter.PresentLinkResults(
+				data.args.writer,
+				data.args.request,
+				data.args.results,
+			)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkResultsPresenter,
+				data.fields.Logger,
+				data.args.writer,
+			)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package presenters
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+const variantCookiePrefix = "variant_"
+
+// VariantLinkPresenter ...
+//
+// The random source should be safe for concurrent use and should return
+// a number in the range [0, n).
+//
+type VariantLinkPresenter struct {
+	LinkPresenter LinkPresenter
+	RandomSource  func(n int) int
+	CookieMaxAge  time.Duration
+}
+
+// PresentLink ...
+//
+// It replaces the link URL by the one of a variant selected by the weights.
+// The variant is stored in a cookie, so the visitor keeps seeing the same one.
+// Only the selected variant is kept in the link, so the next presenters
+// are able to know it.
+//
+func (presenter VariantLinkPresenter) PresentLink(
+	writer http.ResponseWriter,
+	request *http.Request,
+	link entities.Link,
+) error {
+	if len(link.Variants) != 0 {
+		cookieName := variantCookiePrefix + link.Code
+
+		var name string
+		if cookie, err := request.Cookie(cookieName); err == nil {
+			name = cookie.Value
+		}
+
+		variant := link.SelectVariant(name, presenter.RandomSource)
+		http.SetCookie(writer, &http.Cookie{
+			Name:     cookieName,
+			Value:    variant.Name,
+			Path:     "/",
+			MaxAge:   int(presenter.CookieMaxAge / time.Second),
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+
+		link.URL = variant.URL
+		link.Variants = []entities.Variant{variant}
+	}
+
+	return presenter.LinkPresenter.PresentLink(writer, request, link)
+}
@@ -0,0 +1,218 @@
+package presenters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestVariantLinkPresenter_PresentLink(test *testing.T) {
+	type fields struct {
+		LinkPresenter LinkPresenter
+		RandomSource  func(n int) int
+		CookieMaxAge  time.Duration
+	}
+	type args struct {
+		writer  http.ResponseWriter
+		request *http.Request
+		link    entities.Link
+	}
+
+	variants := []entities.Variant{
+		{Name: "a", URL: "url #1", Weight: 3},
+		{Name: "b", URL: "url #2", Weight: 1},
+	}
+	makeRequest := func(variant string) *http.Request {
+		request :=
+			httptest.NewRequest(http.MethodGet, "http://example.com/code", nil)
+		if variant != "" {
+			request.AddCookie(&http.Cookie{Name: "variant_code", Value: variant})
+		}
+
+		return request
+	}
+
+	for _, data := range []struct {
+		name       string
+		fields     fields
+		args       args
+		wantCookie string
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success without variants",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(""),
+							entities.Link{Code: "code", URL: "url"},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				RandomSource: func(n int) int { panic("it shouldn't be called") },
+				CookieMaxAge: time.Hour,
+			},
+			args: args{
+				writer:  httptest.NewRecorder(),
+				request: makeRequest(""),
+				link:    entities.Link{Code: "code", URL: "url"},
+			},
+			wantCookie: "",
+			wantErr:    assert.NoError,
+		},
+		{
+			name: "success with a random variant",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(""),
+							entities.Link{
+								Code:     "code",
+								URL:      "url #2",
+								Variants: []entities.Variant{variants[1]},
+							},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				RandomSource: func(n int) int { return n - 1 },
+				CookieMaxAge: time.Hour,
+			},
+			args: args{
+				writer:  httptest.NewRecorder(),
+				request: makeRequest(""),
+				link:    entities.Link{Code: "code", URL: "url", Variants: variants},
+			},
+			wantCookie: "variant_code=b; Path=/; Max-Age=3600; HttpOnly; SameSite=Lax",
+			wantErr:    assert.NoError,
+		},
+		{
+			name: "success with a sticky variant",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest("b"),
+							entities.Link{
+								Code:     "code",
+								URL:      "url #2",
+								Variants: []entities.Variant{variants[1]},
+							},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				RandomSource: func(n int) int { panic("it shouldn't be called") },
+				CookieMaxAge: time.Hour,
+			},
+			args: args{
+				writer:  httptest.NewRecorder(),
+				request: makeRequest("b"),
+				link:    entities.Link{Code: "code", URL: "url", Variants: variants},
+			},
+			wantCookie: "variant_code=b; Path=/; Max-Age=3600; HttpOnly; SameSite=Lax",
+			wantErr:    assert.NoError,
+		},
+		{
+			name: "success with an unknown sticky variant",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest("unknown"),
+							entities.Link{
+								Code:     "code",
+								URL:      "url #1",
+								Variants: []entities.Variant{variants[0]},
+							},
+						).
+						Return(nil)
+
+					return presenter
+				}(),
+				RandomSource: func(n int) int { return 0 },
+				CookieMaxAge: 0,
+			},
+			args: args{
+				writer:  httptest.NewRecorder(),
+				request: makeRequest("unknown"),
+				link:    entities.Link{Code: "code", URL: "url", Variants: variants},
+			},
+			wantCookie: "variant_code=a; Path=/; HttpOnly; SameSite=Lax",
+			wantErr:    assert.NoError,
+		},
+		{
+			name: "error",
+			fields: fields{
+				LinkPresenter: func() LinkPresenter {
+					presenter := new(MockLinkPresenter)
+					presenter.
+						On(
+							"PresentLink",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							makeRequest(""),
+							entities.Link{
+								Code:     "code",
+								URL:      "url #1",
+								Variants: []entities.Variant{variants[0]},
+							},
+						).
+						Return(iotest.ErrTimeout)
+
+					return presenter
+				}(),
+				RandomSource: func(n int) int { return 0 },
+				CookieMaxAge: time.Hour,
+			},
+			args: args{
+				writer:  httptest.NewRecorder(),
+				request: makeRequest(""),
+				link:    entities.Link{Code: "code", URL: "url", Variants: variants},
+			},
+			wantCookie: "variant_code=a; Path=/; Max-Age=3600; HttpOnly; SameSite=Lax",
+			wantErr:    assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			presenter := VariantLinkPresenter{
+				LinkPresenter: data.fields.LinkPresenter,
+				RandomSource:  data.fields.RandomSource,
+				CookieMaxAge:  data.fields.CookieMaxAge,
+			}
+			gotErr :=
+				presenter.PresentLink(data.args.writer, data.args.request, data.args.link)
+
+			mock.AssertExpectationsForObjects(test, data.fields.LinkPresenter)
+			assert.Equal(
+				test,
+				data.wantCookie,
+				data.args.writer.Header().Get("Set-Cookie"),
+			)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
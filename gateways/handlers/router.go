@@ -8,10 +8,14 @@ import (
 
 // Handlers ...
 type Handlers struct {
-	LinkRedirectHandler http.Handler
-	LinkGettingHandler  http.Handler
-	LinkCreatingHandler http.Handler
-	StaticFileHandler   http.Handler
+	LinkRedirectHandler      http.Handler
+	LinkGettingHandler       http.Handler
+	LinkCreatingHandler      http.Handler
+	LinkBulkCreatingHandler  http.Handler
+	LinkDeletingHandler      http.Handler
+	LinkUpdatingHandler      http.Handler
+	ClickStatsGettingHandler http.Handler
+	StaticFileHandler        http.Handler
 }
 
 // NewRouter ...
@@ -32,6 +36,17 @@ func NewRouter(redirectEndpointPrefix string, handlers Handlers) *mux.Router {
 		)
 	rootRouter.
 		Handle(redirectEndpointPrefix+"/{code}", handlers.LinkRedirectHandler)
+	// the path suffix is forwarded onto the link URL, if the link allows that
+	rootRouter.
+		Handle(
+			redirectEndpointPrefix+"/{serverID}:{code}/{path:.*}",
+			handlers.LinkRedirectHandler,
+		)
+	rootRouter.
+		Handle(
+			redirectEndpointPrefix+"/{code}/{path:.*}",
+			handlers.LinkRedirectHandler,
+		)
 	rootRouter.
 		PathPrefix("/").Handler(handlers.StaticFileHandler).
 		Methods(http.MethodGet)
@@ -42,9 +57,33 @@ func NewRouter(redirectEndpointPrefix string, handlers Handlers) *mux.Router {
 	apiRouter.
 		Handle("/links/{code}", handlers.LinkGettingHandler).
 		Methods(http.MethodGet)
+	apiRouter.
+		Handle("/links/{serverID}:{code}", handlers.LinkDeletingHandler).
+		Methods(http.MethodDelete)
+	apiRouter.
+		Handle("/links/{code}", handlers.LinkDeletingHandler).
+		Methods(http.MethodDelete)
+	apiRouter.
+		Handle("/links/{serverID}:{code}", handlers.LinkUpdatingHandler).
+		Methods(http.MethodPatch)
+	apiRouter.
+		Handle("/links/{code}", handlers.LinkUpdatingHandler).
+		Methods(http.MethodPatch)
+	apiRouter.
+		Handle(
+			"/links/{serverID}:{code}/stats",
+			handlers.ClickStatsGettingHandler,
+		).
+		Methods(http.MethodGet)
+	apiRouter.
+		Handle("/links/{code}/stats", handlers.ClickStatsGettingHandler).
+		Methods(http.MethodGet)
 	apiRouter.
 		Handle("/links/", handlers.LinkCreatingHandler).
 		Methods(http.MethodPost)
+	apiRouter.
+		Handle("/links/bulk", handlers.LinkBulkCreatingHandler).
+		Methods(http.MethodPost)
 
 	return rootRouter
 }
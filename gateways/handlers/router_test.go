@@ -43,9 +43,13 @@ func TestNewRouter(test *testing.T) {
 
 						return handler
 					}(),
-					LinkGettingHandler:  new(MockHandler),
-					LinkCreatingHandler: new(MockHandler),
-					StaticFileHandler:   new(MockHandler),
+					LinkGettingHandler:       new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
 				},
 				request: httptest.NewRequest(
 					http.MethodGet,
@@ -78,9 +82,13 @@ func TestNewRouter(test *testing.T) {
 
 						return handler
 					}(),
-					LinkGettingHandler:  new(MockHandler),
-					LinkCreatingHandler: new(MockHandler),
-					StaticFileHandler:   new(MockHandler),
+					LinkGettingHandler:       new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
 				},
 				request: httptest.NewRequest(
 					http.MethodGet,
@@ -90,6 +98,88 @@ func TestNewRouter(test *testing.T) {
 			},
 			wantStatusCode: http.StatusOK,
 		},
+		{
+			name: "link redirect (with a path suffix)",
+			args: args{
+				redirectEndpointPrefix: "/redirect",
+				handlers: Handlers{
+					LinkRedirectHandler: func() http.Handler {
+						handler := new(MockHandler)
+						handler.On(
+							"ServeHTTP",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							mock.MatchedBy(func(request *http.Request) bool {
+								var code string
+								httputils.ParsePathParameter(request, "code", &code)
+
+								var path string
+								httputils.ParsePathParameter(request, "path", &path)
+
+								return code == "code" && path == "extra/path"
+							}),
+						)
+
+						return handler
+					}(),
+					LinkGettingHandler:       new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
+				},
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/code/extra/path",
+					nil,
+				),
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "link redirect (with the server ID and a path suffix)",
+			args: args{
+				redirectEndpointPrefix: "/redirect",
+				handlers: Handlers{
+					LinkRedirectHandler: func() http.Handler {
+						handler := new(MockHandler)
+						handler.On(
+							"ServeHTTP",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							mock.MatchedBy(func(request *http.Request) bool {
+								var serverID string
+								httputils.ParsePathParameter(request, "serverID", &serverID)
+
+								var code string
+								httputils.ParsePathParameter(request, "code", &code)
+
+								var path string
+								httputils.ParsePathParameter(request, "path", &path)
+
+								return serverID == "server-id" && code == "code" &&
+									path == "extra/path"
+							}),
+						)
+
+						return handler
+					}(),
+					LinkGettingHandler:       new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
+				},
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/redirect/server-id:code/extra/path",
+					nil,
+				),
+			},
+			wantStatusCode: http.StatusOK,
+		},
 		{
 			name: "link getting",
 			args: args{
@@ -111,8 +201,12 @@ func TestNewRouter(test *testing.T) {
 
 						return handler
 					}(),
-					LinkCreatingHandler: new(MockHandler),
-					StaticFileHandler:   new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
 				},
 				request: httptest.NewRequest(
 					http.MethodGet,
@@ -146,8 +240,12 @@ func TestNewRouter(test *testing.T) {
 
 						return handler
 					}(),
-					LinkCreatingHandler: new(MockHandler),
-					StaticFileHandler:   new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
 				},
 				request: httptest.NewRequest(
 					http.MethodGet,
@@ -157,6 +255,156 @@ func TestNewRouter(test *testing.T) {
 			},
 			wantStatusCode: http.StatusOK,
 		},
+		{
+			name: "link deleting",
+			args: args{
+				redirectEndpointPrefix: "/redirect",
+				handlers: Handlers{
+					LinkRedirectHandler: new(MockHandler),
+					LinkGettingHandler:  new(MockHandler),
+					LinkDeletingHandler: func() http.Handler {
+						handler := new(MockHandler)
+						handler.On(
+							"ServeHTTP",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							mock.MatchedBy(func(request *http.Request) bool {
+								var code string
+								httputils.ParsePathParameter(request, "code", &code)
+
+								return code == "code"
+							}),
+						)
+
+						return handler
+					}(),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
+				},
+				request: httptest.NewRequest(
+					http.MethodDelete,
+					"http://example.com/api/v1/links/code",
+					nil,
+				),
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "link deleting (with the server ID)",
+			args: args{
+				redirectEndpointPrefix: "/redirect",
+				handlers: Handlers{
+					LinkRedirectHandler: new(MockHandler),
+					LinkGettingHandler:  new(MockHandler),
+					LinkDeletingHandler: func() http.Handler {
+						handler := new(MockHandler)
+						handler.On(
+							"ServeHTTP",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							mock.MatchedBy(func(request *http.Request) bool {
+								var serverID string
+								httputils.ParsePathParameter(request, "serverID", &serverID)
+
+								var code string
+								httputils.ParsePathParameter(request, "code", &code)
+
+								return serverID == "server-id" && code == "code"
+							}),
+						)
+
+						return handler
+					}(),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
+				},
+				request: httptest.NewRequest(
+					http.MethodDelete,
+					"http://example.com/api/v1/links/server-id:code",
+					nil,
+				),
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "link updating",
+			args: args{
+				redirectEndpointPrefix: "/redirect",
+				handlers: Handlers{
+					LinkRedirectHandler: new(MockHandler),
+					LinkGettingHandler:  new(MockHandler),
+					LinkUpdatingHandler: func() http.Handler {
+						handler := new(MockHandler)
+						handler.On(
+							"ServeHTTP",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							mock.MatchedBy(func(request *http.Request) bool {
+								var code string
+								httputils.ParsePathParameter(request, "code", &code)
+
+								return code == "code"
+							}),
+						)
+
+						return handler
+					}(),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
+				},
+				request: httptest.NewRequest(
+					http.MethodPatch,
+					"http://example.com/api/v1/links/code",
+					nil,
+				),
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "link updating (with the server ID)",
+			args: args{
+				redirectEndpointPrefix: "/redirect",
+				handlers: Handlers{
+					LinkRedirectHandler: new(MockHandler),
+					LinkGettingHandler:  new(MockHandler),
+					LinkUpdatingHandler: func() http.Handler {
+						handler := new(MockHandler)
+						handler.On(
+							"ServeHTTP",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							mock.MatchedBy(func(request *http.Request) bool {
+								var serverID string
+								httputils.ParsePathParameter(request, "serverID", &serverID)
+
+								var code string
+								httputils.ParsePathParameter(request, "code", &code)
+
+								return serverID == "server-id" && code == "code"
+							}),
+						)
+
+						return handler
+					}(),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
+				},
+				request: httptest.NewRequest(
+					http.MethodPatch,
+					"http://example.com/api/v1/links/server-id:code",
+					nil,
+				),
+			},
+			wantStatusCode: http.StatusOK,
+		},
 		{
 			name: "link creating",
 			args: args{
@@ -174,7 +422,11 @@ func TestNewRouter(test *testing.T) {
 
 						return handler
 					}(),
-					StaticFileHandler: new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
 				},
 				request: httptest.NewRequest(
 					http.MethodPost,
@@ -185,13 +437,123 @@ func TestNewRouter(test *testing.T) {
 			wantStatusCode: http.StatusOK,
 		},
 		{
-			name: "static file",
+			name: "link bulk creating",
 			args: args{
 				redirectEndpointPrefix: "/redirect",
 				handlers: Handlers{
 					LinkRedirectHandler: new(MockHandler),
 					LinkGettingHandler:  new(MockHandler),
 					LinkCreatingHandler: new(MockHandler),
+					LinkBulkCreatingHandler: func() http.Handler {
+						handler := new(MockHandler)
+						handler.On(
+							"ServeHTTP",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							mock.MatchedBy(func(*http.Request) bool { return true }),
+						)
+
+						return handler
+					}(),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
+				},
+				request: httptest.NewRequest(
+					http.MethodPost,
+					"http://example.com/api/v1/links/bulk",
+					nil,
+				),
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "click stats getting",
+			args: args{
+				redirectEndpointPrefix: "/redirect",
+				handlers: Handlers{
+					LinkRedirectHandler:     new(MockHandler),
+					LinkGettingHandler:      new(MockHandler),
+					LinkCreatingHandler:     new(MockHandler),
+					LinkBulkCreatingHandler: new(MockHandler),
+					LinkDeletingHandler:     new(MockHandler),
+					LinkUpdatingHandler:     new(MockHandler),
+					ClickStatsGettingHandler: func() http.Handler {
+						handler := new(MockHandler)
+						handler.On(
+							"ServeHTTP",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							mock.MatchedBy(func(request *http.Request) bool {
+								var code string
+								httputils.ParsePathParameter(request, "code", &code)
+
+								return code == "code"
+							}),
+						)
+
+						return handler
+					}(),
+					StaticFileHandler: new(MockHandler),
+				},
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/api/v1/links/code/stats",
+					nil,
+				),
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "click stats getting (with the server ID)",
+			args: args{
+				redirectEndpointPrefix: "/redirect",
+				handlers: Handlers{
+					LinkRedirectHandler:     new(MockHandler),
+					LinkGettingHandler:      new(MockHandler),
+					LinkCreatingHandler:     new(MockHandler),
+					LinkBulkCreatingHandler: new(MockHandler),
+					LinkDeletingHandler:     new(MockHandler),
+					LinkUpdatingHandler:     new(MockHandler),
+					ClickStatsGettingHandler: func() http.Handler {
+						handler := new(MockHandler)
+						handler.On(
+							"ServeHTTP",
+							mock.MatchedBy(func(http.ResponseWriter) bool { return true }),
+							mock.MatchedBy(func(request *http.Request) bool {
+								var serverID string
+								httputils.ParsePathParameter(request, "serverID", &serverID)
+
+								var code string
+								httputils.ParsePathParameter(request, "code", &code)
+
+								return serverID == "server-id" && code == "code"
+							}),
+						)
+
+						return handler
+					}(),
+					StaticFileHandler: new(MockHandler),
+				},
+				request: httptest.NewRequest(
+					http.MethodGet,
+					"http://example.com/api/v1/links/server-id:code/stats",
+					nil,
+				),
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "static file",
+			args: args{
+				redirectEndpointPrefix: "/redirect",
+				handlers: Handlers{
+					LinkRedirectHandler:      new(MockHandler),
+					LinkGettingHandler:       new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
 					StaticFileHandler: func() http.Handler {
 						handler := new(MockHandler)
 						handler.On(
@@ -216,9 +578,13 @@ func TestNewRouter(test *testing.T) {
 			args: args{
 				redirectEndpointPrefix: "/redirect",
 				handlers: Handlers{
-					LinkRedirectHandler: new(MockHandler),
-					LinkGettingHandler:  new(MockHandler),
-					LinkCreatingHandler: new(MockHandler),
+					LinkRedirectHandler:      new(MockHandler),
+					LinkGettingHandler:       new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
 					StaticFileHandler: func() http.Handler {
 						handler := new(MockHandler)
 						handler.On(
@@ -243,10 +609,14 @@ func TestNewRouter(test *testing.T) {
 			args: args{
 				redirectEndpointPrefix: "/redirect",
 				handlers: Handlers{
-					LinkRedirectHandler: new(MockHandler),
-					LinkGettingHandler:  new(MockHandler),
-					LinkCreatingHandler: new(MockHandler),
-					StaticFileHandler:   new(MockHandler),
+					LinkRedirectHandler:      new(MockHandler),
+					LinkGettingHandler:       new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
 				},
 				request: httptest.NewRequest(
 					http.MethodPost,
@@ -261,9 +631,13 @@ func TestNewRouter(test *testing.T) {
 			args: args{
 				redirectEndpointPrefix: "/redirect",
 				handlers: Handlers{
-					LinkRedirectHandler: new(MockHandler),
-					LinkGettingHandler:  new(MockHandler),
-					LinkCreatingHandler: new(MockHandler),
+					LinkRedirectHandler:      new(MockHandler),
+					LinkGettingHandler:       new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
 					StaticFileHandler: func() http.Handler {
 						handler := new(MockHandler)
 						handler.On(
@@ -288,10 +662,14 @@ func TestNewRouter(test *testing.T) {
 			args: args{
 				redirectEndpointPrefix: "/redirect",
 				handlers: Handlers{
-					LinkRedirectHandler: new(MockHandler),
-					LinkGettingHandler:  new(MockHandler),
-					LinkCreatingHandler: new(MockHandler),
-					StaticFileHandler:   new(MockHandler),
+					LinkRedirectHandler:      new(MockHandler),
+					LinkGettingHandler:       new(MockHandler),
+					LinkCreatingHandler:      new(MockHandler),
+					LinkBulkCreatingHandler:  new(MockHandler),
+					LinkDeletingHandler:      new(MockHandler),
+					LinkUpdatingHandler:      new(MockHandler),
+					ClickStatsGettingHandler: new(MockHandler),
+					StaticFileHandler:        new(MockHandler),
 				},
 				request: httptest.NewRequest(
 					http.MethodPost,
@@ -315,6 +693,10 @@ func TestNewRouter(test *testing.T) {
 				data.args.handlers.LinkRedirectHandler,
 				data.args.handlers.LinkGettingHandler,
 				data.args.handlers.LinkCreatingHandler,
+				data.args.handlers.LinkBulkCreatingHandler,
+				data.args.handlers.LinkDeletingHandler,
+				data.args.handlers.LinkUpdatingHandler,
+				data.args.handlers.ClickStatsGettingHandler,
 				data.args.handlers.StaticFileHandler,
 			)
 			assert.Equal(test, data.wantStatusCode, response.StatusCode)
@@ -0,0 +1,28 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// ClickSetter ...
+type ClickSetter struct {
+	Client Client
+}
+
+// SetClicks ...
+func (setter ClickSetter) SetClicks(
+	_ context.Context,
+	clicks []entities.Click,
+) error {
+	data := setter.Client.data
+	data.lock.Lock()
+	defer data.lock.Unlock()
+
+	for _, click := range clicks {
+		data.clicks[click.Code] = append(data.clicks[click.Code], click)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+const clickDateLayout = "2006-01-02"
+
+// ClickStatsGetter ...
+type ClickStatsGetter struct {
+	Client Client
+}
+
+// GetClickStats ...
+func (getter ClickStatsGetter) GetClickStats(
+	_ context.Context,
+	code string,
+) (entities.ClickStats, error) {
+	data := getter.Client.data
+	data.lock.RLock()
+	defer data.lock.RUnlock()
+
+	dailyCounts := make(map[string]uint64)
+	for _, click := range data.clicks[code] {
+		dailyCounts[click.Time.UTC().Format(clickDateLayout)]++
+	}
+
+	stats := entities.ClickStats{Code: code}
+	for date, count := range dailyCounts {
+		stats.TotalCount += count
+		stats.DailyCounts = append(stats.DailyCounts, entities.DailyClickCount{
+			Date:  date,
+			Count: count,
+		})
+	}
+	sort.Slice(stats.DailyCounts, func(i int, j int) bool {
+		return stats.DailyCounts[i].Date < stats.DailyCounts[j].Date
+	})
+
+	return stats, nil
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestClickStatsGetter_GetClickStats(test *testing.T) {
+	type args struct {
+		code string
+	}
+
+	clickTime := time.Date(2019, time.December, 1, 2, 3, 4, 0, time.UTC)
+	for _, data := range []struct {
+		name      string
+		clicks    []entities.Click
+		args      args
+		wantStats entities.ClickStats
+	}{
+		{
+			name: "success",
+			clicks: []entities.Click{
+				{Code: "code", Time: clickTime.AddDate(0, 0, 1)},
+				{Code: "code", Time: clickTime},
+				{Code: "code", Time: clickTime.Add(time.Hour)},
+				{Code: "another code", Time: clickTime},
+			},
+			args: args{"code"},
+			wantStats: entities.ClickStats{
+				Code:       "code",
+				TotalCount: 3,
+				DailyCounts: []entities.DailyClickCount{
+					{Date: "2019-12-01", Count: 2},
+					{Date: "2019-12-02", Count: 1},
+				},
+			},
+		},
+		{
+			name: "success without clicks",
+			clicks: []entities.Click{
+				{Code: "another code", Time: clickTime},
+			},
+			args:      args{"code"},
+			wantStats: entities.ClickStats{Code: "code"},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client := NewClient()
+			err := ClickSetter{Client: client}.SetClicks(context.Background(), data.clicks)
+			require.NoError(test, err)
+
+			getter := ClickStatsGetter{Client: client}
+			gotStats, gotErr := getter.GetClickStats(context.Background(), data.args.code)
+
+			assert.Equal(test, data.wantStats, gotStats)
+			assert.NoError(test, gotErr)
+		})
+	}
+}
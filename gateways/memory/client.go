@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// Client ...
+//
+// It's safe for concurrent use. Copies of the client share the same data.
+//
+type Client struct {
+	data *data
+}
+
+type data struct {
+	lock        sync.RWMutex
+	linksByCode map[string]entities.Link
+	linksByURL  map[string]entities.Link
+	clicks      map[string][]entities.Click
+	counters    map[string]uint64
+}
+
+// NewClient ...
+func NewClient() Client {
+	return Client{
+		data: &data{
+			linksByCode: make(map[string]entities.Link),
+			linksByURL:  make(map[string]entities.Link),
+			clicks:      make(map[string][]entities.Click),
+			counters:    make(map[string]uint64),
+		},
+	}
+}
+
+// it should be called under the lock
+func (data *data) setLink(link entities.Link) {
+	data.linksByCode[link.Code] = link
+	data.linksByURL[link.URL] = link
+}
+
+// it should be called under the lock
+func (data *data) deleteLink(link entities.Link) {
+	delete(data.linksByCode, link.Code)
+	delete(data.linksByURL, link.URL)
+}
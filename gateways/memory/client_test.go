@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func makeClient(links ...entities.Link) Client {
+	client := NewClient()
+	for _, link := range links {
+		client.data.setLink(link)
+	}
+
+	return client
+}
+
+func TestNewClient(test *testing.T) {
+	got := NewClient()
+
+	assert.NotNil(test, got.data)
+	assert.Empty(test, got.data.linksByCode)
+	assert.Empty(test, got.data.linksByURL)
+	assert.Empty(test, got.data.clicks)
+	assert.Empty(test, got.data.counters)
+}
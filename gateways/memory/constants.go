@@ -0,0 +1,7 @@
+package memory
+
+// ...
+const (
+	CodeLinkField = "code"
+	URLLinkField  = "url"
+)
@@ -0,0 +1,36 @@
+package memory
+
+import (
+	"context"
+)
+
+// Counter ...
+type Counter struct {
+	Client Client
+	Name   string
+}
+
+// NextCountChunk ...
+func (counter Counter) NextCountChunk(_ context.Context) (uint64, error) {
+	data := counter.Client.data
+	data.lock.Lock()
+	defer data.lock.Unlock()
+
+	countChunk := data.counters[counter.Name]
+	data.counters[counter.Name]++
+
+	return countChunk, nil
+}
+
+// PeekCountChunk ...
+//
+// It returns the count chunk, which will be returned by the next call
+// of the NextCountChunk method, without reserving it.
+//
+func (counter Counter) PeekCountChunk(_ context.Context) (uint64, error) {
+	data := counter.Client.data
+	data.lock.RLock()
+	defer data.lock.RUnlock()
+
+	return data.counters[counter.Name], nil
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter_NextCountChunk(test *testing.T) {
+	client := NewClient()
+	counterOne := Counter{Client: client, Name: "one"}
+	counterTwo := Counter{Client: client, Name: "two"}
+
+	var gotCountChunks []uint64
+	for _, counter := range []Counter{counterOne, counterOne, counterTwo} {
+		countChunk, err := counter.NextCountChunk(context.Background())
+		assert.NoError(test, err)
+
+		gotCountChunks = append(gotCountChunks, countChunk)
+	}
+
+	assert.Equal(test, []uint64{0, 1, 0}, gotCountChunks)
+}
+
+func TestCounter_PeekCountChunk(test *testing.T) {
+	client := NewClient()
+	counter := Counter{Client: client, Name: "one"}
+
+	var gotCountChunks []uint64
+	for _, action := range []func(ctx context.Context) (uint64, error){
+		counter.PeekCountChunk,
+		counter.NextCountChunk,
+		counter.PeekCountChunk,
+		counter.PeekCountChunk,
+	} {
+		countChunk, err := action(context.Background())
+		assert.NoError(test, err)
+
+		gotCountChunks = append(gotCountChunks, countChunk)
+	}
+
+	assert.Equal(test, []uint64{0, 0, 1, 1}, gotCountChunks)
+}
@@ -0,0 +1,31 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkDeleter ...
+type LinkDeleter struct {
+	Client Client
+}
+
+// DeleteLink ...
+func (deleter LinkDeleter) DeleteLink(
+	_ context.Context,
+	link entities.Link,
+) error {
+	data := deleter.Client.data
+	data.lock.Lock()
+	defer data.lock.Unlock()
+
+	existingLink, ok := data.linksByCode[link.Code]
+	if !ok {
+		return sql.ErrNoRows
+	}
+
+	data.deleteLink(existingLink)
+	return nil
+}
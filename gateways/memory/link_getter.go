@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkGetter ...
+type LinkGetter struct {
+	Client   Client
+	KeyField string
+}
+
+// GetLink ...
+func (getter LinkGetter) GetLink(
+	_ context.Context,
+	query string,
+) (entities.Link, error) {
+	getter.Client.data.lock.RLock()
+	defer getter.Client.data.lock.RUnlock()
+
+	var links map[string]entities.Link
+	switch getter.KeyField {
+	case CodeLinkField:
+		links = getter.Client.data.linksByCode
+	case URLLinkField:
+		links = getter.Client.data.linksByURL
+	default:
+		return entities.Link{},
+			errors.Errorf("unknown key field %q", getter.KeyField)
+	}
+
+	link, ok := links[query]
+	if !ok {
+		return entities.Link{}, sql.ErrNoRows
+	}
+	if link.IsExpired(time.Now()) {
+		return entities.Link{}, entities.ErrLinkExpired
+	}
+
+	return link, nil
+}
@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkGetter_GetLink(test *testing.T) {
+	type fields struct {
+		Client   Client
+		KeyField string
+	}
+	type args struct {
+		query string
+	}
+
+	expiredTime := time.Now().Add(-time.Minute)
+	for _, data := range []struct {
+		name     string
+		fields   fields
+		args     args
+		wantLink entities.Link
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success by the code",
+			fields: fields{
+				Client:   makeClient(entities.Link{Code: "code", URL: "url"}),
+				KeyField: CodeLinkField,
+			},
+			args:     args{"code"},
+			wantLink: entities.Link{Code: "code", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success by the URL",
+			fields: fields{
+				Client:   makeClient(entities.Link{Code: "code", URL: "url"}),
+				KeyField: URLLinkField,
+			},
+			args:     args{"url"},
+			wantLink: entities.Link{Code: "code", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "error without the link",
+			fields: fields{
+				Client:   makeClient(entities.Link{Code: "code", URL: "url"}),
+				KeyField: CodeLinkField,
+			},
+			args:     args{"url"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+		{
+			name: "error with an expired link",
+			fields: fields{
+				Client: makeClient(entities.Link{
+					Code:           "code",
+					URL:            "url",
+					ExpirationTime: &expiredTime,
+				}),
+				KeyField: CodeLinkField,
+			},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkExpired, err, args)
+			},
+		},
+		{
+			name: "error with the key field",
+			fields: fields{
+				Client:   makeClient(entities.Link{Code: "code", URL: "url"}),
+				KeyField: "unknown",
+			},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr:  assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			getter := LinkGetter{
+				Client:   data.fields.Client,
+				KeyField: data.fields.KeyField,
+			}
+			gotLink, gotErr := getter.GetLink(context.Background(), data.args.query)
+
+			assert.Equal(test, data.wantLink, gotLink)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkIterator ...
+type LinkIterator struct {
+	Client Client
+}
+
+// IterateLinks ...
+//
+// It skips expired links, because they are considered as absent. The handler
+// is called for a snapshot of the links, so it may modify the storage.
+//
+func (iterator LinkIterator) IterateLinks(
+	_ context.Context,
+	handler func(link entities.Link) error,
+) error {
+	now := time.Now()
+	var links []entities.Link
+	iterator.Client.data.lock.RLock()
+	for _, link := range iterator.Client.data.linksByCode {
+		if !link.IsExpired(now) {
+			links = append(links, link)
+		}
+	}
+	iterator.Client.data.lock.RUnlock()
+
+	for _, link := range links {
+		if err := handler(link); err != nil {
+			return errors.Wrap(err, "unable to handle the link")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkIterator_IterateLinks(test *testing.T) {
+	type fields struct {
+		Client Client
+	}
+	type args struct {
+		handlerErr error
+	}
+
+	expiredTime := time.Now().Add(-time.Minute)
+	for _, data := range []struct {
+		name      string
+		fields    fields
+		args      args
+		wantLinks []entities.Link
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Client: makeClient(
+					entities.Link{Code: "code #1", URL: "url #1"},
+					entities.Link{Code: "code #2", URL: "url #2", Disabled: true},
+					entities.Link{Code: "code #3", URL: "url #3", ExpirationTime: &expiredTime},
+				),
+			},
+			args: args{handlerErr: nil},
+			wantLinks: []entities.Link{
+				{Code: "code #1", URL: "url #1"},
+				{Code: "code #2", URL: "url #2", Disabled: true},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "success without links",
+			fields:    fields{Client: makeClient()},
+			args:      args{handlerErr: nil},
+			wantLinks: nil,
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "error with the handler",
+			fields: fields{
+				Client: makeClient(entities.Link{Code: "code", URL: "url"}),
+			},
+			args:      args{handlerErr: iotest.ErrTimeout},
+			wantLinks: []entities.Link{{Code: "code", URL: "url"}},
+			wantErr:   assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var gotLinks []entities.Link
+			iterator := LinkIterator{Client: data.fields.Client}
+			gotErr := iterator.IterateLinks(context.Background(), func(link entities.Link) error {
+				gotLinks = append(gotLinks, link)
+				return data.args.handlerErr
+			})
+
+			assert.ElementsMatch(test, data.wantLinks, gotLinks)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
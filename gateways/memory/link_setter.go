@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkSetter ...
+type LinkSetter struct {
+	Client Client
+}
+
+// SetLink ...
+//
+// It repeats the upsert semantics of the MongoDB storage: if the link URL
+// is already present, nothing is changed.
+//
+func (setter LinkSetter) SetLink(
+	_ context.Context,
+	link entities.Link,
+) error {
+	data := setter.Client.data
+	data.lock.Lock()
+	defer data.lock.Unlock()
+
+	// expired links are never purged automatically,
+	// so they should be removed explicitly; otherwise,
+	// they would block their URLs and codes
+	now := time.Now()
+	for _, existingLink := range []entities.Link{
+		data.linksByURL[link.URL],
+		data.linksByCode[link.Code],
+	} {
+		if existingLink.IsExpired(now) {
+			data.deleteLink(existingLink)
+		}
+	}
+
+	if _, ok := data.linksByURL[link.URL]; ok {
+		return nil
+	}
+	if _, ok := data.linksByCode[link.Code]; ok {
+		return entities.ErrLinkConflict
+	}
+
+	data.setLink(link)
+	return nil
+}
@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkSetter_SetLink(test *testing.T) {
+	type fields struct {
+		Client Client
+	}
+	type args struct {
+		link entities.Link
+	}
+
+	expiredTime := time.Now().Add(-time.Minute)
+	for _, data := range []struct {
+		name            string
+		fields          fields
+		args            args
+		wantLinksByCode map[string]entities.Link
+		wantLinksByURL  map[string]entities.Link
+		wantErr         assert.ErrorAssertionFunc
+	}{
+		{
+			name:   "success with creating",
+			fields: fields{Client: makeClient()},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code": {Code: "code", URL: "url"},
+			},
+			wantLinksByURL: map[string]entities.Link{
+				"url": {Code: "code", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with an existing URL",
+			fields: fields{
+				Client: makeClient(entities.Link{Code: "code #1", URL: "url"}),
+			},
+			args: args{
+				link: entities.Link{Code: "code #2", URL: "url"},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code #1": {Code: "code #1", URL: "url"},
+			},
+			wantLinksByURL: map[string]entities.Link{
+				"url": {Code: "code #1", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with replacing of expired links",
+			fields: fields{
+				Client: makeClient(
+					entities.Link{
+						Code:           "code #1",
+						URL:            "url #1",
+						ExpirationTime: &expiredTime,
+					},
+					entities.Link{
+						Code:           "code #2",
+						URL:            "url #2",
+						ExpirationTime: &expiredTime,
+					},
+				),
+			},
+			args: args{
+				link: entities.Link{Code: "code #1", URL: "url #2"},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code #1": {Code: "code #1", URL: "url #2"},
+			},
+			wantLinksByURL: map[string]entities.Link{
+				"url #2": {Code: "code #1", URL: "url #2"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error with an existing code",
+			fields: fields{
+				Client: makeClient(entities.Link{Code: "code", URL: "url #1"}),
+			},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url #2"},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code": {Code: "code", URL: "url #1"},
+			},
+			wantLinksByURL: map[string]entities.Link{
+				"url #1": {Code: "code", URL: "url #1"},
+			},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkConflict, err, args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			setter := LinkSetter{Client: data.fields.Client}
+			gotErr := setter.SetLink(context.Background(), data.args.link)
+
+			data.wantErr(test, gotErr)
+			assert.Equal(test, data.wantLinksByCode, data.fields.Client.data.linksByCode)
+			assert.Equal(test, data.wantLinksByURL, data.fields.Client.data.linksByURL)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkUpdater ...
+type LinkUpdater struct {
+	Client Client
+}
+
+// UpdateLink ...
+//
+// Only mutable fields of the link are updated; now it's the disabling flag.
+//
+func (updater LinkUpdater) UpdateLink(
+	_ context.Context,
+	link entities.Link,
+) error {
+	data := updater.Client.data
+	data.lock.Lock()
+	defer data.lock.Unlock()
+
+	existingLink, ok := data.linksByCode[link.Code]
+	if !ok {
+		return sql.ErrNoRows
+	}
+
+	existingLink.Disabled = link.Disabled
+	data.setLink(existingLink)
+
+	return nil
+}
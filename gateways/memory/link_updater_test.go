@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkUpdater_UpdateLink(test *testing.T) {
+	type args struct {
+		link entities.Link
+	}
+
+	for _, data := range []struct {
+		name            string
+		args            args
+		wantLinksByCode map[string]entities.Link
+		wantLinksByURL  map[string]entities.Link
+		wantErr         assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				link: entities.Link{Code: "code", Disabled: true},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code": {Code: "code", URL: "url", Disabled: true},
+			},
+			wantLinksByURL: map[string]entities.Link{
+				"url": {Code: "code", URL: "url", Disabled: true},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error without the link",
+			args: args{
+				link: entities.Link{Code: "unknown", Disabled: true},
+			},
+			wantLinksByCode: map[string]entities.Link{
+				"code": {Code: "code", URL: "url"},
+			},
+			wantLinksByURL: map[string]entities.Link{
+				"url": {Code: "code", URL: "url"},
+			},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client := makeClient(entities.Link{Code: "code", URL: "url"})
+
+			updater := LinkUpdater{Client: client}
+			gotErr := updater.UpdateLink(context.Background(), data.args.link)
+
+			data.wantErr(test, gotErr)
+			assert.Equal(test, data.wantLinksByCode, client.data.linksByCode)
+			assert.Equal(test, data.wantLinksByURL, client.data.linksByURL)
+		})
+	}
+}
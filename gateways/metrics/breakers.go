@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
+)
+
+// BreakerMetrics ...
+//
+// It implements the observer of circuit breakers. The state is exported
+// as a number: 0 is closed, 1 is half-open and 2 is open.
+//
+type BreakerMetrics struct {
+	states        *prometheus.GaugeVec
+	stateChanges  *prometheus.CounterVec
+	rejectedCalls *prometheus.CounterVec
+}
+
+// NewBreakerMetrics ...
+func NewBreakerMetrics(
+	registerer prometheus.Registerer,
+) (BreakerMetrics, error) {
+	metrics := BreakerMetrics{
+		states: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "breaker",
+			Name:      "state",
+			Help:      "State of the circuit breaker.",
+		}, []string{"name"}),
+		stateChanges: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "breaker",
+			Name:      "state_changes_total",
+			Help:      "Count of changes of the circuit breaker state by new states.",
+		}, []string{"name", "state"}),
+		rejectedCalls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "breaker",
+			Name:      "rejected_calls_total",
+			Help:      "Count of calls rejected by the open circuit breaker.",
+		}, []string{"name"}),
+	}
+	if err := register(
+		registerer,
+		metrics.states,
+		metrics.stateChanges,
+		metrics.rejectedCalls,
+	); err != nil {
+		return BreakerMetrics{}, err
+	}
+
+	return metrics, nil
+}
+
+// ObserveStateChange ...
+func (metrics BreakerMetrics) ObserveStateChange(
+	name string,
+	state breakers.State,
+) {
+	metrics.states.WithLabelValues(name).Set(float64(state))
+	metrics.stateChanges.WithLabelValues(name, state.String()).Inc()
+}
+
+// ObserveRejectedCall ...
+func (metrics BreakerMetrics) ObserveRejectedCall(name string) {
+	metrics.rejectedCalls.WithLabelValues(name).Inc()
+}
@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
+)
+
+func TestNewBreakerMetrics(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	_, err := NewBreakerMetrics(registry)
+	require.NoError(test, err)
+
+	// the metrics can't be registered twice
+	_, err = NewBreakerMetrics(registry)
+	assert.Error(test, err)
+}
+
+func TestBreakerMetrics(test *testing.T) {
+	metrics, err := NewBreakerMetrics(prometheus.NewRegistry())
+	require.NoError(test, err)
+
+	metrics.ObserveStateChange("cache", breakers.OpenState)
+	metrics.ObserveRejectedCall("cache")
+	metrics.ObserveRejectedCall("cache")
+	metrics.ObserveStateChange("cache", breakers.HalfOpenState)
+	metrics.ObserveStateChange("cache", breakers.OpenState)
+	metrics.ObserveStateChange("storage", breakers.OpenState)
+	metrics.ObserveStateChange("storage", breakers.HalfOpenState)
+	metrics.ObserveStateChange("storage", breakers.ClosedState)
+
+	for _, data := range []struct {
+		collector prometheus.Collector
+		want      float64
+	}{
+		{metrics.states.WithLabelValues("cache"), 2},
+		{metrics.states.WithLabelValues("storage"), 0},
+		{metrics.stateChanges.WithLabelValues("cache", "open"), 2},
+		{metrics.stateChanges.WithLabelValues("cache", "half-open"), 1},
+		{metrics.stateChanges.WithLabelValues("storage", "closed"), 1},
+		{metrics.rejectedCalls.WithLabelValues("cache"), 2},
+		{metrics.rejectedCalls.WithLabelValues("storage"), 0},
+	} {
+		assert.Equal(test, data.want, testutil.ToFloat64(data.collector))
+	}
+}
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=LinkGetter --inpackage --case=underscore --testonly
+
+// LinkGetter ...
+type LinkGetter interface {
+	GetLink(ctx context.Context, query string) (entities.Link, error)
+}
+
+// CacheMetrics ...
+type CacheMetrics struct {
+	requests *prometheus.CounterVec
+}
+
+// NewCacheMetrics ...
+func NewCacheMetrics(registerer prometheus.Registerer) (CacheMetrics, error) {
+	metrics := CacheMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "requests_total",
+			Help:      "Count of getting of links from the cache by their results.",
+		}, []string{"result"}),
+	}
+	if err := register(registerer, metrics.requests); err != nil {
+		return CacheMetrics{}, err
+	}
+
+	return metrics, nil
+}
+
+// CountingLinkGetter ...
+//
+// It counts hits and misses of the cache. Expired links are considered
+// as misses, and errors are counted separately.
+//
+type CountingLinkGetter struct {
+	LinkGetter LinkGetter
+	Metrics    CacheMetrics
+}
+
+// GetLink ...
+func (getter CountingLinkGetter) GetLink(
+	ctx context.Context,
+	query string,
+) (entities.Link, error) {
+	link, err := getter.LinkGetter.GetLink(ctx, query)
+
+	var result string
+	switch errors.Cause(err) {
+	case nil:
+		result = "hit"
+	case sql.ErrNoRows, entities.ErrLinkExpired:
+		result = "miss"
+	default:
+		result = "error"
+	}
+	getter.Metrics.requests.WithLabelValues(result).Inc()
+
+	// the error is returned as is to keep sentinel errors comparable
+	return link, err
+}
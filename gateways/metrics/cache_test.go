@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/iotest"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestNewCacheMetrics(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	_, err := NewCacheMetrics(registry)
+	require.NoError(test, err)
+
+	// the metrics can't be registered twice
+	_, err = NewCacheMetrics(registry)
+	assert.Error(test, err)
+}
+
+func TestCountingLinkGetter_GetLink(test *testing.T) {
+	type args struct {
+		query string
+	}
+
+	for _, data := range []struct {
+		name       string
+		linkGetter LinkGetter
+		args       args
+		wantResult string
+		wantLink   entities.Link
+		wantErr    error
+	}{
+		{
+			name: "hit",
+			linkGetter: func() LinkGetter {
+				getter := new(MockLinkGetter)
+				getter.
+					On("GetLink", context.Background(), "code").
+					Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+				return getter
+			}(),
+			args:       args{"code"},
+			wantResult: "hit",
+			wantLink:   entities.Link{Code: "code", URL: "url"},
+			wantErr:    nil,
+		},
+		{
+			name: "miss of a link",
+			linkGetter: func() LinkGetter {
+				getter := new(MockLinkGetter)
+				getter.On("GetLink", context.Background(), "code").Return(entities.Link{}, sql.ErrNoRows)
+
+				return getter
+			}(),
+			args:       args{"code"},
+			wantResult: "miss",
+			wantLink:   entities.Link{},
+			wantErr:    sql.ErrNoRows,
+		},
+		{
+			name: "miss of an expired link",
+			linkGetter: func() LinkGetter {
+				getter := new(MockLinkGetter)
+				getter.
+					On("GetLink", context.Background(), "code").
+					Return(entities.Link{}, entities.ErrLinkExpired)
+
+				return getter
+			}(),
+			args:       args{"code"},
+			wantResult: "miss",
+			wantLink:   entities.Link{},
+			wantErr:    entities.ErrLinkExpired,
+		},
+		{
+			name: "error",
+			linkGetter: func() LinkGetter {
+				getter := new(MockLinkGetter)
+				getter.On("GetLink", context.Background(), "code").Return(entities.Link{}, iotest.ErrTimeout)
+
+				return getter
+			}(),
+			args:       args{"code"},
+			wantResult: "error",
+			wantLink:   entities.Link{},
+			wantErr:    iotest.ErrTimeout,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			metrics, err := NewCacheMetrics(prometheus.NewRegistry())
+			require.NoError(test, err)
+
+			getter := CountingLinkGetter{LinkGetter: data.linkGetter, Metrics: metrics}
+			gotLink, gotErr := getter.GetLink(context.Background(), data.args.query)
+
+			mock.AssertExpectationsForObjects(test, data.linkGetter)
+			assert.Equal(
+				test,
+				float64(1),
+				testutil.ToFloat64(metrics.requests.WithLabelValues(data.wantResult)),
+			)
+			assert.Equal(test, data.wantLink, gotLink)
+			assert.Equal(test, data.wantErr, gotErr)
+		})
+	}
+}
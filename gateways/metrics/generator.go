@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// GeneratorMetrics ...
+//
+// It implements the observer of the distributed generator of link codes.
+//
+type GeneratorMetrics struct {
+	generatedCodes prometheus.Counter
+	refilledChunks prometheus.Counter
+	// it shows how often the prefetching of count chunks isn't in time
+	synchronousRefills prometheus.Counter
+	remainingCodes     prometheus.Gauge
+}
+
+// NewGeneratorMetrics ...
+func NewGeneratorMetrics(
+	registerer prometheus.Registerer,
+) (GeneratorMetrics, error) {
+	metrics := GeneratorMetrics{
+		generatedCodes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "generator",
+			Name:      "generated_codes_total",
+			Help:      "Count of generated link codes.",
+		}),
+		refilledChunks: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "generator",
+			Name:      "refilled_chunks_total",
+			Help:      "Count of count chunks got from the distributed counters.",
+		}),
+		synchronousRefills: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "generator",
+			Name:      "synchronous_refills_total",
+			Help:      "Count of count chunks waited for by generating of codes.",
+		}),
+		remainingCodes: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "generator",
+			Name:      "remaining_codes",
+			Help:      "Count of link codes remaining in the current count chunk.",
+		}),
+	}
+	if err := register(
+		registerer,
+		metrics.generatedCodes,
+		metrics.refilledChunks,
+		metrics.synchronousRefills,
+		metrics.remainingCodes,
+	); err != nil {
+		return GeneratorMetrics{}, err
+	}
+
+	return metrics, nil
+}
+
+// ObserveGeneratedCodes ...
+func (metrics GeneratorMetrics) ObserveGeneratedCodes(count int) {
+	metrics.generatedCodes.Add(float64(count))
+}
+
+// ObserveRefilledChunk ...
+func (metrics GeneratorMetrics) ObserveRefilledChunk() {
+	metrics.refilledChunks.Inc()
+}
+
+// ObserveSynchronousRefill ...
+func (metrics GeneratorMetrics) ObserveSynchronousRefill() {
+	metrics.synchronousRefills.Inc()
+}
+
+// ObserveRemainingCodes ...
+func (metrics GeneratorMetrics) ObserveRemainingCodes(count uint64) {
+	metrics.remainingCodes.Set(float64(count))
+}
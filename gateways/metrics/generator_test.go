@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGeneratorMetrics(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	_, err := NewGeneratorMetrics(registry)
+	require.NoError(test, err)
+
+	// the metrics can't be registered twice
+	_, err = NewGeneratorMetrics(registry)
+	assert.Error(test, err)
+}
+
+func TestGeneratorMetrics(test *testing.T) {
+	metrics, err := NewGeneratorMetrics(prometheus.NewRegistry())
+	require.NoError(test, err)
+
+	metrics.ObserveRefilledChunk()
+	metrics.ObserveSynchronousRefill()
+	metrics.ObserveGeneratedCodes(3)
+	metrics.ObserveRemainingCodes(23)
+	metrics.ObserveRefilledChunk()
+	metrics.ObserveGeneratedCodes(1)
+	metrics.ObserveRemainingCodes(42)
+
+	assert.Equal(test, float64(4), testutil.ToFloat64(metrics.generatedCodes))
+	assert.Equal(test, float64(2), testutil.ToFloat64(metrics.refilledChunks))
+	assert.Equal(test, float64(1), testutil.ToFloat64(metrics.synchronousRefills))
+	assert.Equal(test, float64(42), testutil.ToFloat64(metrics.remainingCodes))
+}
@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HTTPMetrics ...
+type HTTPMetrics struct {
+	requests  *prometheus.CounterVec
+	durations *prometheus.HistogramVec
+}
+
+// NewHTTPMetrics ...
+func NewHTTPMetrics(registerer prometheus.Registerer) (HTTPMetrics, error) {
+	labels := []string{"route", "method", "code"}
+	metrics := HTTPMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Count of processed HTTP requests.",
+		}, labels),
+		durations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of processing of HTTP requests.",
+			Buckets:   prometheus.DefBuckets,
+		}, labels),
+	}
+	if err :=
+		register(registerer, metrics.requests, metrics.durations); err != nil {
+		return HTTPMetrics{}, err
+	}
+
+	return metrics, nil
+}
+
+// Middleware ...
+//
+// It should be used by a router, because requests are labeled by templates
+// of their routes instead of their paths to limit the count of label values.
+//
+func (metrics HTTPMetrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(
+		writer http.ResponseWriter,
+		request *http.Request,
+	) {
+		startTime := time.Now()
+		recorder := &statusRecorder{ResponseWriter: writer}
+		next.ServeHTTP(recorder, request)
+
+		var route string
+		if currentRoute := mux.CurrentRoute(request); currentRoute != nil {
+			// routes without a path template are labeled by an empty string
+			route, _ = currentRoute.GetPathTemplate() // nolint: gosec
+		}
+
+		statusCode := recorder.statusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+
+		labels := prometheus.Labels{
+			"route":  route,
+			"method": request.Method,
+			"code":   strconv.Itoa(statusCode),
+		}
+		metrics.requests.With(labels).Inc()
+		metrics.durations.With(labels).Observe(time.Since(startTime).Seconds())
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+
+	statusCode int
+}
+
+func (recorder *statusRecorder) WriteHeader(statusCode int) {
+	if recorder.statusCode == 0 {
+		recorder.statusCode = statusCode
+	}
+
+	recorder.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (recorder *statusRecorder) Write(data []byte) (int, error) {
+	if recorder.statusCode == 0 {
+		recorder.statusCode = http.StatusOK
+	}
+
+	return recorder.ResponseWriter.Write(data)
+}
@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPMetrics(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	_, err := NewHTTPMetrics(registry)
+	require.NoError(test, err)
+
+	// the metrics can't be registered twice
+	_, err = NewHTTPMetrics(registry)
+	assert.Error(test, err)
+}
+
+func TestHTTPMetrics_Middleware(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics, err := NewHTTPMetrics(registry)
+	require.NoError(test, err)
+
+	router := mux.NewRouter()
+	router.
+		HandleFunc("/links/{code}", func(http.ResponseWriter, *http.Request) {}).
+		Methods(http.MethodGet)
+	router.
+		HandleFunc("/links", func(writer http.ResponseWriter, _ *http.Request) {
+			writer.WriteHeader(http.StatusBadRequest)
+			writer.WriteHeader(http.StatusInternalServerError)
+		}).
+		Methods(http.MethodPost)
+	router.Use(metrics.Middleware)
+
+	for _, request := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "http://example.com/links/one", nil),
+		httptest.NewRequest(http.MethodGet, "http://example.com/links/two", nil),
+		httptest.NewRequest(http.MethodPost, "http://example.com/links", nil),
+	} {
+		router.ServeHTTP(httptest.NewRecorder(), request)
+	}
+
+	for _, data := range []struct {
+		labels prometheus.Labels
+		want   int
+	}{
+		{
+			labels: prometheus.Labels{
+				"route":  "/links/{code}",
+				"method": http.MethodGet,
+				"code":   "200",
+			},
+			want: 2,
+		},
+		{
+			labels: prometheus.Labels{
+				"route":  "/links",
+				"method": http.MethodPost,
+				"code":   "400",
+			},
+			want: 1,
+		},
+	} {
+		assert.Equal(
+			test,
+			float64(data.want),
+			testutil.ToFloat64(metrics.requests.With(data.labels)),
+		)
+		assert.Equal(
+			test,
+			uint64(data.want),
+			getSampleCount(
+				test,
+				registry,
+				"link_shortener_http_request_duration_seconds",
+				data.labels,
+			),
+		)
+	}
+}
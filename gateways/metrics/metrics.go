@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// it prefixes names of all the metrics of the service
+const namespace = "link_shortener"
+
+func register(
+	registerer prometheus.Registerer,
+	collectors ...prometheus.Collector,
+) error {
+	for _, collector := range collectors {
+		if err := registerer.Register(collector); err != nil {
+			return errors.Wrap(err, "unable to register the collector")
+		}
+	}
+
+	return nil
+}
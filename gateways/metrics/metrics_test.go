@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "counter"})
+	err := register(registry, counter)
+	require.NoError(test, err)
+
+	err = register(registry, counter)
+	assert.Error(test, err)
+}
+
+// it returns a total count of observations of the histogram with the labels
+func getSampleCount(
+	test *testing.T,
+	gatherer prometheus.Gatherer,
+	name string,
+	labels prometheus.Labels,
+) uint64 {
+	families, err := gatherer.Gather()
+	require.NoError(test, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			metricLabels := make(prometheus.Labels)
+			for _, pair := range metric.GetLabel() {
+				metricLabels[pair.GetName()] = pair.GetValue()
+			}
+			if assert.ObjectsAreEqual(labels, metricLabels) {
+				return metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+
+	return 0
+}
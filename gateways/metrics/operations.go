@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// Databases which operations are observed.
+const (
+	MongoDBDatabase = "mongodb"
+	EtcdDatabase    = "etcd"
+)
+
+// OperationMetrics ...
+type OperationMetrics struct {
+	durations *prometheus.HistogramVec
+	failures  *prometheus.CounterVec
+}
+
+// NewOperationMetrics ...
+func NewOperationMetrics(
+	registerer prometheus.Registerer,
+) (OperationMetrics, error) {
+	labels := []string{"database", "operation"}
+	metrics := OperationMetrics{
+		durations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "database",
+			Name:      "operation_duration_seconds",
+			Help:      "Latency of database operations, including failed ones.",
+			Buckets:   prometheus.DefBuckets,
+		}, labels),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "database",
+			Name:      "operation_errors_total",
+			Help:      "Count of failed database operations.",
+		}, labels),
+	}
+	if err :=
+		register(registerer, metrics.durations, metrics.failures); err != nil {
+		return OperationMetrics{}, err
+	}
+
+	return metrics, nil
+}
+
+// ObserveOperation ...
+func (metrics OperationMetrics) ObserveOperation(
+	database string,
+	operation string,
+	duration time.Duration,
+	err error,
+) {
+	metrics.durations.
+		WithLabelValues(database, operation).
+		Observe(duration.Seconds())
+	if err != nil {
+		metrics.failures.WithLabelValues(database, operation).Inc()
+	}
+}
+
+// MongoDBMonitor ...
+//
+// It observes all the commands sent to MongoDB, so it should be passed
+// to the MongoDB client options.
+//
+func (metrics OperationMetrics) MongoDBMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(
+			_ context.Context,
+			succeededEvent *event.CommandSucceededEvent,
+		) {
+			metrics.ObserveOperation(
+				MongoDBDatabase,
+				succeededEvent.CommandName,
+				time.Duration(succeededEvent.DurationNanos),
+				nil,
+			)
+		},
+		Failed: func(_ context.Context, failedEvent *event.CommandFailedEvent) {
+			metrics.ObserveOperation(
+				MongoDBDatabase,
+				failedEvent.CommandName,
+				time.Duration(failedEvent.DurationNanos),
+				errors.New(failedEvent.Failure),
+			)
+		},
+	}
+}
+
+//go:generate mockery --name=DistributedCounter --inpackage --case=underscore --testonly
+
+// DistributedCounter ...
+type DistributedCounter interface {
+	NextCountChunk(ctx context.Context) (uint64, error)
+}
+
+// ObservedCounter ...
+type ObservedCounter struct {
+	DistributedCounter DistributedCounter
+	Metrics            OperationMetrics
+	Database           string
+}
+
+// NextCountChunk ...
+func (counter ObservedCounter) NextCountChunk(
+	ctx context.Context,
+) (uint64, error) {
+	startTime := time.Now()
+	countChunk, err := counter.DistributedCounter.NextCountChunk(ctx)
+	counter.Metrics.
+		ObserveOperation(counter.Database, "next", time.Since(startTime), err)
+
+	return countChunk, err
+}
@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+func TestNewOperationMetrics(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	_, err := NewOperationMetrics(registry)
+	require.NoError(test, err)
+
+	// the metrics can't be registered twice
+	_, err = NewOperationMetrics(registry)
+	assert.Error(test, err)
+}
+
+func TestOperationMetrics_MongoDBMonitor(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics, err := NewOperationMetrics(registry)
+	require.NoError(test, err)
+
+	monitor := metrics.MongoDBMonitor()
+	monitor.Succeeded(context.Background(), &event.CommandSucceededEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{
+			DurationNanos: int64(time.Millisecond),
+			CommandName:   "find",
+		},
+	})
+	monitor.Failed(context.Background(), &event.CommandFailedEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{
+			DurationNanos: int64(time.Millisecond),
+			CommandName:   "find",
+		},
+		Failure: "failure",
+	})
+
+	labels := prometheus.Labels{"database": MongoDBDatabase, "operation": "find"}
+	assert.Equal(
+		test,
+		uint64(2),
+		getSampleCount(
+			test,
+			registry,
+			"link_shortener_database_operation_duration_seconds",
+			labels,
+		),
+	)
+	assert.Equal(test, float64(1), testutil.ToFloat64(metrics.failures.With(labels)))
+}
+
+func TestObservedCounter(test *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics, err := NewOperationMetrics(registry)
+	require.NoError(test, err)
+
+	distributedCounter := new(MockDistributedCounter)
+	distributedCounter.On("NextCountChunk", context.Background()).Return(uint64(23), nil).Once()
+	distributedCounter.On("NextCountChunk", context.Background()).Return(uint64(0), iotest.ErrTimeout).Once()
+
+	counter := ObservedCounter{
+		DistributedCounter: distributedCounter,
+		Metrics:            metrics,
+		Database:           EtcdDatabase,
+	}
+	gotChunk, gotErr := counter.NextCountChunk(context.Background())
+	assert.Equal(test, uint64(23), gotChunk)
+	assert.NoError(test, gotErr)
+
+	gotChunk, gotErr = counter.NextCountChunk(context.Background())
+	assert.Equal(test, uint64(0), gotChunk)
+	assert.Equal(test, iotest.ErrTimeout, gotErr)
+
+	mock.AssertExpectationsForObjects(test, distributedCounter)
+	for _, data := range []struct {
+		operation    string
+		wantCount    uint64
+		wantFailures float64
+	}{
+		{operation: "next", wantCount: 2, wantFailures: 1},
+	} {
+		labels :=
+			prometheus.Labels{"database": EtcdDatabase, "operation": data.operation}
+		assert.Equal(
+			test,
+			data.wantCount,
+			getSampleCount(
+				test,
+				registry,
+				"link_shortener_database_operation_duration_seconds",
+				labels,
+			),
+		)
+		assert.Equal(
+			test,
+			data.wantFailures,
+			testutil.ToFloat64(metrics.failures.With(labels)),
+		)
+	}
+}
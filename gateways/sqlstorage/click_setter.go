@@ -0,0 +1,67 @@
+package sqlstorage
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+const clickDateLayout = "2006-01-02"
+
+// ClickSetter ...
+type ClickSetter struct {
+	Client Client
+}
+
+// SetClicks ...
+//
+// A click date is stored separately, because functions for dates
+// aren't portable between SQL databases.
+//
+func (setter ClickSetter) SetClicks(
+	ctx context.Context,
+	clicks []entities.Click,
+) error {
+	if len(clicks) == 0 {
+		return nil
+	}
+
+	transaction, err := setter.Client.innerClient.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "unable to begin the transaction")
+	}
+	defer transaction.Rollback() // nolint: errcheck
+
+	statement, err := transaction.PrepareContext(
+		ctx,
+		`INSERT INTO clicks (code, time, date, referrer, user_agent, ip, variant)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	)
+	if err != nil {
+		return errors.Wrap(err, "unable to prepare the statement")
+	}
+	defer statement.Close() // nolint: errcheck
+
+	for _, click := range clicks {
+		clickTime := click.Time.UTC()
+		if _, err := statement.ExecContext(
+			ctx,
+			click.Code,
+			clickTime,
+			clickTime.Format(clickDateLayout),
+			click.Referrer,
+			click.UserAgent,
+			click.IP,
+			click.Variant,
+		); err != nil {
+			return errors.Wrap(err, "unable to set the click in the SQL database")
+		}
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "unable to commit the transaction")
+	}
+
+	return nil
+}
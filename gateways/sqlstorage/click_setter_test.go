@@ -0,0 +1,108 @@
+package sqlstorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestClickSetter_SetClicks(test *testing.T) {
+	type args struct {
+		clicks []entities.Click
+	}
+
+	clickTime := time.Date(2019, time.December, 1, 23, 3, 4, 0, time.UTC)
+	for _, data := range []struct {
+		name       string
+		args       args
+		wantClicks []entities.Click
+		wantDates  []string
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				clicks: []entities.Click{
+					{
+						Code:      "code #1",
+						Time:      clickTime,
+						Referrer:  "referrer",
+						UserAgent: "user-agent",
+						IP:        "192.0.2.0",
+						Variant:   "a",
+					},
+					{
+						Code: "code #2",
+						// the date should be got in UTC
+						Time: clickTime.In(time.FixedZone("UTC+3", 3*60*60)),
+					},
+				},
+			},
+			wantClicks: []entities.Click{
+				{
+					Code:      "code #1",
+					Time:      clickTime,
+					Referrer:  "referrer",
+					UserAgent: "user-agent",
+					IP:        "192.0.2.0",
+					Variant:   "a",
+				},
+				{Code: "code #2", Time: clickTime},
+			},
+			wantDates: []string{"2019-12-01", "2019-12-01"},
+			wantErr:   assert.NoError,
+		},
+		{
+			name:       "success without clicks",
+			args:       args{clicks: nil},
+			wantClicks: nil,
+			wantDates:  nil,
+			wantErr:    assert.NoError,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			setter := ClickSetter{Client: client}
+			gotErr := setter.SetClicks(context.Background(), data.args.clicks)
+
+			rows, err := client.innerClient.Query(
+				`SELECT code, time, date, referrer, user_agent, ip, variant FROM clicks
+				ORDER BY code`,
+			)
+			require.NoError(test, err)
+			defer rows.Close() // nolint: errcheck
+
+			var clicks []entities.Click
+			var dates []string
+			for rows.Next() {
+				var click entities.Click
+				var date string
+				err := rows.Scan(
+					&click.Code,
+					&click.Time,
+					&date,
+					&click.Referrer,
+					&click.UserAgent,
+					&click.IP,
+					&click.Variant,
+				)
+				require.NoError(test, err)
+
+				click.Time = click.Time.UTC()
+				clicks = append(clicks, click)
+				dates = append(dates, date)
+			}
+			require.NoError(test, rows.Err())
+
+			data.wantErr(test, gotErr)
+			assert.Equal(test, data.wantClicks, clicks)
+			assert.Equal(test, data.wantDates, dates)
+		})
+	}
+}
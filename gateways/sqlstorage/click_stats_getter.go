@@ -0,0 +1,51 @@
+package sqlstorage
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// ClickStatsGetter ...
+type ClickStatsGetter struct {
+	Client Client
+}
+
+// GetClickStats ...
+func (getter ClickStatsGetter) GetClickStats(
+	ctx context.Context,
+	code string,
+) (entities.ClickStats, error) {
+	rows, err := getter.Client.innerClient.QueryContext(
+		ctx,
+		`SELECT date, COUNT(*) FROM clicks
+		WHERE code = $1
+		GROUP BY date
+		ORDER BY date`,
+		code,
+	)
+	if err != nil {
+		return entities.ClickStats{},
+			errors.Wrap(err, "unable to aggregate the clicks in the SQL database")
+	}
+	defer rows.Close() // nolint: errcheck
+
+	stats := entities.ClickStats{Code: code}
+	for rows.Next() {
+		var dailyCount entities.DailyClickCount
+		if err := rows.Scan(&dailyCount.Date, &dailyCount.Count); err != nil {
+			return entities.ClickStats{},
+				errors.Wrap(err, "unable to scan the click stats")
+		}
+
+		stats.TotalCount += dailyCount.Count
+		stats.DailyCounts = append(stats.DailyCounts, dailyCount)
+	}
+	if err := rows.Err(); err != nil {
+		return entities.ClickStats{},
+			errors.Wrap(err, "unable to iterate over the click stats")
+	}
+
+	return stats, nil
+}
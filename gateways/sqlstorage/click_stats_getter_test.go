@@ -0,0 +1,69 @@
+package sqlstorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestClickStatsGetter_GetClickStats(test *testing.T) {
+	type args struct {
+		code string
+	}
+
+	clickTime := time.Date(2019, time.December, 1, 2, 3, 4, 0, time.UTC)
+	for _, data := range []struct {
+		name      string
+		clicks    []entities.Click
+		args      args
+		wantStats entities.ClickStats
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			clicks: []entities.Click{
+				{Code: "code", Time: clickTime, IP: "192.0.2.0"},
+				{Code: "code", Time: clickTime.Add(time.Hour)},
+				{Code: "code", Time: clickTime.AddDate(0, 0, 1)},
+				{Code: "another code", Time: clickTime},
+			},
+			args: args{"code"},
+			wantStats: entities.ClickStats{
+				Code:       "code",
+				TotalCount: 3,
+				DailyCounts: []entities.DailyClickCount{
+					{Date: "2019-12-01", Count: 2},
+					{Date: "2019-12-02", Count: 1},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success without clicks",
+			clicks: []entities.Click{
+				{Code: "another code", Time: clickTime},
+			},
+			args:      args{"code"},
+			wantStats: entities.ClickStats{Code: "code"},
+			wantErr:   assert.NoError,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			err := ClickSetter{Client: client}.SetClicks(context.Background(), data.clicks)
+			require.NoError(test, err)
+
+			getter := ClickStatsGetter{Client: client}
+			gotStats, gotErr := getter.GetClickStats(context.Background(), data.args.code)
+
+			assert.Equal(test, data.wantStats, gotStats)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
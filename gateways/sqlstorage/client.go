@@ -0,0 +1,42 @@
+package sqlstorage
+
+import (
+	"database/sql"
+
+	"github.com/pkg/errors"
+)
+
+// Client ...
+type Client struct {
+	innerClient *sql.DB
+	driver      string
+}
+
+// NewClient ...
+//
+// The SQL driver should be registered in advance. The schema of the database
+// is migrated to the latest version automatically.
+//
+func NewClient(driver string, dataSource string) (Client, error) {
+	innerClient, err := sql.Open(driver, dataSource)
+	if err != nil {
+		return Client{}, errors.Wrap(err, "unable to open the SQL database")
+	}
+	if err := innerClient.Ping(); err != nil {
+		innerClient.Close() // nolint: errcheck, gosec
+		return Client{}, errors.Wrap(err, "unable to connect to the SQL database")
+	}
+
+	client := Client{innerClient: innerClient, driver: driver}
+	if err := client.migrate(); err != nil {
+		innerClient.Close() // nolint: errcheck, gosec
+		return Client{}, errors.Wrap(err, "unable to migrate the SQL database")
+	}
+
+	return client, nil
+}
+
+// DB ...
+func (client Client) DB() *sql.DB {
+	return client.innerClient
+}
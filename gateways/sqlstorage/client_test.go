@@ -0,0 +1,115 @@
+package sqlstorage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(test *testing.T) (client Client, cleanup func()) {
+	directory, err := ioutil.TempDir("", "sqlstorage")
+	require.NoError(test, err)
+
+	client, err = NewClient("sqlite3", filepath.Join(directory, "database.db"))
+	require.NoError(test, err)
+
+	return client, func() {
+		client.innerClient.Close() // nolint: errcheck, gosec
+		os.RemoveAll(directory)    // nolint: errcheck, gosec
+	}
+}
+
+func TestNewClient(test *testing.T) {
+	directory, err := ioutil.TempDir("", "sqlstorage")
+	require.NoError(test, err)
+	defer os.RemoveAll(directory) // nolint: errcheck
+
+	type args struct {
+		driver     string
+		dataSource string
+	}
+
+	for _, data := range []struct {
+		name        string
+		args        args
+		wantVersion int
+		wantErr     assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				driver:     "sqlite3",
+				dataSource: filepath.Join(directory, "database.db"),
+			},
+			wantVersion: len(migrations),
+			wantErr:     assert.NoError,
+		},
+		{
+			name: "success with repeated migrating",
+			args: args{
+				driver:     "sqlite3",
+				dataSource: filepath.Join(directory, "database.db"),
+			},
+			wantVersion: len(migrations),
+			wantErr:     assert.NoError,
+		},
+		{
+			name: "error with an unknown driver",
+			args: args{
+				driver:     "unknown",
+				dataSource: filepath.Join(directory, "database.db"),
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error with connecting",
+			args: args{
+				driver:     "sqlite3",
+				dataSource: filepath.Join(directory, "unknown", "database.db"),
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotClient, gotErr := NewClient(data.args.driver, data.args.dataSource)
+
+			data.wantErr(test, gotErr)
+			if gotErr != nil {
+				assert.Nil(test, gotClient.innerClient)
+				return
+			}
+			defer gotClient.innerClient.Close() // nolint: errcheck
+
+			var version int
+			err := gotClient.innerClient.
+				QueryRow(`SELECT MAX(version) FROM schema_migrations`).
+				Scan(&version)
+			require.NoError(test, err)
+
+			assert.Equal(test, data.wantVersion, version)
+		})
+	}
+}
+
+func TestClient_applyMigration(test *testing.T) {
+	client, cleanup := newTestClient(test)
+	defer cleanup()
+
+	// it's the case of a migration applied by another server
+	// while this one was waiting for the lock
+	err := client.applyMigration(0)
+	require.NoError(test, err)
+
+	var count int
+	err = client.innerClient.
+		QueryRow(`SELECT COUNT(*) FROM schema_migrations`).
+		Scan(&count)
+	require.NoError(test, err)
+
+	assert.Equal(test, len(migrations), count)
+}
@@ -0,0 +1,7 @@
+package sqlstorage
+
+// ...
+const (
+	CodeLinkField = "code"
+	URLLinkField  = "url"
+)
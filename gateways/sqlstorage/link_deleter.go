@@ -0,0 +1,36 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkDeleter ...
+type LinkDeleter struct {
+	Client Client
+}
+
+// DeleteLink ...
+func (deleter LinkDeleter) DeleteLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	result, err := deleter.Client.innerClient.
+		ExecContext(ctx, `DELETE FROM links WHERE code = $1`, link.Code)
+	if err != nil {
+		return errors.Wrap(err, "unable to delete the link from the SQL database")
+	}
+
+	deletedCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get the count of the deleted links")
+	}
+	if deletedCount == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
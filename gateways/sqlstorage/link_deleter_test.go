@@ -0,0 +1,65 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkDeleter_DeleteLink(test *testing.T) {
+	type args struct {
+		link entities.Link
+	}
+
+	for _, data := range []struct {
+		name      string
+		args      args
+		wantLinks []entities.Link
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				link: entities.Link{Code: "code #1", URL: "url #1"},
+			},
+			wantLinks: []entities.Link{{Code: "code #2", URL: "url #2"}},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "error without the link",
+			args: args{
+				link: entities.Link{Code: "code #3", URL: "url #3"},
+			},
+			wantLinks: []entities.Link{
+				{Code: "code #1", URL: "url #1"},
+				{Code: "code #2", URL: "url #2"},
+			},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			for _, link := range []entities.Link{
+				{Code: "code #1", URL: "url #1"},
+				{Code: "code #2", URL: "url #2"},
+			} {
+				err := LinkSetter{Client: client}.SetLink(context.Background(), link)
+				require.NoError(test, err)
+			}
+
+			deleter := LinkDeleter{Client: client}
+			gotErr := deleter.DeleteLink(context.Background(), data.args.link)
+
+			data.wantErr(test, gotErr)
+			assert.Equal(test, data.wantLinks, getAllLinks(test, client))
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+const linkColumns = `code, url, expiration_time, disabled, redirect_code,
+	query_forwarding, path_forwarding, redirect_rules, variants`
+
+type rowScanner interface {
+	Scan(destinations ...interface{}) error
+}
+
+// LinkGetter ...
+type LinkGetter struct {
+	Client   Client
+	KeyField string
+}
+
+// GetLink ...
+func (getter LinkGetter) GetLink(
+	ctx context.Context,
+	query string,
+) (entities.Link, error) {
+	// the key field isn't passed by an user, so it's safe to format it
+	// nolint: gosec
+	statement := fmt.Sprintf(
+		`SELECT %s
+		FROM links
+		WHERE %s = $1`,
+		linkColumns,
+		getter.KeyField,
+	)
+
+	row := getter.Client.innerClient.QueryRowContext(ctx, statement, query)
+	link, err := scanLink(row)
+	switch err {
+	case nil:
+		// unlike MongoDB, an SQL database doesn't purge expired links at all,
+		// so they should be filtered out explicitly
+		if link.IsExpired(time.Now()) {
+			return entities.Link{}, entities.ErrLinkExpired
+		}
+
+		return link, nil
+	case sql.ErrNoRows:
+		return entities.Link{}, sql.ErrNoRows
+	default:
+		return entities.Link{},
+			errors.Wrap(err, "unable to get the link from the SQL database")
+	}
+}
+
+// it returns the error of the scanner as is, so it may be compared
+func scanLink(scanner rowScanner) (entities.Link, error) {
+	var link entities.Link
+	var rules, variants string
+	if err := scanner.Scan(
+		&link.Code,
+		&link.URL,
+		&link.ExpirationTime,
+		&link.Disabled,
+		&link.RedirectCode,
+		&link.QueryForwarding,
+		&link.PathForwarding,
+		&rules,
+		&variants,
+	); err != nil {
+		return entities.Link{}, err
+	}
+
+	if rules != "" {
+		if err := json.Unmarshal([]byte(rules), &link.Rules); err != nil {
+			return entities.Link{},
+				errors.Wrap(err, "unable to unmarshal the link rules")
+		}
+	}
+	if variants != "" {
+		if err := json.Unmarshal([]byte(variants), &link.Variants); err != nil {
+			return entities.Link{},
+				errors.Wrap(err, "unable to unmarshal the link variants")
+		}
+	}
+
+	return link, nil
+}
@@ -0,0 +1,148 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkGetter_GetLink(test *testing.T) {
+	type fields struct {
+		keyField string
+	}
+	type args struct {
+		query string
+	}
+
+	expirationTime := time.Now().Add(time.Hour).UTC().Round(time.Millisecond)
+	for _, data := range []struct {
+		name     string
+		fields   fields
+		prepare  func(test *testing.T, client Client)
+		args     args
+		wantLink entities.Link
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:   "success by the code",
+			fields: fields{keyField: CodeLinkField},
+			prepare: func(test *testing.T, client Client) {
+				err := LinkSetter{Client: client}.
+					SetLink(context.Background(), entities.Link{Code: "code", URL: "url"})
+				require.NoError(test, err)
+			},
+			args:     args{"code"},
+			wantLink: entities.Link{Code: "code", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name:   "success by the URL",
+			fields: fields{keyField: URLLinkField},
+			prepare: func(test *testing.T, client Client) {
+				err := LinkSetter{Client: client}.
+					SetLink(context.Background(), entities.Link{Code: "code", URL: "url"})
+				require.NoError(test, err)
+			},
+			args:     args{"url"},
+			wantLink: entities.Link{Code: "code", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name:   "success with all fields",
+			fields: fields{keyField: CodeLinkField},
+			prepare: func(test *testing.T, client Client) {
+				err := LinkSetter{Client: client}.SetLink(context.Background(), entities.Link{
+					Code:            "code",
+					URL:             "url",
+					ExpirationTime:  &expirationTime,
+					RedirectCode:    http.StatusFound,
+					QueryForwarding: entities.LinkQueryForwarding,
+					PathForwarding:  true,
+					Rules: []entities.RedirectRule{
+						{OSes: []string{"ios"}, URL: "url #1"},
+					},
+					Variants: []entities.Variant{
+						{Name: "a", URL: "url #2", Weight: 1},
+					},
+				})
+				require.NoError(test, err)
+
+				err = LinkUpdater{Client: client}.
+					UpdateLink(context.Background(), entities.Link{Code: "code", Disabled: true})
+				require.NoError(test, err)
+			},
+			args: args{"code"},
+			wantLink: entities.Link{
+				Code:            "code",
+				URL:             "url",
+				ExpirationTime:  &expirationTime,
+				Disabled:        true,
+				RedirectCode:    http.StatusFound,
+				QueryForwarding: entities.LinkQueryForwarding,
+				PathForwarding:  true,
+				Rules: []entities.RedirectRule{
+					{OSes: []string{"ios"}, URL: "url #1"},
+				},
+				Variants: []entities.Variant{
+					{Name: "a", URL: "url #2", Weight: 1},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "error without the link",
+			fields:   fields{keyField: CodeLinkField},
+			prepare:  func(test *testing.T, client Client) {},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+		{
+			name:   "error with an expired link",
+			fields: fields{keyField: CodeLinkField},
+			prepare: func(test *testing.T, client Client) {
+				_, err := client.innerClient.Exec(
+					`INSERT INTO links (code, url, expiration_time) VALUES ($1, $2, $3)`,
+					"code",
+					"url",
+					time.Now().Add(-time.Minute).UTC(),
+				)
+				require.NoError(test, err)
+			},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkExpired, err, args)
+			},
+		},
+		{
+			name:     "error with the key field",
+			fields:   fields{keyField: "unknown"},
+			prepare:  func(test *testing.T, client Client) {},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr:  assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			data.prepare(test, client)
+
+			getter := LinkGetter{Client: client, KeyField: data.fields.keyField}
+			gotLink, gotErr := getter.GetLink(context.Background(), data.args.query)
+
+			assert.Equal(test, data.wantLink, gotLink)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
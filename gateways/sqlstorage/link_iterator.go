@@ -0,0 +1,51 @@
+package sqlstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkIterator ...
+type LinkIterator struct {
+	Client Client
+}
+
+// IterateLinks ...
+//
+// It skips expired links, because they are considered as absent.
+//
+func (iterator LinkIterator) IterateLinks(
+	ctx context.Context,
+	handler func(link entities.Link) error,
+) error {
+	rows, err := iterator.Client.innerClient.QueryContext(
+		ctx,
+		`SELECT `+linkColumns+`
+		FROM links
+		WHERE expiration_time IS NULL OR expiration_time > $1`,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "unable to find the links in the SQL database")
+	}
+	defer rows.Close() // nolint: errcheck
+
+	for rows.Next() {
+		link, err := scanLink(rows)
+		if err != nil {
+			return errors.Wrap(err, "unable to scan the link")
+		}
+
+		if err := handler(link); err != nil {
+			return errors.Wrap(err, "unable to handle the link")
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "unable to iterate over the links")
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package sqlstorage
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkIterator_IterateLinks(test *testing.T) {
+	type args struct {
+		handlerErr error
+	}
+
+	expirationTime := time.Now().Add(time.Hour).UTC().Round(time.Millisecond)
+	expiredTime := time.Now().Add(-time.Hour).UTC().Round(time.Millisecond)
+	for _, data := range []struct {
+		name      string
+		prepare   func(test *testing.T, client Client)
+		args      args
+		wantLinks []entities.Link
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			prepare: func(test *testing.T, client Client) {
+				for _, link := range []entities.Link{
+					{Code: "code #1", URL: "url #1"},
+					{Code: "code #2", URL: "url #2", ExpirationTime: &expirationTime},
+					{Code: "code #3", URL: "url #3", ExpirationTime: &expiredTime},
+				} {
+					err := LinkSetter{Client: client}.SetLink(context.Background(), link)
+					require.NoError(test, err)
+				}
+			},
+			args: args{handlerErr: nil},
+			wantLinks: []entities.Link{
+				{Code: "code #1", URL: "url #1"},
+				{Code: "code #2", URL: "url #2", ExpirationTime: &expirationTime},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "success without links",
+			prepare:   func(test *testing.T, client Client) {},
+			args:      args{handlerErr: nil},
+			wantLinks: nil,
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "error with the handler",
+			prepare: func(test *testing.T, client Client) {
+				err := LinkSetter{Client: client}.
+					SetLink(context.Background(), entities.Link{Code: "code", URL: "url"})
+				require.NoError(test, err)
+			},
+			args:      args{handlerErr: iotest.ErrTimeout},
+			wantLinks: []entities.Link{{Code: "code", URL: "url"}},
+			wantErr:   assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			data.prepare(test, client)
+
+			var gotLinks []entities.Link
+			iterator := LinkIterator{Client: client}
+			gotErr := iterator.IterateLinks(context.Background(), func(link entities.Link) error {
+				if link.ExpirationTime != nil {
+					expirationTime := link.ExpirationTime.UTC()
+					link.ExpirationTime = &expirationTime
+				}
+
+				gotLinks = append(gotLinks, link)
+				return data.args.handlerErr
+			})
+
+			assert.ElementsMatch(test, data.wantLinks, gotLinks)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
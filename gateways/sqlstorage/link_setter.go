@@ -0,0 +1,125 @@
+package sqlstorage
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkSetter ...
+type LinkSetter struct {
+	Client Client
+}
+
+// SetLink ...
+func (setter LinkSetter) SetLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	// expired links are never purged automatically,
+	// so they should be removed explicitly; otherwise,
+	// they would block their URLs and codes
+	if _, err := setter.Client.innerClient.ExecContext(
+		ctx,
+		`DELETE FROM links
+		WHERE (url = $1 OR code = $2) AND expiration_time <= $3`,
+		link.URL,
+		link.Code,
+		time.Now().UTC(),
+	); err != nil {
+		return errors.Wrap(
+			err,
+			"unable to remove the expired links from the SQL database",
+		)
+	}
+
+	var expirationTime *time.Time
+	if link.ExpirationTime != nil {
+		utcExpirationTime := link.ExpirationTime.UTC()
+		expirationTime = &utcExpirationTime
+	}
+
+	// rules and variants are stored as a JSON,
+	// because they are never queried separately
+	var rules string
+	if len(link.Rules) != 0 {
+		rulesAsJSON, err := json.Marshal(link.Rules)
+		if err != nil {
+			return errors.Wrap(err, "unable to marshal the link rules")
+		}
+
+		rules = string(rulesAsJSON)
+	}
+
+	var variants string
+	if len(link.Variants) != 0 {
+		variantsAsJSON, err := json.Marshal(link.Variants)
+		if err != nil {
+			return errors.Wrap(err, "unable to marshal the link variants")
+		}
+
+		variants = string(variantsAsJSON)
+	}
+
+	// by the time of setting the database may already have a link created
+	// in another thread; therefore, to avoid duplicates, conflicting links
+	// aren't inserted; it repeats the upsert semantics of the MongoDB storage
+	result, err := setter.Client.innerClient.ExecContext(
+		ctx,
+		`INSERT INTO links (
+			code,
+			url,
+			expiration_time,
+			redirect_code,
+			query_forwarding,
+			path_forwarding,
+			redirect_rules,
+			variants
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT DO NOTHING`,
+		link.Code,
+		link.URL,
+		expirationTime,
+		link.RedirectCode,
+		link.QueryForwarding,
+		link.PathForwarding,
+		rules,
+		variants,
+	)
+	if err != nil {
+		return errors.Wrap(err, "unable to set the link in the SQL database")
+	}
+
+	insertedCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get the count of the inserted links")
+	}
+	if insertedCount != 0 {
+		return nil
+	}
+
+	// the link wasn't inserted; it's fine only if its URL is already present,
+	// otherwise, its code is already taken by another URL
+	var urlCount int
+	if err := setter.Client.innerClient.
+		QueryRowContext(
+			ctx,
+			`SELECT COUNT(*) FROM links WHERE url = $1`,
+			link.URL,
+		).
+		Scan(&urlCount); err != nil {
+		return errors.Wrap(err, "unable to check the link in the SQL database")
+	}
+	if urlCount == 0 {
+		return errors.Wrap(
+			entities.ErrLinkConflict,
+			"unable to set the link in the SQL database",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package sqlstorage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkSetter_SetLink(test *testing.T) {
+	type args struct {
+		link entities.Link
+	}
+
+	expirationTime := time.Now().Add(time.Hour).UTC().Round(time.Millisecond)
+	for _, data := range []struct {
+		name      string
+		prepare   func(test *testing.T, client Client)
+		args      args
+		wantLinks []entities.Link
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success with creating",
+			prepare: func(test *testing.T, client Client) {},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantLinks: []entities.Link{{Code: "code", URL: "url"}},
+			wantErr:   assert.NoError,
+		},
+		{
+			name:    "success with creating and an expiration time",
+			prepare: func(test *testing.T, client Client) {},
+			args: args{
+				link: entities.Link{
+					Code:           "code",
+					URL:            "url",
+					ExpirationTime: &expirationTime,
+				},
+			},
+			wantLinks: []entities.Link{
+				{Code: "code", URL: "url", ExpirationTime: &expirationTime},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with creating and a redirect code",
+			prepare: func(test *testing.T, client Client) {},
+			args: args{
+				link: entities.Link{
+					Code:         "code",
+					URL:          "url",
+					RedirectCode: http.StatusTemporaryRedirect,
+				},
+			},
+			wantLinks: []entities.Link{
+				{Code: "code", URL: "url", RedirectCode: http.StatusTemporaryRedirect},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with creating and forwarding",
+			prepare: func(test *testing.T, client Client) {},
+			args: args{
+				link: entities.Link{
+					Code:            "code",
+					URL:             "url",
+					QueryForwarding: entities.RequestQueryForwarding,
+					PathForwarding:  true,
+				},
+			},
+			wantLinks: []entities.Link{
+				{
+					Code:            "code",
+					URL:             "url",
+					QueryForwarding: entities.RequestQueryForwarding,
+					PathForwarding:  true,
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with creating and rules",
+			prepare: func(test *testing.T, client Client) {},
+			args: args{
+				link: entities.Link{
+					Code: "code",
+					URL:  "url",
+					Rules: []entities.RedirectRule{
+						{OSes: []string{"ios"}, URL: "url #1"},
+						{Languages: []string{"en"}, URL: "url #2"},
+					},
+				},
+			},
+			wantLinks: []entities.Link{
+				{
+					Code: "code",
+					URL:  "url",
+					Rules: []entities.RedirectRule{
+						{OSes: []string{"ios"}, URL: "url #1"},
+						{Languages: []string{"en"}, URL: "url #2"},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with creating and variants",
+			prepare: func(test *testing.T, client Client) {},
+			args: args{
+				link: entities.Link{
+					Code: "code",
+					URL:  "url",
+					Variants: []entities.Variant{
+						{Name: "a", URL: "url #1", Weight: 3},
+						{Name: "b", URL: "url #2", Weight: 1},
+					},
+				},
+			},
+			wantLinks: []entities.Link{
+				{
+					Code: "code",
+					URL:  "url",
+					Variants: []entities.Variant{
+						{Name: "a", URL: "url #1", Weight: 3},
+						{Name: "b", URL: "url #2", Weight: 1},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with an existing URL",
+			prepare: func(test *testing.T, client Client) {
+				err := LinkSetter{Client: client}.
+					SetLink(context.Background(), entities.Link{Code: "code #1", URL: "url"})
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code #2", URL: "url"},
+			},
+			wantLinks: []entities.Link{{Code: "code #1", URL: "url"}},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "success with replacing of an expired link",
+			prepare: func(test *testing.T, client Client) {
+				_, err := client.innerClient.Exec(
+					`INSERT INTO links (code, url, expiration_time) VALUES ($1, $2, $3)`,
+					"code #1",
+					"url",
+					time.Now().Add(-time.Minute).UTC(),
+				)
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code #2", URL: "url"},
+			},
+			wantLinks: []entities.Link{{Code: "code #2", URL: "url"}},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "error with an existing code",
+			prepare: func(test *testing.T, client Client) {
+				err := LinkSetter{Client: client}.
+					SetLink(context.Background(), entities.Link{Code: "code", URL: "url #1"})
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url #2"},
+			},
+			wantLinks: []entities.Link{{Code: "code", URL: "url #1"}},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(
+					test,
+					entities.ErrLinkConflict,
+					errors.Cause(err),
+					args,
+				)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			data.prepare(test, client)
+
+			setter := LinkSetter{Client: client}
+			gotErr := setter.SetLink(context.Background(), data.args.link)
+
+			data.wantErr(test, gotErr)
+			assert.Equal(test, data.wantLinks, getAllLinks(test, client))
+		})
+	}
+}
+
+func getAllLinks(test *testing.T, client Client) []entities.Link {
+	rows, err := client.innerClient.Query(
+		`SELECT code, url, expiration_time, disabled, redirect_code,
+			query_forwarding, path_forwarding, redirect_rules, variants
+		FROM links
+		ORDER BY code`,
+	)
+	require.NoError(test, err)
+	defer rows.Close() // nolint: errcheck
+
+	var links []entities.Link
+	for rows.Next() {
+		var link entities.Link
+		var rules, variants string
+		err := rows.Scan(
+			&link.Code,
+			&link.URL,
+			&link.ExpirationTime,
+			&link.Disabled,
+			&link.RedirectCode,
+			&link.QueryForwarding,
+			&link.PathForwarding,
+			&rules,
+			&variants,
+		)
+		require.NoError(test, err)
+
+		if rules != "" {
+			err := json.Unmarshal([]byte(rules), &link.Rules)
+			require.NoError(test, err)
+		}
+		if variants != "" {
+			err := json.Unmarshal([]byte(variants), &link.Variants)
+			require.NoError(test, err)
+		}
+
+		links = append(links, link)
+	}
+	require.NoError(test, rows.Err())
+
+	return links
+}
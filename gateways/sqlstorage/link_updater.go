@@ -0,0 +1,43 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkUpdater ...
+type LinkUpdater struct {
+	Client Client
+}
+
+// UpdateLink ...
+//
+// Only mutable fields of the link are updated; now it's the disabling flag.
+//
+func (updater LinkUpdater) UpdateLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	result, err := updater.Client.innerClient.ExecContext(
+		ctx,
+		`UPDATE links SET disabled = $1 WHERE code = $2`,
+		link.Disabled,
+		link.Code,
+	)
+	if err != nil {
+		return errors.Wrap(err, "unable to update the link in the SQL database")
+	}
+
+	updatedCount, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get the count of the updated links")
+	}
+	if updatedCount == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
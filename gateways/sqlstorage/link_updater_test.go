@@ -0,0 +1,80 @@
+package sqlstorage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkUpdater_UpdateLink(test *testing.T) {
+	type args struct {
+		link entities.Link
+	}
+
+	for _, data := range []struct {
+		name      string
+		prepare   func(test *testing.T, client Client)
+		args      args
+		wantLinks []entities.Link
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success with disabling",
+			prepare: func(test *testing.T, client Client) {
+				err := LinkSetter{Client: client}.
+					SetLink(context.Background(), entities.Link{Code: "code", URL: "url"})
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url", Disabled: true},
+			},
+			wantLinks: []entities.Link{{Code: "code", URL: "url", Disabled: true}},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "success with enabling",
+			prepare: func(test *testing.T, client Client) {
+				err := LinkSetter{Client: client}.
+					SetLink(context.Background(), entities.Link{Code: "code", URL: "url"})
+				require.NoError(test, err)
+
+				err = LinkUpdater{Client: client}.
+					UpdateLink(context.Background(), entities.Link{Code: "code", URL: "url", Disabled: true})
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantLinks: []entities.Link{{Code: "code", URL: "url"}},
+			wantErr:   assert.NoError,
+		},
+		{
+			name:    "error without the link",
+			prepare: func(test *testing.T, client Client) {},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url", Disabled: true},
+			},
+			wantLinks: nil,
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, cleanup := newTestClient(test)
+			defer cleanup()
+
+			data.prepare(test, client)
+
+			updater := LinkUpdater{Client: client}
+			gotErr := updater.UpdateLink(context.Background(), data.args.link)
+
+			data.wantErr(test, gotErr)
+			assert.Equal(test, data.wantLinks, getAllLinks(test, client))
+		})
+	}
+}
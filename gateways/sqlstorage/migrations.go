@@ -0,0 +1,113 @@
+package sqlstorage
+
+import (
+	"github.com/pkg/errors"
+)
+
+// the SQL should be portable between PostgreSQL and SQLite; migrations
+// are only appended, never changed, because their indices are their versions
+var migrations = []string{
+	`CREATE TABLE links (
+		code TEXT NOT NULL,
+		url TEXT NOT NULL,
+		expiration_time TIMESTAMP NULL,
+		disabled BOOLEAN NOT NULL DEFAULT FALSE,
+		CONSTRAINT links_code_key UNIQUE (code),
+		CONSTRAINT links_url_key UNIQUE (url)
+	)`,
+	`CREATE TABLE clicks (
+		code TEXT NOT NULL,
+		time TIMESTAMP NOT NULL,
+		date TEXT NOT NULL,
+		referrer TEXT NOT NULL DEFAULT '',
+		user_agent TEXT NOT NULL DEFAULT '',
+		ip TEXT NOT NULL DEFAULT ''
+	)`,
+	`CREATE INDEX clicks_code_date_index ON clicks (code, date)`,
+	`ALTER TABLE links ADD COLUMN redirect_code INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE links ADD COLUMN query_forwarding TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE links ADD COLUMN path_forwarding BOOLEAN NOT NULL DEFAULT FALSE`,
+	`ALTER TABLE links ADD COLUMN redirect_rules TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE links ADD COLUMN variants TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE clicks ADD COLUMN variant TEXT NOT NULL DEFAULT ''`,
+}
+
+const (
+	// it's an arbitrary key of the PostgreSQL advisory lock
+	// that serializes migrating by concurrently started servers
+	migrationLockKey int64 = 7239016451
+
+	selectSchemaVersion = `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`
+)
+
+func (client Client) migrate() error {
+	if _, err := client.innerClient.Exec(
+		`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER NOT NULL PRIMARY KEY
+		)`,
+	); err != nil {
+		return errors.Wrap(err, "unable to create the migration table")
+	}
+
+	var version int
+	if err := client.innerClient.
+		QueryRow(selectSchemaVersion).Scan(&version); err != nil {
+		return errors.Wrap(err, "unable to get the schema version")
+	}
+
+	for ; version < len(migrations); version++ {
+		if err := client.applyMigration(version); err != nil {
+			return errors.Wrapf(err, "unable to apply the migration #%d", version+1)
+		}
+	}
+
+	return nil
+}
+
+func (client Client) applyMigration(version int) error {
+	transaction, err := client.innerClient.Begin()
+	if err != nil {
+		return errors.Wrap(err, "unable to begin the transaction")
+	}
+	defer transaction.Rollback() // nolint: errcheck
+
+	// another server may migrate the database concurrently, so the migrations
+	// are serialized by the lock held until the end of the transaction;
+	// SQLite needs no lock, because its database is used by a single server
+	if client.driver == "postgres" {
+		if _, err := transaction.Exec(
+			`SELECT pg_advisory_xact_lock($1)`,
+			migrationLockKey,
+		); err != nil {
+			return errors.Wrap(err, "unable to lock the schema")
+		}
+	}
+
+	// the migration may be already applied by another server
+	// while this one was waiting for the lock
+	var currentVersion int
+	if err := transaction.
+		QueryRow(selectSchemaVersion).Scan(&currentVersion); err != nil {
+		return errors.Wrap(err, "unable to get the schema version")
+	}
+	if currentVersion > version {
+		return nil
+	}
+
+	if _, err := transaction.Exec(migrations[version]); err != nil {
+		return errors.Wrap(err, "unable to change the schema")
+	}
+
+	if _, err := transaction.Exec(
+		`INSERT INTO schema_migrations (version) VALUES ($1)`,
+		version+1,
+	); err != nil {
+		return errors.Wrap(err, "unable to update the schema version")
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "unable to commit the transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ClickSetter ...
+type ClickSetter struct {
+	Client Client
+}
+
+// SetClicks ...
+func (setter ClickSetter) SetClicks(
+	ctx context.Context,
+	clicks []entities.Click,
+) error {
+	if len(clicks) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, 0, len(clicks))
+	for _, click := range clicks {
+		documents = append(documents, click)
+	}
+
+	// clicks are independent of each other, so there's no reason
+	// to stop inserting at the first failed one
+	_, err := setter.Client.
+		Collection().
+		InsertMany(
+			ctx,
+			documents,
+			options.InsertMany().SetOrdered(false),
+		)
+	if err != nil {
+		return errors.Wrap(err, "unable to set the clicks in MongoDB")
+	}
+
+	return nil
+}
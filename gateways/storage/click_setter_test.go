@@ -0,0 +1,90 @@
+// +build integration
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestClickSetter_SetClicks(test *testing.T) {
+	// nolint: lll
+	type options struct {
+		StorageAddress string `env:"STORAGE_ADDRESS" envDefault:"mongodb://localhost:27017"`
+	}
+	type args struct {
+		clicks []entities.Click
+	}
+
+	var opts options
+	err := env.Parse(&opts)
+	require.NoError(test, err)
+
+	clickTime := time.Date(2019, time.December, 1, 2, 3, 4, 0, time.UTC)
+	for _, data := range []struct {
+		name       string
+		args       args
+		wantClicks []entities.Click
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				clicks: []entities.Click{
+					{Code: "code #1", Time: clickTime, IP: "192.0.2.0"},
+					{Code: "code #2", Time: clickTime.Add(time.Second)},
+				},
+			},
+			wantClicks: []entities.Click{
+				{Code: "code #1", Time: clickTime, IP: "192.0.2.0"},
+				{Code: "code #2", Time: clickTime.Add(time.Second)},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:       "success without clicks",
+			args:       args{clicks: nil},
+			wantClicks: nil,
+			wantErr:    assert.NoError,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, err := NewClient(opts.StorageAddress, "database", "collection")
+			require.NoError(test, err)
+
+			clickClient, err := NewClickClient(client, "clicks")
+			require.NoError(test, err)
+
+			_, err = clickClient.
+				Collection().
+				DeleteMany(context.Background(), bson.M{})
+			require.NoError(test, err)
+
+			setter := ClickSetter{Client: clickClient}
+			gotErr := setter.SetClicks(context.Background(), data.args.clicks)
+
+			cursor, err := clickClient.
+				Collection().
+				Find(context.Background(), bson.M{})
+			require.NoError(test, err)
+
+			var clicks []entities.Click
+			err = cursor.All(context.Background(), &clicks)
+			require.NoError(test, err)
+
+			for index := range clicks {
+				clicks[index].Time = clicks[index].Time.In(time.UTC)
+			}
+
+			data.wantErr(test, gotErr)
+			assert.ElementsMatch(test, data.wantClicks, clicks)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ClickStatsGetter ...
+type ClickStatsGetter struct {
+	Client Client
+}
+
+// GetClickStats ...
+func (getter ClickStatsGetter) GetClickStats(
+	ctx context.Context,
+	code string,
+) (entities.ClickStats, error) {
+	cursor, err := getter.Client.
+		Collection().
+		Aggregate(ctx, bson.A{
+			bson.M{"$match": bson.M{CodeClickField: code}},
+			bson.M{"$group": bson.M{
+				"_id": bson.M{"$dateToString": bson.M{
+					"format": "%Y-%m-%d",
+					"date":   "$" + TimeClickField,
+				}},
+				"count": bson.M{"$sum": 1},
+			}},
+			bson.M{"$sort": bson.M{"_id": 1}},
+		})
+	if err != nil {
+		return entities.ClickStats{},
+			errors.Wrap(err, "unable to aggregate the clicks in MongoDB")
+	}
+
+	var groups []struct {
+		Date  string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return entities.ClickStats{},
+			errors.Wrap(err, "unable to decode the click stats from MongoDB")
+	}
+
+	stats := entities.ClickStats{Code: code}
+	for _, group := range groups {
+		stats.TotalCount += uint64(group.Count)
+		stats.DailyCounts = append(stats.DailyCounts, entities.DailyClickCount{
+			Date:  group.Date,
+			Count: uint64(group.Count),
+		})
+	}
+
+	return stats, nil
+}
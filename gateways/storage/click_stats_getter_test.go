@@ -0,0 +1,89 @@
+// +build integration
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestClickStatsGetter_GetClickStats(test *testing.T) {
+	// nolint: lll
+	type options struct {
+		StorageAddress string `env:"STORAGE_ADDRESS" envDefault:"mongodb://localhost:27017"`
+	}
+	type args struct {
+		code string
+	}
+
+	var opts options
+	err := env.Parse(&opts)
+	require.NoError(test, err)
+
+	clickTime := time.Date(2019, time.December, 1, 2, 3, 4, 0, time.UTC)
+	for _, data := range []struct {
+		name      string
+		clicks    []entities.Click
+		args      args
+		wantStats entities.ClickStats
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			clicks: []entities.Click{
+				{Code: "code", Time: clickTime},
+				{Code: "code", Time: clickTime.Add(time.Hour)},
+				{Code: "code", Time: clickTime.AddDate(0, 0, 1)},
+				{Code: "another code", Time: clickTime},
+			},
+			args: args{"code"},
+			wantStats: entities.ClickStats{
+				Code:       "code",
+				TotalCount: 3,
+				DailyCounts: []entities.DailyClickCount{
+					{Date: "2019-12-01", Count: 2},
+					{Date: "2019-12-02", Count: 1},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success without clicks",
+			clicks: []entities.Click{
+				{Code: "another code", Time: clickTime},
+			},
+			args:      args{"code"},
+			wantStats: entities.ClickStats{Code: "code"},
+			wantErr:   assert.NoError,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, err := NewClient(opts.StorageAddress, "database", "collection")
+			require.NoError(test, err)
+
+			clickClient, err := NewClickClient(client, "clicks")
+			require.NoError(test, err)
+
+			_, err = clickClient.
+				Collection().
+				DeleteMany(context.Background(), bson.M{})
+			require.NoError(test, err)
+
+			err = ClickSetter{Client: clickClient}.SetClicks(context.Background(), data.clicks)
+			require.NoError(test, err)
+
+			getter := ClickStatsGetter{Client: clickClient}
+			gotStats, gotErr := getter.GetClickStats(context.Background(), data.args.code)
+
+			assert.Equal(test, data.wantStats, gotStats)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
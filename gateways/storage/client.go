@@ -17,9 +17,19 @@ type Client struct {
 }
 
 // NewClient ...
-func NewClient(uri string, database string, collection string) (Client, error) {
-	innerClient, err :=
-		mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
+//
+// The additional client options (e.g. a command monitor) are merged
+// with the options parsed from the URI.
+//
+func NewClient(
+	uri string,
+	database string,
+	collection string,
+	clientOptions ...*options.ClientOptions,
+) (Client, error) {
+	allOptions := []*options.ClientOptions{options.Client().ApplyURI(uri)}
+	allOptions = append(allOptions, clientOptions...)
+	innerClient, err := mongo.Connect(context.Background(), allOptions...)
 	if err != nil {
 		return Client{}, errors.Wrap(err, "unable to connect to MongoDB")
 	}
@@ -38,6 +48,7 @@ func NewClient(uri string, database string, collection string) (Client, error) {
 			[]mongo.IndexModel{
 				makeUniqueIndex(CodeLinkField),
 				makeUniqueIndex(URLLinkField),
+				makeTTLIndex(ExpirationTimeLinkField),
 			},
 			options.CreateIndexes(),
 		)
@@ -48,6 +59,38 @@ func NewClient(uri string, database string, collection string) (Client, error) {
 	return client, nil
 }
 
+// NewClickClient ...
+//
+// It shares the connection of the passed client, but uses another collection
+// with indexes suitable for clicks.
+//
+func NewClickClient(client Client, collection string) (Client, error) {
+	clickClient := Client{
+		innerClient: client.innerClient,
+		database:    client.database,
+		collection:  collection,
+	}
+
+	_, err := clickClient.
+		Collection().
+		Indexes().
+		CreateOne(
+			context.Background(),
+			mongo.IndexModel{
+				Keys: bson.D{
+					{Key: CodeClickField, Value: 1},
+					{Key: TimeClickField, Value: 1},
+				},
+			},
+			options.CreateIndexes(),
+		)
+	if err != nil {
+		return Client{}, errors.Wrap(err, "unable to create indexes in MongoDB")
+	}
+
+	return clickClient, nil
+}
+
 // Collection ...
 func (client Client) Collection() *mongo.Collection {
 	return client.innerClient.
@@ -61,3 +104,12 @@ func makeUniqueIndex(key string) mongo.IndexModel {
 		Options: options.Index().SetUnique(true),
 	}
 }
+
+func makeTTLIndex(key string) mongo.IndexModel {
+	// documents are removed as soon as the time specified in the key field
+	// has come; documents without this field are never removed
+	return mongo.IndexModel{
+		Keys:    bson.D{{Key: key, Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}
+}
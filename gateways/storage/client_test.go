@@ -9,14 +9,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type Index struct {
-	Name      string `bson:"name"`
-	Namespace string `bson:"ns"`
-	Key       bson.M `bson:"key"`
-	Unique    bool   `bson:"unique"`
+	Name               string `bson:"name"`
+	Namespace          string `bson:"ns"`
+	Key                bson.M `bson:"key"`
+	Unique             bool   `bson:"unique"`
+	ExpireAfterSeconds *int32 `bson:"expireAfterSeconds"`
 }
 
 func TestNewClient(test *testing.T) {
@@ -60,6 +62,16 @@ func TestNewClient(test *testing.T) {
 					Key:       bson.M{URLLinkField: int32(1)},
 					Unique:    true,
 				},
+				{
+					Name:      ExpirationTimeLinkField + "_1",
+					Namespace: "database.collection",
+					Key:       bson.M{ExpirationTimeLinkField: int32(1)},
+					Unique:    false,
+					ExpireAfterSeconds: func() *int32 {
+						expireAfterSeconds := int32(0)
+						return &expireAfterSeconds
+					}(),
+				},
 			},
 			wantErr: assert.NoError,
 		},
@@ -101,3 +113,59 @@ func TestNewClient(test *testing.T) {
 		})
 	}
 }
+
+func TestNewClient_withOptions(test *testing.T) {
+	var commandNames []string
+	monitor := &event.CommandMonitor{
+		Succeeded: func(_ context.Context, event *event.CommandSucceededEvent) {
+			commandNames = append(commandNames, event.CommandName)
+		},
+	}
+
+	_, err := NewClient(
+		"mongodb://localhost:27017",
+		"database",
+		"collection",
+		options.Client().SetMonitor(monitor),
+	)
+	require.NoError(test, err)
+
+	assert.Contains(test, commandNames, "createIndexes")
+}
+
+func TestNewClickClient(test *testing.T) {
+	client, err := NewClient("mongodb://localhost:27017", "database", "collection")
+	require.NoError(test, err)
+
+	gotClient, gotErr := NewClickClient(client, "clicks")
+	require.NoError(test, gotErr)
+
+	cursor, err := gotClient.
+		Collection().
+		Indexes().
+		List(context.Background(), options.ListIndexes())
+	require.NoError(test, err)
+
+	var indexes []Index
+	err = cursor.All(context.Background(), &indexes)
+	require.NoError(test, err)
+
+	wantIndexes := []Index{
+		{
+			Name:      "_id_",
+			Namespace: "database.clicks",
+			Key:       bson.M{"_id": int32(1)},
+			Unique:    false,
+		},
+		{
+			Name:      CodeClickField + "_1_" + TimeClickField + "_1",
+			Namespace: "database.clicks",
+			Key:       bson.M{CodeClickField: int32(1), TimeClickField: int32(1)},
+			Unique:    false,
+		},
+	}
+	assert.ElementsMatch(test, wantIndexes, indexes)
+	assert.Equal(test, client.innerClient, gotClient.innerClient)
+	assert.Equal(test, "database", gotClient.database)
+	assert.Equal(test, "clicks", gotClient.collection)
+}
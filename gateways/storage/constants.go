@@ -2,6 +2,15 @@ package storage
 
 // ...
 const (
-	CodeLinkField = "code"
-	URLLinkField  = "url"
+	CodeLinkField            = "code"
+	URLLinkField             = "url"
+	ExpirationTimeLinkField  = "expirationtime"
+	DisabledLinkField        = "disabled"
+	RedirectCodeLinkField    = "redirectcode"
+	QueryForwardingLinkField = "queryforwarding"
+	PathForwardingLinkField  = "pathforwarding"
+	RulesLinkField           = "rules"
+	VariantsLinkField        = "variants"
+	CodeClickField           = "code"
+	TimeClickField           = "time"
 )
@@ -0,0 +1,22 @@
+package storage
+
+import (
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const duplicateKeyErrorCode = 11000
+
+func isDuplicateKeyError(err error) bool {
+	switch typedErr := err.(type) {
+	case mongo.WriteException:
+		for _, writeErr := range typedErr.WriteErrors {
+			if writeErr.Code == duplicateKeyErrorCode {
+				return true
+			}
+		}
+	case mongo.CommandError:
+		return typedErr.Code == duplicateKeyErrorCode
+	}
+
+	return false
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func Test_isDuplicateKeyError(test *testing.T) {
+	type args struct {
+		err error
+	}
+
+	for _, data := range []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "write exception with a duplicate key",
+			args: args{
+				err: mongo.WriteException{
+					WriteErrors: mongo.WriteErrors{
+						{Code: duplicateKeyErrorCode, Message: "duplicate key"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "write exception without a duplicate key",
+			args: args{
+				err: mongo.WriteException{
+					WriteErrors: mongo.WriteErrors{{Code: 2, Message: "bad value"}},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "command error with a duplicate key",
+			args: args{
+				err: mongo.CommandError{
+					Code:    duplicateKeyErrorCode,
+					Message: "duplicate key",
+				},
+			},
+			want: true,
+		},
+		{
+			name: "other error",
+			args: args{
+				err: iotest.ErrTimeout,
+			},
+			want: false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := isDuplicateKeyError(data.args.err)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
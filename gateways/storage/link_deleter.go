@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// LinkDeleter ...
+type LinkDeleter struct {
+	Client Client
+}
+
+// DeleteLink ...
+func (deleter LinkDeleter) DeleteLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	result, err := deleter.Client.
+		Collection().
+		DeleteOne(ctx, bson.M{CodeLinkField: link.Code})
+	if err != nil {
+		return errors.Wrap(err, "unable to delete the link from MongoDB")
+	}
+	if result.DeletedCount == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
@@ -17,14 +18,23 @@ type LinkGetter struct {
 }
 
 // GetLink ...
-func (getter LinkGetter) GetLink(query string) (entities.Link, error) {
+func (getter LinkGetter) GetLink(
+	ctx context.Context,
+	query string,
+) (entities.Link, error) {
 	var link entities.Link
 	err := getter.Client.
 		Collection().
-		FindOne(context.Background(), bson.M{getter.KeyField: query}).
+		FindOne(ctx, bson.M{getter.KeyField: query}).
 		Decode(&link)
 	switch err {
 	case nil:
+		// MongoDB purges expired documents with a delay,
+		// so they should be filtered out explicitly
+		if link.IsExpired(time.Now()) {
+			return entities.Link{}, entities.ErrLinkExpired
+		}
+
 		return link, nil
 	case mongo.ErrNoDocuments:
 		return entities.Link{}, sql.ErrNoRows
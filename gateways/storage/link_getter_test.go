@@ -6,8 +6,9 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
-	"github.com/caarlos0/env"
+	"github.com/caarlos0/env/v6"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
@@ -88,6 +89,39 @@ func TestLinkGetter_GetLink(test *testing.T) {
 				return assert.Equal(test, sql.ErrNoRows, err, args)
 			},
 		},
+		{
+			name: "error with an expired link",
+			fields: fields{
+				makeClient: func(test *testing.T) Client {
+					client, err := NewClient(opts.StorageAddress, "database", "collection")
+					require.NoError(test, err)
+
+					return client
+				},
+				keyField: CodeLinkField,
+			},
+			prepare: func(test *testing.T, getter LinkGetter) {
+				_, err := getter.Client.
+					Collection().
+					DeleteMany(context.Background(), bson.M{})
+				require.NoError(test, err)
+
+				expirationTime := time.Now().Add(-time.Minute)
+				_, err = getter.Client.
+					Collection().
+					InsertOne(context.Background(), entities.Link{
+						Code:           "code",
+						URL:            "url",
+						ExpirationTime: &expirationTime,
+					})
+				require.NoError(test, err)
+			},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkExpired, err, args)
+			},
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			client := data.fields.makeClient(test)
@@ -97,7 +131,7 @@ func TestLinkGetter_GetLink(test *testing.T) {
 			}
 			data.prepare(test, getter)
 
-			gotLink, gotErr := getter.GetLink(data.args.query)
+			gotLink, gotErr := getter.GetLink(context.Background(), data.args.query)
 
 			assert.Equal(test, data.wantLink, gotLink)
 			data.wantErr(test, gotErr)
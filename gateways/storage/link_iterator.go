@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// LinkIterator ...
+type LinkIterator struct {
+	Client Client
+}
+
+// IterateLinks ...
+//
+// MongoDB purges expired documents with a delay, so they are filtered out
+// explicitly.
+//
+func (iterator LinkIterator) IterateLinks(
+	ctx context.Context,
+	handler func(link entities.Link) error,
+) error {
+	cursor, err := iterator.Client.
+		Collection().
+		Find(ctx, bson.M{
+			"$or": bson.A{
+				bson.M{ExpirationTimeLinkField: bson.M{"$exists": false}},
+				bson.M{ExpirationTimeLinkField: bson.M{"$gt": time.Now()}},
+			},
+		})
+	if err != nil {
+		return errors.Wrap(err, "unable to find the links in MongoDB")
+	}
+	defer cursor.Close(ctx) // nolint: errcheck
+
+	for cursor.Next(ctx) {
+		var link entities.Link
+		if err := cursor.Decode(&link); err != nil {
+			return errors.Wrap(err, "unable to decode the link from MongoDB")
+		}
+
+		if err := handler(link); err != nil {
+			return errors.Wrap(err, "unable to handle the link")
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return errors.Wrap(err, "unable to iterate over the links in MongoDB")
+	}
+
+	return nil
+}
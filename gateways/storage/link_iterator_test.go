@@ -0,0 +1,97 @@
+// +build integration
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestLinkIterator_IterateLinks(test *testing.T) {
+	// nolint: lll
+	type options struct {
+		StorageAddress string `env:"STORAGE_ADDRESS" envDefault:"mongodb://localhost:27017"`
+	}
+	type args struct {
+		handlerErr error
+	}
+
+	var opts options
+	err := env.Parse(&opts)
+	require.NoError(test, err)
+
+	expirationTime := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	expiredTime := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	for _, data := range []struct {
+		name      string
+		links     []entities.Link
+		args      args
+		wantLinks []entities.Link
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			links: []entities.Link{
+				{Code: "code #1", URL: "url #1", Disabled: true},
+				{Code: "code #2", URL: "url #2", ExpirationTime: &expirationTime},
+				{Code: "code #3", URL: "url #3", ExpirationTime: &expiredTime},
+			},
+			args: args{handlerErr: nil},
+			wantLinks: []entities.Link{
+				{Code: "code #1", URL: "url #1", Disabled: true},
+				{Code: "code #2", URL: "url #2", ExpirationTime: &expirationTime},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "success without links",
+			links:     nil,
+			args:      args{handlerErr: nil},
+			wantLinks: nil,
+			wantErr:   assert.NoError,
+		},
+		{
+			name:      "error with the handler",
+			links:     []entities.Link{{Code: "code", URL: "url"}},
+			args:      args{handlerErr: iotest.ErrTimeout},
+			wantLinks: []entities.Link{{Code: "code", URL: "url"}},
+			wantErr:   assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, err := NewClient(opts.StorageAddress, "database", "collection")
+			require.NoError(test, err)
+
+			_, err = client.Collection().DeleteMany(context.Background(), bson.M{})
+			require.NoError(test, err)
+
+			for _, link := range data.links {
+				_, err := client.Collection().InsertOne(context.Background(), link)
+				require.NoError(test, err)
+			}
+
+			var gotLinks []entities.Link
+			iterator := LinkIterator{Client: client}
+			gotErr := iterator.IterateLinks(context.Background(), func(link entities.Link) error {
+				if link.ExpirationTime != nil {
+					expirationTime := link.ExpirationTime.Local()
+					link.ExpirationTime = &expirationTime
+				}
+
+				gotLinks = append(gotLinks, link)
+				return data.args.handlerErr
+			})
+
+			assert.ElementsMatch(test, data.wantLinks, gotLinks)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -2,10 +2,12 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
@@ -15,22 +17,147 @@ type LinkSetter struct {
 }
 
 // SetLink ...
-func (setter LinkSetter) SetLink(link entities.Link) error {
+func (setter LinkSetter) SetLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	// MongoDB purges expired documents with a delay, so they should be removed
+	// explicitly; otherwise, they would block their URLs and codes
+	_, err := setter.Client.
+		Collection().
+		DeleteMany(
+			ctx,
+			bson.M{
+				"$or": bson.A{
+					bson.M{URLLinkField: link.URL},
+					bson.M{CodeLinkField: link.Code},
+				},
+				ExpirationTimeLinkField: bson.M{"$lte": time.Now()},
+			},
+		)
+	if err != nil {
+		return errors.Wrap(err, "unable to remove the expired links from MongoDB")
+	}
+
 	// by the time of setting the database may already have a link created
 	// in another thread; therefore, to avoid duplicates, we don't insert
 	// but update in the upsert mode; a link code is always unique, so we search
 	// by a link URL
-	_, err := setter.Client.
+	_, err = setter.Client.
 		Collection().
 		UpdateOne(
-			context.Background(),
+			ctx,
 			bson.M{URLLinkField: link.URL},
-			bson.M{"$setOnInsert": bson.M{CodeLinkField: link.Code}},
+			bson.M{"$setOnInsert": makeInsertedFields(link)},
 			options.Update().SetUpsert(true),
 		)
 	if err != nil {
+		if isDuplicateKeyError(err) {
+			// the link URL is used as a search key, so only the link code can be
+			// duplicated here
+			err = entities.ErrLinkConflict
+		}
+
 		return errors.Wrap(err, "unable to set the link in MongoDB")
 	}
 
 	return nil
 }
+
+// SetLinks ...
+//
+// It writes all the links by a single unordered bulk operation, so a failure
+// of one link doesn't prevent writing of the others.
+//
+func (setter LinkSetter) SetLinks(
+	ctx context.Context,
+	links []entities.Link,
+) ([]error, error) {
+	errs := make([]error, len(links))
+	if len(links) == 0 {
+		return errs, nil
+	}
+
+	urls := make(bson.A, 0, len(links))
+	codes := make(bson.A, 0, len(links))
+	models := make([]mongo.WriteModel, 0, len(links))
+	for _, link := range links {
+		urls = append(urls, link.URL)
+		codes = append(codes, link.Code)
+		models = append(
+			models,
+			mongo.NewUpdateOneModel().
+				SetFilter(bson.M{URLLinkField: link.URL}).
+				SetUpdate(bson.M{"$setOnInsert": makeInsertedFields(link)}).
+				SetUpsert(true),
+		)
+	}
+
+	// see the comments in the SetLink() method
+	_, err := setter.Client.
+		Collection().
+		DeleteMany(
+			ctx,
+			bson.M{
+				"$or": bson.A{
+					bson.M{URLLinkField: bson.M{"$in": urls}},
+					bson.M{CodeLinkField: bson.M{"$in": codes}},
+				},
+				ExpirationTimeLinkField: bson.M{"$lte": time.Now()},
+			},
+		)
+	if err != nil {
+		return nil,
+			errors.Wrap(err, "unable to remove the expired links from MongoDB")
+	}
+
+	_, err = setter.Client.
+		Collection().
+		BulkWrite(
+			ctx,
+			models,
+			options.BulkWrite().SetOrdered(false),
+		)
+	if err != nil {
+		bulkErr, ok := err.(mongo.BulkWriteException)
+		if !ok || bulkErr.WriteConcernError != nil {
+			return nil, errors.Wrap(err, "unable to set the links in MongoDB")
+		}
+
+		for _, writeErr := range bulkErr.WriteErrors {
+			var linkErr error = writeErr.WriteError
+			if writeErr.Code == duplicateKeyErrorCode {
+				linkErr = entities.ErrLinkConflict
+			}
+
+			errs[writeErr.Index] =
+				errors.Wrap(linkErr, "unable to set the link in MongoDB")
+		}
+	}
+
+	return errs, nil
+}
+
+func makeInsertedFields(link entities.Link) bson.M {
+	insertedFields := bson.M{CodeLinkField: link.Code}
+	if link.ExpirationTime != nil {
+		insertedFields[ExpirationTimeLinkField] = *link.ExpirationTime
+	}
+	if link.RedirectCode != 0 {
+		insertedFields[RedirectCodeLinkField] = link.RedirectCode
+	}
+	if link.QueryForwarding != "" {
+		insertedFields[QueryForwardingLinkField] = link.QueryForwarding
+	}
+	if link.PathForwarding {
+		insertedFields[PathForwardingLinkField] = link.PathForwarding
+	}
+	if len(link.Rules) != 0 {
+		insertedFields[RulesLinkField] = link.Rules
+	}
+	if len(link.Variants) != 0 {
+		insertedFields[VariantsLinkField] = link.Variants
+	}
+
+	return insertedFields
+}
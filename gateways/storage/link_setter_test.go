@@ -4,9 +4,12 @@ package storage
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
-	"github.com/caarlos0/env"
+	"github.com/caarlos0/env/v6"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
@@ -146,6 +149,191 @@ func TestLinkSetter_SetLink(test *testing.T) {
 				assert.Equal(test, []entities.Link{{Code: "code", URL: "url"}}, links)
 			},
 		},
+		{
+			name: "success with creating (with an expiration time)",
+			fields: fields{
+				makeClient: func(test *testing.T) Client {
+					client, err := NewClient(opts.StorageAddress, "database", "collection")
+					require.NoError(test, err)
+
+					return client
+				},
+			},
+			prepare: func(test *testing.T, setter LinkSetter) {
+				_, err := setter.Client.
+					Collection().
+					DeleteMany(context.Background(), bson.M{})
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{
+					Code: "code",
+					URL:  "url",
+					ExpirationTime: func() *time.Time {
+						expirationTime := time.Date(2100, time.January, 2, 15, 4, 5, 0, time.UTC)
+						return &expirationTime
+					}(),
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, setter LinkSetter) {
+				cursor, err := setter.Client.
+					Collection().
+					Find(context.Background(), bson.M{URLLinkField: "url"})
+				require.NoError(test, err)
+
+				var links []entities.Link
+				err = cursor.All(context.Background(), &links)
+				require.NoError(test, err)
+
+				expirationTime := time.Date(2100, time.January, 2, 15, 4, 5, 0, time.UTC)
+				assert.Equal(test, []entities.Link{
+					{Code: "code", URL: "url", ExpirationTime: &expirationTime},
+				}, links)
+			},
+		},
+		{
+			name: "success with creating (with all the options)",
+			fields: fields{
+				makeClient: func(test *testing.T) Client {
+					client, err := NewClient(opts.StorageAddress, "database", "collection")
+					require.NoError(test, err)
+
+					return client
+				},
+			},
+			prepare: func(test *testing.T, setter LinkSetter) {
+				_, err := setter.Client.
+					Collection().
+					DeleteMany(context.Background(), bson.M{})
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{
+					Code:            "code",
+					URL:             "url",
+					RedirectCode:    http.StatusFound,
+					QueryForwarding: entities.MergeQueryForwarding,
+					PathForwarding:  true,
+					Rules: []entities.RedirectRule{
+						{OSes: []string{"ios"}, URL: "url #1"},
+					},
+					Variants: []entities.Variant{
+						{Name: "a", URL: "url #2", Weight: 1},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, setter LinkSetter) {
+				cursor, err := setter.Client.
+					Collection().
+					Find(context.Background(), bson.M{URLLinkField: "url"})
+				require.NoError(test, err)
+
+				var links []entities.Link
+				err = cursor.All(context.Background(), &links)
+				require.NoError(test, err)
+
+				assert.Equal(test, []entities.Link{
+					{
+						Code:            "code",
+						URL:             "url",
+						RedirectCode:    http.StatusFound,
+						QueryForwarding: entities.MergeQueryForwarding,
+						PathForwarding:  true,
+						Rules: []entities.RedirectRule{
+							{OSes: []string{"ios"}, URL: "url #1"},
+						},
+						Variants: []entities.Variant{
+							{Name: "a", URL: "url #2", Weight: 1},
+						},
+					},
+				}, links)
+			},
+		},
+		{
+			name: "success with replacing an expired link",
+			fields: fields{
+				makeClient: func(test *testing.T) Client {
+					client, err := NewClient(opts.StorageAddress, "database", "collection")
+					require.NoError(test, err)
+
+					return client
+				},
+			},
+			prepare: func(test *testing.T, setter LinkSetter) {
+				_, err := setter.Client.
+					Collection().
+					DeleteMany(context.Background(), bson.M{})
+				require.NoError(test, err)
+
+				expirationTime := time.Now().Add(-time.Minute)
+				_, err = setter.Client.
+					Collection().
+					InsertOne(context.Background(), entities.Link{
+						Code:           "code #1",
+						URL:            "url",
+						ExpirationTime: &expirationTime,
+					})
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code #2", URL: "url"},
+			},
+			wantErr: assert.NoError,
+			check: func(test *testing.T, setter LinkSetter) {
+				cursor, err := setter.Client.
+					Collection().
+					Find(context.Background(), bson.M{URLLinkField: "url"})
+				require.NoError(test, err)
+
+				var links []entities.Link
+				err = cursor.All(context.Background(), &links)
+				require.NoError(test, err)
+
+				assert.Equal(test, []entities.Link{{Code: "code #2", URL: "url"}}, links)
+			},
+		},
+		{
+			name: "error with a code conflict",
+			fields: fields{
+				makeClient: func(test *testing.T) Client {
+					client, err := NewClient(opts.StorageAddress, "database", "collection")
+					require.NoError(test, err)
+
+					return client
+				},
+			},
+			prepare: func(test *testing.T, setter LinkSetter) {
+				_, err := setter.Client.
+					Collection().
+					DeleteMany(context.Background(), bson.M{})
+				require.NoError(test, err)
+
+				_, err = setter.Client.
+					Collection().
+					InsertOne(context.Background(), entities.Link{Code: "code", URL: "url #1"})
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url #2"},
+			},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkConflict, errors.Cause(err), args)
+			},
+			check: func(test *testing.T, setter LinkSetter) {
+				cursor, err := setter.Client.
+					Collection().
+					Find(context.Background(), bson.M{CodeLinkField: "code"})
+				require.NoError(test, err)
+
+				var links []entities.Link
+				err = cursor.All(context.Background(), &links)
+				require.NoError(test, err)
+
+				assert.Equal(test, []entities.Link{{Code: "code", URL: "url #1"}}, links)
+			},
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			client := data.fields.makeClient(test)
@@ -154,8 +342,115 @@ func TestLinkSetter_SetLink(test *testing.T) {
 			}
 			data.prepare(test, setter)
 
-			gotErr := setter.SetLink(data.args.link)
+			gotErr := setter.SetLink(context.Background(), data.args.link)
+
+			data.wantErr(test, gotErr)
+			data.check(test, setter)
+		})
+	}
+}
+
+func TestLinkSetter_SetLinks(test *testing.T) {
+	// nolint: lll
+	type options struct {
+		StorageAddress string `env:"STORAGE_ADDRESS" envDefault:"mongodb://localhost:27017"`
+	}
+	type args struct {
+		links []entities.Link
+	}
+
+	var opts options
+	err := env.Parse(&opts)
+	require.NoError(test, err)
+
+	for _, data := range []struct {
+		name         string
+		prepare      func(test *testing.T, setter LinkSetter)
+		args         args
+		wantErrCause []error
+		wantErr      assert.ErrorAssertionFunc
+		check        func(test *testing.T, setter LinkSetter)
+	}{
+		{
+			name: "success without links",
+			prepare: func(test *testing.T, setter LinkSetter) {
+				_, err := setter.Client.
+					Collection().
+					DeleteMany(context.Background(), bson.M{})
+				require.NoError(test, err)
+			},
+			args:         args{links: nil},
+			wantErrCause: []error{},
+			wantErr:      assert.NoError,
+			check:        func(test *testing.T, setter LinkSetter) {},
+		},
+		{
+			name: "success with links",
+			prepare: func(test *testing.T, setter LinkSetter) {
+				_, err := setter.Client.
+					Collection().
+					DeleteMany(context.Background(), bson.M{})
+				require.NoError(test, err)
+
+				expirationTime := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+				_, err = setter.Client.
+					Collection().
+					InsertMany(context.Background(), []interface{}{
+						entities.Link{Code: "code #1", URL: "url #1"},
+						entities.Link{Code: "code", URL: "url #0"},
+						entities.Link{
+							Code:           "code #4",
+							URL:            "url #4",
+							ExpirationTime: &expirationTime,
+						},
+					})
+				require.NoError(test, err)
+			},
+			args: args{
+				links: []entities.Link{
+					{Code: "code #2", URL: "url #1"},
+					{Code: "code", URL: "url #2"},
+					{Code: "code #3", URL: "url #3"},
+					{Code: "code #5", URL: "url #4"},
+				},
+			},
+			wantErrCause: []error{nil, entities.ErrLinkConflict, nil, nil},
+			wantErr:      assert.NoError,
+			check: func(test *testing.T, setter LinkSetter) {
+				cursor, err := setter.Client.
+					Collection().
+					Find(context.Background(), bson.M{})
+				require.NoError(test, err)
+
+				var links []entities.Link
+				err = cursor.All(context.Background(), &links)
+				require.NoError(test, err)
+
+				wantLinks := []entities.Link{
+					{Code: "code", URL: "url #0"},
+					{Code: "code #1", URL: "url #1"},
+					{Code: "code #3", URL: "url #3"},
+					{Code: "code #5", URL: "url #4"},
+				}
+				assert.ElementsMatch(test, wantLinks, links)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, err := NewClient(opts.StorageAddress, "database", "collection")
+			require.NoError(test, err)
+
+			setter := LinkSetter{
+				Client: client,
+			}
+			data.prepare(test, setter)
+
+			gotErrs, gotErr := setter.SetLinks(context.Background(), data.args.links)
 
+			for index, err := range gotErrs {
+				gotErrs[index] = errors.Cause(err)
+			}
+			assert.Equal(test, data.wantErrCause, gotErrs)
 			data.wantErr(test, gotErr)
 			data.check(test, setter)
 		})
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// LinkUpdater ...
+type LinkUpdater struct {
+	Client Client
+}
+
+// UpdateLink ...
+//
+// Only mutable fields of the link are updated; now it's the disabling flag.
+//
+func (updater LinkUpdater) UpdateLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	result, err := updater.Client.
+		Collection().
+		UpdateOne(
+			ctx,
+			bson.M{CodeLinkField: link.Code},
+			bson.M{"$set": bson.M{DisabledLinkField: link.Disabled}},
+		)
+	if err != nil {
+		return errors.Wrap(err, "unable to update the link in MongoDB")
+	}
+	if result.MatchedCount == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
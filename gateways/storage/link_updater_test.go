@@ -0,0 +1,106 @@
+// +build integration
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestLinkUpdater_UpdateLink(test *testing.T) {
+	// nolint: lll
+	type options struct {
+		StorageAddress string `env:"STORAGE_ADDRESS" envDefault:"mongodb://localhost:27017"`
+	}
+	type args struct {
+		link entities.Link
+	}
+
+	var opts options
+	err := env.Parse(&opts)
+	require.NoError(test, err)
+
+	for _, data := range []struct {
+		name      string
+		prepare   func(test *testing.T, updater LinkUpdater)
+		args      args
+		wantLinks []entities.Link
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success with disabling",
+			prepare: func(test *testing.T, updater LinkUpdater) {
+				_, err := updater.Client.
+					Collection().
+					InsertOne(
+						context.Background(),
+						entities.Link{Code: "code", URL: "url"},
+					)
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url", Disabled: true},
+			},
+			wantLinks: []entities.Link{{Code: "code", URL: "url", Disabled: true}},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "success with enabling",
+			prepare: func(test *testing.T, updater LinkUpdater) {
+				_, err := updater.Client.
+					Collection().
+					InsertOne(
+						context.Background(),
+						entities.Link{Code: "code", URL: "url", Disabled: true},
+					)
+				require.NoError(test, err)
+			},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantLinks: []entities.Link{{Code: "code", URL: "url"}},
+			wantErr:   assert.NoError,
+		},
+		{
+			name:    "error without the link",
+			prepare: func(test *testing.T, updater LinkUpdater) {},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url", Disabled: true},
+			},
+			wantLinks: nil,
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			client, err := NewClient(opts.StorageAddress, "database", "collection")
+			require.NoError(test, err)
+
+			_, err = client.Collection().DeleteMany(context.Background(), bson.M{})
+			require.NoError(test, err)
+
+			updater := LinkUpdater{Client: client}
+			data.prepare(test, updater)
+
+			gotErr := updater.UpdateLink(context.Background(), data.args.link)
+
+			cursor, err := client.Collection().Find(context.Background(), bson.M{})
+			require.NoError(test, err)
+
+			var links []entities.Link
+			err = cursor.All(context.Background(), &links)
+			require.NoError(test, err)
+
+			assert.Equal(test, data.wantLinks, links)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
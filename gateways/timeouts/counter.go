@@ -0,0 +1,38 @@
+package timeouts
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockery --name=DistributedCounter --inpackage --case=underscore --testonly
+
+// DistributedCounter ...
+type DistributedCounter interface {
+	NextCountChunk(ctx context.Context) (uint64, error)
+}
+
+// TimeLimitedCounter ...
+//
+// It limits the time of holding of the generator lock, while a count chunk
+// is being got.
+//
+type TimeLimitedCounter struct {
+	DistributedCounter DistributedCounter
+	Timeout            time.Duration
+}
+
+// NextCountChunk ...
+func (counter TimeLimitedCounter) NextCountChunk(
+	ctx context.Context,
+) (uint64, error) {
+	var countChunk uint64
+	err := runWithTimeout(ctx, counter.Timeout, func(ctx context.Context) error {
+		var err error
+		countChunk, err = counter.DistributedCounter.NextCountChunk(ctx)
+
+		return err
+	})
+
+	return countChunk, err
+}
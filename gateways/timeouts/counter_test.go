@@ -0,0 +1,66 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTimeLimitedCounter_NextCountChunk(test *testing.T) {
+	for _, data := range []struct {
+		name           string
+		innerErr       error
+		innerWait      bool
+		wantCountChunk uint64
+		wantCause      error
+	}{
+		{
+			name:           "success",
+			innerErr:       nil,
+			innerWait:      false,
+			wantCountChunk: 23,
+			wantCause:      nil,
+		},
+		{
+			name:           "error",
+			innerErr:       iotest.ErrTimeout,
+			innerWait:      false,
+			wantCountChunk: 0,
+			wantCause:      iotest.ErrTimeout,
+		},
+		{
+			name:           "error with the timeout",
+			innerErr:       iotest.ErrTimeout,
+			innerWait:      true,
+			wantCountChunk: 0,
+			wantCause:      context.DeadlineExceeded,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			distributedCounter := new(MockDistributedCounter)
+			call := distributedCounter.
+				On("NextCountChunk", withDeadline).
+				Return(data.wantCountChunk, data.innerErr)
+			timeout := time.Hour
+			if data.innerWait {
+				timeout = time.Millisecond
+				call.Run(waitForDeadline)
+			}
+
+			counter := TimeLimitedCounter{
+				DistributedCounter: distributedCounter,
+				Timeout:            timeout,
+			}
+			gotCountChunk, gotErr := counter.NextCountChunk(context.Background())
+
+			mock.AssertExpectationsForObjects(test, distributedCounter)
+			assert.Equal(test, data.wantCountChunk, gotCountChunk)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
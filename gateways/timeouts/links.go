@@ -0,0 +1,193 @@
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=LinkGetter --inpackage --case=underscore --testonly
+
+// LinkGetter ...
+type LinkGetter interface {
+	GetLink(ctx context.Context, query string) (entities.Link, error)
+}
+
+//go:generate mockery --name=LinkSetter --inpackage --case=underscore --testonly
+
+// LinkSetter ...
+type LinkSetter interface {
+	SetLink(ctx context.Context, link entities.Link) error
+}
+
+//go:generate mockery --name=BulkLinkSetter --inpackage --case=underscore --testonly
+
+// BulkLinkSetter ...
+type BulkLinkSetter interface {
+	SetLinks(ctx context.Context, links []entities.Link) ([]error, error)
+}
+
+//go:generate mockery --name=LinkDeleter --inpackage --case=underscore --testonly
+
+// LinkDeleter ...
+type LinkDeleter interface {
+	DeleteLink(ctx context.Context, link entities.Link) error
+}
+
+//go:generate mockery --name=LinkUpdater --inpackage --case=underscore --testonly
+
+// LinkUpdater ...
+type LinkUpdater interface {
+	UpdateLink(ctx context.Context, link entities.Link) error
+}
+
+//go:generate mockery --name=ClickSetter --inpackage --case=underscore --testonly
+
+// ClickSetter ...
+type ClickSetter interface {
+	SetClicks(ctx context.Context, clicks []entities.Click) error
+}
+
+//go:generate mockery --name=ClickStatsGetter --inpackage --case=underscore --testonly
+
+// ClickStatsGetter ...
+type ClickStatsGetter interface {
+	GetClickStats(ctx context.Context, code string) (entities.ClickStats, error)
+}
+
+// TimeLimitedLinkGetter ...
+//
+// A zero timeout means no deadline.
+//
+type TimeLimitedLinkGetter struct {
+	LinkGetter LinkGetter
+	Timeout    time.Duration
+}
+
+// GetLink ...
+func (getter TimeLimitedLinkGetter) GetLink(
+	ctx context.Context,
+	query string,
+) (entities.Link, error) {
+	var link entities.Link
+	err := runWithTimeout(ctx, getter.Timeout, func(ctx context.Context) error {
+		var err error
+		link, err = getter.LinkGetter.GetLink(ctx, query)
+
+		return err
+	})
+
+	return link, err
+}
+
+// TimeLimitedLinkSetter ...
+type TimeLimitedLinkSetter struct {
+	LinkSetter LinkSetter
+	Timeout    time.Duration
+}
+
+// SetLink ...
+func (setter TimeLimitedLinkSetter) SetLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	return runWithTimeout(ctx, setter.Timeout, func(ctx context.Context) error {
+		return setter.LinkSetter.SetLink(ctx, link)
+	})
+}
+
+// TimeLimitedBulkLinkSetter ...
+//
+// The timeout limits the whole batch, not each link.
+//
+type TimeLimitedBulkLinkSetter struct {
+	BulkLinkSetter BulkLinkSetter
+	Timeout        time.Duration
+}
+
+// SetLinks ...
+func (setter TimeLimitedBulkLinkSetter) SetLinks(
+	ctx context.Context,
+	links []entities.Link,
+) ([]error, error) {
+	var errs []error
+	err := runWithTimeout(ctx, setter.Timeout, func(ctx context.Context) error {
+		var err error
+		errs, err = setter.BulkLinkSetter.SetLinks(ctx, links)
+
+		return err
+	})
+
+	return errs, err
+}
+
+// TimeLimitedLinkDeleter ...
+type TimeLimitedLinkDeleter struct {
+	LinkDeleter LinkDeleter
+	Timeout     time.Duration
+}
+
+// DeleteLink ...
+func (deleter TimeLimitedLinkDeleter) DeleteLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	return runWithTimeout(ctx, deleter.Timeout, func(ctx context.Context) error {
+		return deleter.LinkDeleter.DeleteLink(ctx, link)
+	})
+}
+
+// TimeLimitedLinkUpdater ...
+type TimeLimitedLinkUpdater struct {
+	LinkUpdater LinkUpdater
+	Timeout     time.Duration
+}
+
+// UpdateLink ...
+func (updater TimeLimitedLinkUpdater) UpdateLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	return runWithTimeout(ctx, updater.Timeout, func(ctx context.Context) error {
+		return updater.LinkUpdater.UpdateLink(ctx, link)
+	})
+}
+
+// TimeLimitedClickSetter ...
+type TimeLimitedClickSetter struct {
+	ClickSetter ClickSetter
+	Timeout     time.Duration
+}
+
+// SetClicks ...
+func (setter TimeLimitedClickSetter) SetClicks(
+	ctx context.Context,
+	clicks []entities.Click,
+) error {
+	return runWithTimeout(ctx, setter.Timeout, func(ctx context.Context) error {
+		return setter.ClickSetter.SetClicks(ctx, clicks)
+	})
+}
+
+// TimeLimitedClickStatsGetter ...
+type TimeLimitedClickStatsGetter struct {
+	ClickStatsGetter ClickStatsGetter
+	Timeout          time.Duration
+}
+
+// GetClickStats ...
+func (getter TimeLimitedClickStatsGetter) GetClickStats(
+	ctx context.Context,
+	code string,
+) (entities.ClickStats, error) {
+	var stats entities.ClickStats
+	err := runWithTimeout(ctx, getter.Timeout, func(ctx context.Context) error {
+		var err error
+		stats, err = getter.ClickStatsGetter.GetClickStats(ctx, code)
+
+		return err
+	})
+
+	return stats, err
+}
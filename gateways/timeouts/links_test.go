@@ -0,0 +1,353 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestTimeLimitedLinkGetter_GetLink(test *testing.T) {
+	for _, data := range []struct {
+		name       string
+		result     entities.Link
+		innerErr   error
+		innerWait  bool
+		wantResult entities.Link
+		wantCause  error
+	}{
+		{
+			name:       "success",
+			result:     entities.Link{Code: "code", URL: "url"},
+			innerErr:   nil,
+			innerWait:  false,
+			wantResult: entities.Link{Code: "code", URL: "url"},
+			wantCause:  nil,
+		},
+		{
+			name:       "error",
+			result:     entities.Link{},
+			innerErr:   iotest.ErrTimeout,
+			innerWait:  false,
+			wantResult: entities.Link{},
+			wantCause:  iotest.ErrTimeout,
+		},
+		{
+			name:       "error with the timeout",
+			result:     entities.Link{},
+			innerErr:   iotest.ErrTimeout,
+			innerWait:  true,
+			wantResult: entities.Link{},
+			wantCause:  context.DeadlineExceeded,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			linkGetter := new(MockLinkGetter)
+			call := linkGetter.On("GetLink", withDeadline, "code").Return(data.result, data.innerErr)
+			timeout := time.Hour
+			if data.innerWait {
+				timeout = time.Millisecond
+				call.Run(waitForDeadline)
+			}
+
+			getter := TimeLimitedLinkGetter{LinkGetter: linkGetter, Timeout: timeout}
+			gotResult, gotErr := getter.GetLink(context.Background(), "code")
+
+			mock.AssertExpectationsForObjects(test, linkGetter)
+			assert.Equal(test, data.wantResult, gotResult)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestTimeLimitedLinkSetter_SetLink(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		innerErr  error
+		innerWait bool
+		wantCause error
+	}{
+		{
+			name:      "success",
+			innerErr:  nil,
+			innerWait: false,
+			wantCause: nil,
+		},
+		{
+			name:      "error",
+			innerErr:  iotest.ErrTimeout,
+			innerWait: false,
+			wantCause: iotest.ErrTimeout,
+		},
+		{
+			name:      "error with the timeout",
+			innerErr:  iotest.ErrTimeout,
+			innerWait: true,
+			wantCause: context.DeadlineExceeded,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := entities.Link{Code: "code", URL: "url"}
+			linkSetter := new(MockLinkSetter)
+			call := linkSetter.On("SetLink", withDeadline, link).Return(data.innerErr)
+			timeout := time.Hour
+			if data.innerWait {
+				timeout = time.Millisecond
+				call.Run(waitForDeadline)
+			}
+
+			setter := TimeLimitedLinkSetter{LinkSetter: linkSetter, Timeout: timeout}
+			gotErr := setter.SetLink(context.Background(), link)
+
+			mock.AssertExpectationsForObjects(test, linkSetter)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestTimeLimitedBulkLinkSetter_SetLinks(test *testing.T) {
+	for _, data := range []struct {
+		name       string
+		result     []error
+		innerErr   error
+		innerWait  bool
+		wantResult []error
+		wantCause  error
+	}{
+		{
+			name:       "success",
+			result:     []error{nil, entities.ErrLinkConflict},
+			innerErr:   nil,
+			innerWait:  false,
+			wantResult: []error{nil, entities.ErrLinkConflict},
+			wantCause:  nil,
+		},
+		{
+			name:       "error",
+			result:     []error(nil),
+			innerErr:   iotest.ErrTimeout,
+			innerWait:  false,
+			wantResult: []error(nil),
+			wantCause:  iotest.ErrTimeout,
+		},
+		{
+			name:       "error with the timeout",
+			result:     []error(nil),
+			innerErr:   iotest.ErrTimeout,
+			innerWait:  true,
+			wantResult: []error(nil),
+			wantCause:  context.DeadlineExceeded,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			links := []entities.Link{{Code: "code #1", URL: "url #1"}, {Code: "code #2", URL: "url #2"}}
+			bulkLinkSetter := new(MockBulkLinkSetter)
+			call := bulkLinkSetter.On("SetLinks", withDeadline, links).Return(data.result, data.innerErr)
+			timeout := time.Hour
+			if data.innerWait {
+				timeout = time.Millisecond
+				call.Run(waitForDeadline)
+			}
+
+			setter := TimeLimitedBulkLinkSetter{BulkLinkSetter: bulkLinkSetter, Timeout: timeout}
+			gotResult, gotErr := setter.SetLinks(context.Background(), links)
+
+			mock.AssertExpectationsForObjects(test, bulkLinkSetter)
+			assert.Equal(test, data.wantResult, gotResult)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestTimeLimitedLinkDeleter_DeleteLink(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		innerErr  error
+		innerWait bool
+		wantCause error
+	}{
+		{
+			name:      "success",
+			innerErr:  nil,
+			innerWait: false,
+			wantCause: nil,
+		},
+		{
+			name:      "error",
+			innerErr:  iotest.ErrTimeout,
+			innerWait: false,
+			wantCause: iotest.ErrTimeout,
+		},
+		{
+			name:      "error with the timeout",
+			innerErr:  iotest.ErrTimeout,
+			innerWait: true,
+			wantCause: context.DeadlineExceeded,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := entities.Link{Code: "code", URL: "url"}
+			linkDeleter := new(MockLinkDeleter)
+			call := linkDeleter.On("DeleteLink", withDeadline, link).Return(data.innerErr)
+			timeout := time.Hour
+			if data.innerWait {
+				timeout = time.Millisecond
+				call.Run(waitForDeadline)
+			}
+
+			deleter := TimeLimitedLinkDeleter{LinkDeleter: linkDeleter, Timeout: timeout}
+			gotErr := deleter.DeleteLink(context.Background(), link)
+
+			mock.AssertExpectationsForObjects(test, linkDeleter)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestTimeLimitedLinkUpdater_UpdateLink(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		innerErr  error
+		innerWait bool
+		wantCause error
+	}{
+		{
+			name:      "success",
+			innerErr:  nil,
+			innerWait: false,
+			wantCause: nil,
+		},
+		{
+			name:      "error",
+			innerErr:  iotest.ErrTimeout,
+			innerWait: false,
+			wantCause: iotest.ErrTimeout,
+		},
+		{
+			name:      "error with the timeout",
+			innerErr:  iotest.ErrTimeout,
+			innerWait: true,
+			wantCause: context.DeadlineExceeded,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := entities.Link{Code: "code", URL: "url"}
+			linkUpdater := new(MockLinkUpdater)
+			call := linkUpdater.On("UpdateLink", withDeadline, link).Return(data.innerErr)
+			timeout := time.Hour
+			if data.innerWait {
+				timeout = time.Millisecond
+				call.Run(waitForDeadline)
+			}
+
+			updater := TimeLimitedLinkUpdater{LinkUpdater: linkUpdater, Timeout: timeout}
+			gotErr := updater.UpdateLink(context.Background(), link)
+
+			mock.AssertExpectationsForObjects(test, linkUpdater)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestTimeLimitedClickSetter_SetClicks(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		innerErr  error
+		innerWait bool
+		wantCause error
+	}{
+		{
+			name:      "success",
+			innerErr:  nil,
+			innerWait: false,
+			wantCause: nil,
+		},
+		{
+			name:      "error",
+			innerErr:  iotest.ErrTimeout,
+			innerWait: false,
+			wantCause: iotest.ErrTimeout,
+		},
+		{
+			name:      "error with the timeout",
+			innerErr:  iotest.ErrTimeout,
+			innerWait: true,
+			wantCause: context.DeadlineExceeded,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			clicks := []entities.Click{{Code: "code"}, {Code: "code"}}
+			clickSetter := new(MockClickSetter)
+			call := clickSetter.On("SetClicks", withDeadline, clicks).Return(data.innerErr)
+			timeout := time.Hour
+			if data.innerWait {
+				timeout = time.Millisecond
+				call.Run(waitForDeadline)
+			}
+
+			setter := TimeLimitedClickSetter{ClickSetter: clickSetter, Timeout: timeout}
+			gotErr := setter.SetClicks(context.Background(), clicks)
+
+			mock.AssertExpectationsForObjects(test, clickSetter)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestTimeLimitedClickStatsGetter_GetClickStats(test *testing.T) {
+	for _, data := range []struct {
+		name       string
+		result     entities.ClickStats
+		innerErr   error
+		innerWait  bool
+		wantResult entities.ClickStats
+		wantCause  error
+	}{
+		{
+			name:       "success",
+			result:     entities.ClickStats{Code: "code", TotalCount: 23},
+			innerErr:   nil,
+			innerWait:  false,
+			wantResult: entities.ClickStats{Code: "code", TotalCount: 23},
+			wantCause:  nil,
+		},
+		{
+			name:       "error",
+			result:     entities.ClickStats{},
+			innerErr:   iotest.ErrTimeout,
+			innerWait:  false,
+			wantResult: entities.ClickStats{},
+			wantCause:  iotest.ErrTimeout,
+		},
+		{
+			name:       "error with the timeout",
+			result:     entities.ClickStats{},
+			innerErr:   iotest.ErrTimeout,
+			innerWait:  true,
+			wantResult: entities.ClickStats{},
+			wantCause:  context.DeadlineExceeded,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			clickStatsGetter := new(MockClickStatsGetter)
+			call := clickStatsGetter.On("GetClickStats", withDeadline, "code").Return(data.result, data.innerErr)
+			timeout := time.Hour
+			if data.innerWait {
+				timeout = time.Millisecond
+				call.Run(waitForDeadline)
+			}
+
+			getter := TimeLimitedClickStatsGetter{ClickStatsGetter: clickStatsGetter, Timeout: timeout}
+			gotResult, gotErr := getter.GetClickStats(context.Background(), "code")
+
+			mock.AssertExpectationsForObjects(test, clickStatsGetter)
+			assert.Equal(test, data.wantResult, gotResult)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
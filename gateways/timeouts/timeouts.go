@@ -0,0 +1,33 @@
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// it runs the handler with the deadline; if the handler fails because
+// its context is done, the error is replaced with the context error,
+// so the caller can distinguish timeouts and cancellations from other
+// failures regardless of how the backend driver reports them
+func runWithTimeout(
+	ctx context.Context,
+	timeout time.Duration,
+	handler func(ctx context.Context) error,
+) error {
+	// a zero timeout means no deadline, but the parent context is respected
+	// anyway, e.g. on client disconnects
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	err := handler(ctx)
+	if err != nil && ctx.Err() != nil {
+		return errors.WithMessage(ctx.Err(), err.Error())
+	}
+
+	return err
+}
@@ -0,0 +1,121 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_runWithTimeout(test *testing.T) {
+	type args struct {
+		ctx     func() context.Context
+		timeout time.Duration
+		handler func(ctx context.Context) error
+	}
+
+	for _, data := range []struct {
+		name      string
+		args      args
+		wantErr   assert.ErrorAssertionFunc
+		wantCause error
+	}{
+		{
+			name: "success",
+			args: args{
+				ctx:     context.Background,
+				timeout: time.Hour,
+				handler: func(ctx context.Context) error {
+					if _, ok := ctx.Deadline(); !ok {
+						return errors.New("no deadline")
+					}
+
+					return nil
+				},
+			},
+			wantErr:   assert.NoError,
+			wantCause: nil,
+		},
+		{
+			name: "success without a timeout",
+			args: args{
+				ctx:     context.Background,
+				timeout: 0,
+				handler: func(ctx context.Context) error {
+					if _, ok := ctx.Deadline(); ok {
+						return errors.New("unexpected deadline")
+					}
+
+					return nil
+				},
+			},
+			wantErr:   assert.NoError,
+			wantCause: nil,
+		},
+		{
+			name: "error",
+			args: args{
+				ctx:     context.Background,
+				timeout: time.Hour,
+				handler: func(context.Context) error {
+					return iotest.ErrTimeout
+				},
+			},
+			wantErr:   assert.Error,
+			wantCause: iotest.ErrTimeout,
+		},
+		{
+			name: "error with the timeout",
+			args: args{
+				ctx:     context.Background,
+				timeout: time.Millisecond,
+				handler: func(ctx context.Context) error {
+					<-ctx.Done()
+					return iotest.ErrTimeout
+				},
+			},
+			wantErr:   assert.Error,
+			wantCause: context.DeadlineExceeded,
+		},
+		{
+			name: "error with the cancelled parent",
+			args: args{
+				ctx: func() context.Context {
+					ctx, cancel := context.WithCancel(context.Background())
+					cancel()
+
+					return ctx
+				},
+				timeout: time.Hour,
+				handler: func(ctx context.Context) error {
+					<-ctx.Done()
+					return iotest.ErrTimeout
+				},
+			},
+			wantErr:   assert.Error,
+			wantCause: context.Canceled,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			err := runWithTimeout(data.args.ctx(), data.args.timeout, data.args.handler)
+
+			data.wantErr(test, err)
+			assert.Equal(test, data.wantCause, errors.Cause(err))
+		})
+	}
+}
+
+// it matches contexts limited by a deadline
+var withDeadline = mock.MatchedBy(func(ctx context.Context) bool {
+	_, ok := ctx.Deadline()
+	return ok
+})
+
+// it blocks a mock call until the deadline of its context
+func waitForDeadline(arguments mock.Arguments) {
+	<-arguments.Get(0).(context.Context).Done()
+}
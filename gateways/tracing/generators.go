@@ -0,0 +1,105 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//go:generate mockery --name=CodeGenerator --inpackage --case=underscore --testonly
+
+// CodeGenerator ...
+type CodeGenerator interface {
+	GenerateCode(ctx context.Context) (string, error)
+	GenerateCodes(ctx context.Context, count int) ([]string, error)
+}
+
+//go:generate mockery --name=DistributedCounter --inpackage --case=underscore --testonly
+
+// DistributedCounter ...
+type DistributedCounter interface {
+	NextCountChunk(ctx context.Context) (uint64, error)
+}
+
+// TracedCodeGenerator ...
+type TracedCodeGenerator struct {
+	CodeGenerator CodeGenerator
+	Tracer        trace.Tracer
+	Name          string
+}
+
+// GenerateCode ...
+func (generator TracedCodeGenerator) GenerateCode(
+	ctx context.Context,
+) (string, error) {
+	var code string
+	err := runInSpan(
+		ctx,
+		generator.Tracer,
+		generator.Name+".GenerateCode",
+		func(ctx context.Context) error {
+			var err error
+			code, err = generator.CodeGenerator.GenerateCode(ctx)
+
+			return err
+		},
+	)
+
+	return code, err
+}
+
+// GenerateCodes ...
+func (generator TracedCodeGenerator) GenerateCodes(
+	ctx context.Context,
+	count int,
+) ([]string, error) {
+	var codes []string
+	err := runInSpan(
+		ctx,
+		generator.Tracer,
+		generator.Name+".GenerateCodes",
+		func(ctx context.Context) error {
+			var err error
+			codes, err = generator.CodeGenerator.GenerateCodes(ctx, count)
+
+			return err
+		},
+		attribute.Int("code.count", count),
+	)
+
+	return codes, err
+}
+
+// TracedCounter ...
+//
+// The counter name is recorded by the spans, so they can be distinguished
+// in a counter group.
+//
+type TracedCounter struct {
+	DistributedCounter DistributedCounter
+	Tracer             trace.Tracer
+	Name               string
+	CounterName        string
+}
+
+// NextCountChunk ...
+func (counter TracedCounter) NextCountChunk(
+	ctx context.Context,
+) (uint64, error) {
+	var countChunk uint64
+	err := runInSpan(
+		ctx,
+		counter.Tracer,
+		counter.Name+".NextCountChunk",
+		func(ctx context.Context) error {
+			var err error
+			countChunk, err = counter.DistributedCounter.NextCountChunk(ctx)
+
+			return err
+		},
+		attribute.String("counter.name", counter.CounterName),
+	)
+
+	return countChunk, err
+}
@@ -0,0 +1,140 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestTracedCodeGenerator_GenerateCode(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		code     string
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			code:     "code",
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			code:     "",
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			codeGenerator := new(MockCodeGenerator)
+			codeGenerator.On("GenerateCode", inSpan).Return(data.code, data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			generator := TracedCodeGenerator{
+				CodeGenerator: codeGenerator,
+				Tracer:        tracer,
+				Name:          "generator",
+			}
+			gotCode, gotErr := generator.GenerateCode(context.Background())
+
+			mock.AssertExpectationsForObjects(test, codeGenerator)
+			checkSpan(test, recorder, "generator.GenerateCode", data.wantErr)
+			assert.Equal(test, data.code, gotCode)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
+
+func TestTracedCodeGenerator_GenerateCodes(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		codes    []string
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			codes:    []string{"code #1", "code #2"},
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			codes:    nil,
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			codeGenerator := new(MockCodeGenerator)
+			codeGenerator.
+				On("GenerateCodes", inSpan, 2).
+				Return(data.codes, data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			generator := TracedCodeGenerator{
+				CodeGenerator: codeGenerator,
+				Tracer:        tracer,
+				Name:          "generator",
+			}
+			gotCodes, gotErr := generator.GenerateCodes(context.Background(), 2)
+
+			mock.AssertExpectationsForObjects(test, codeGenerator)
+			checkSpan(test, recorder, "generator.GenerateCodes", data.wantErr)
+			assert.Equal(test, data.codes, gotCodes)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
+
+func TestTracedCounter_NextCountChunk(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			distributedCounter := new(MockDistributedCounter)
+			distributedCounter.
+				On("NextCountChunk", inSpan).
+				Return(uint64(23), data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			counter := TracedCounter{
+				DistributedCounter: distributedCounter,
+				Tracer:             tracer,
+				Name:               "etcd",
+				CounterName:        "counter",
+			}
+			gotCountChunk, gotErr := counter.NextCountChunk(context.Background())
+
+			mock.AssertExpectationsForObjects(test, distributedCounter)
+			checkSpan(test, recorder, "etcd.NextCountChunk", data.wantErr)
+			require.Len(test, recorder.Ended(), 1)
+			assert.Equal(
+				test,
+				[]attribute.KeyValue{attribute.String("counter.name", "counter")},
+				recorder.Ended()[0].Attributes(),
+			)
+			assert.Equal(test, uint64(23), gotCountChunk)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
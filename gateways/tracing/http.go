@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HTTPTracer ...
+//
+// The propagator extracts a parent span from headers of requests,
+// so a trace can be continued from a caller.
+//
+type HTTPTracer struct {
+	Tracer     trace.Tracer
+	Propagator propagation.TextMapPropagator
+}
+
+// Middleware ...
+//
+// It should be used by a router, because spans are named by templates
+// of their routes instead of their paths.
+//
+func (tracer HTTPTracer) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(
+		writer http.ResponseWriter,
+		request *http.Request,
+	) {
+		ctx := tracer.Propagator.
+			Extract(request.Context(), propagation.HeaderCarrier(request.Header))
+
+		var route string
+		if currentRoute := mux.CurrentRoute(request); currentRoute != nil {
+			// routes without a path template are named by a method only
+			route, _ = currentRoute.GetPathTemplate() // nolint: gosec
+		}
+
+		name := request.Method
+		if route != "" {
+			name += " " + route
+		}
+
+		ctx, span := tracer.Tracer.Start(
+			ctx,
+			name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(request.Method),
+				semconv.HTTPRoute(route),
+			),
+		)
+		defer span.End()
+
+		recorder := &statusRecorder{ResponseWriter: writer}
+		next.ServeHTTP(recorder, request.WithContext(ctx))
+
+		statusCode := recorder.statusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+
+		span.SetAttributes(semconv.HTTPResponseStatusCode(statusCode))
+		// client errors aren't failures of the server
+		if statusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(statusCode))
+		}
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+
+	statusCode int
+}
+
+func (recorder *statusRecorder) WriteHeader(statusCode int) {
+	if recorder.statusCode == 0 {
+		recorder.statusCode = statusCode
+	}
+
+	recorder.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (recorder *statusRecorder) Write(data []byte) (int, error) {
+	if recorder.statusCode == 0 {
+		recorder.statusCode = http.StatusOK
+	}
+
+	return recorder.ResponseWriter.Write(data)
+}
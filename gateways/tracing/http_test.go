@@ -0,0 +1,85 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHTTPTracer_Middleware(test *testing.T) {
+	tracer, recorder := newRecordingTracer()
+	httpTracer := HTTPTracer{
+		Tracer:     tracer,
+		Propagator: propagation.TraceContext{},
+	}
+
+	var handlerSpanContexts []trace.SpanContext
+	router := mux.NewRouter()
+	router.
+		HandleFunc("/links/{code}", func(_ http.ResponseWriter, request *http.Request) {
+			handlerSpanContexts = append(
+				handlerSpanContexts,
+				trace.SpanContextFromContext(request.Context()),
+			)
+		}).
+		Methods(http.MethodGet)
+	router.
+		HandleFunc("/links", func(writer http.ResponseWriter, _ *http.Request) {
+			writer.WriteHeader(http.StatusInternalServerError)
+		}).
+		Methods(http.MethodPost)
+	router.Use(httpTracer.Middleware)
+
+	getRequest :=
+		httptest.NewRequest(http.MethodGet, "http://example.com/links/one", nil)
+	getRequest.Header.Set(
+		"traceparent",
+		"00-0102030405060708090a0b0c0d0e0f10-0102030405060708-01",
+	)
+	for _, request := range []*http.Request{
+		getRequest,
+		httptest.NewRequest(http.MethodPost, "http://example.com/links", nil),
+	} {
+		router.ServeHTTP(httptest.NewRecorder(), request)
+	}
+
+	spans := recorder.Ended()
+	require.Len(test, spans, 2)
+
+	assert.Equal(test, "GET /links/{code}", spans[0].Name())
+	assert.Equal(test, trace.SpanKindServer, spans[0].SpanKind())
+	assert.Equal(
+		test,
+		"0102030405060708090a0b0c0d0e0f10",
+		spans[0].SpanContext().TraceID().String(),
+	)
+	assert.Equal(test, "0102030405060708", spans[0].Parent().SpanID().String())
+	assert.Equal(
+		test,
+		[]trace.SpanContext{spans[0].SpanContext()},
+		handlerSpanContexts,
+	)
+	assert.Contains(
+		test,
+		spans[0].Attributes(),
+		attribute.Int("http.response.status_code", http.StatusOK),
+	)
+	assert.Equal(test, codes.Unset, spans[0].Status().Code)
+
+	assert.Equal(test, "POST /links", spans[1].Name())
+	assert.False(test, spans[1].Parent().IsValid())
+	assert.Contains(
+		test,
+		spans[1].Attributes(),
+		attribute.Int("http.response.status_code", http.StatusInternalServerError),
+	)
+	assert.Equal(test, codes.Error, spans[1].Status().Code)
+}
@@ -0,0 +1,210 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//go:generate mockery --name=LinkGetter --inpackage --case=underscore --testonly
+
+// LinkGetter ...
+type LinkGetter interface {
+	GetLink(ctx context.Context, query string) (entities.Link, error)
+}
+
+//go:generate mockery --name=LinkSetter --inpackage --case=underscore --testonly
+
+// LinkSetter ...
+type LinkSetter interface {
+	SetLink(ctx context.Context, link entities.Link) error
+}
+
+//go:generate mockery --name=BulkLinkSetter --inpackage --case=underscore --testonly
+
+// BulkLinkSetter ...
+type BulkLinkSetter interface {
+	SetLinks(ctx context.Context, links []entities.Link) ([]error, error)
+}
+
+//go:generate mockery --name=LinkDeleter --inpackage --case=underscore --testonly
+
+// LinkDeleter ...
+type LinkDeleter interface {
+	DeleteLink(ctx context.Context, link entities.Link) error
+}
+
+//go:generate mockery --name=LinkUpdater --inpackage --case=underscore --testonly
+
+// LinkUpdater ...
+type LinkUpdater interface {
+	UpdateLink(ctx context.Context, link entities.Link) error
+}
+
+//go:generate mockery --name=ClickStatsGetter --inpackage --case=underscore --testonly
+
+// ClickStatsGetter ...
+type ClickStatsGetter interface {
+	GetClickStats(ctx context.Context, code string) (entities.ClickStats, error)
+}
+
+// TracedLinkGetter ...
+//
+// The name prefixes the span name, so it should identify the traced layer,
+// e.g. a storage.
+//
+type TracedLinkGetter struct {
+	LinkGetter LinkGetter
+	Tracer     trace.Tracer
+	Name       string
+}
+
+// GetLink ...
+func (getter TracedLinkGetter) GetLink(
+	ctx context.Context,
+	query string,
+) (entities.Link, error) {
+	var link entities.Link
+	err := runInSpan(
+		ctx,
+		getter.Tracer,
+		getter.Name+".GetLink",
+		func(ctx context.Context) error {
+			var err error
+			link, err = getter.LinkGetter.GetLink(ctx, query)
+
+			return err
+		},
+	)
+
+	return link, err
+}
+
+// TracedLinkSetter ...
+type TracedLinkSetter struct {
+	LinkSetter LinkSetter
+	Tracer     trace.Tracer
+	Name       string
+}
+
+// SetLink ...
+func (setter TracedLinkSetter) SetLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	return runInSpan(
+		ctx,
+		setter.Tracer,
+		setter.Name+".SetLink",
+		func(ctx context.Context) error {
+			return setter.LinkSetter.SetLink(ctx, link)
+		},
+		attribute.String("link.code", link.Code),
+	)
+}
+
+// TracedBulkLinkSetter ...
+type TracedBulkLinkSetter struct {
+	BulkLinkSetter BulkLinkSetter
+	Tracer         trace.Tracer
+	Name           string
+}
+
+// SetLinks ...
+func (setter TracedBulkLinkSetter) SetLinks(
+	ctx context.Context,
+	links []entities.Link,
+) ([]error, error) {
+	var errs []error
+	err := runInSpan(
+		ctx,
+		setter.Tracer,
+		setter.Name+".SetLinks",
+		func(ctx context.Context) error {
+			var err error
+			errs, err = setter.BulkLinkSetter.SetLinks(ctx, links)
+
+			return err
+		},
+		attribute.Int("link.count", len(links)),
+	)
+
+	return errs, err
+}
+
+// TracedLinkDeleter ...
+type TracedLinkDeleter struct {
+	LinkDeleter LinkDeleter
+	Tracer      trace.Tracer
+	Name        string
+}
+
+// DeleteLink ...
+func (deleter TracedLinkDeleter) DeleteLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	return runInSpan(
+		ctx,
+		deleter.Tracer,
+		deleter.Name+".DeleteLink",
+		func(ctx context.Context) error {
+			return deleter.LinkDeleter.DeleteLink(ctx, link)
+		},
+		attribute.String("link.code", link.Code),
+	)
+}
+
+// TracedLinkUpdater ...
+type TracedLinkUpdater struct {
+	LinkUpdater LinkUpdater
+	Tracer      trace.Tracer
+	Name        string
+}
+
+// UpdateLink ...
+func (updater TracedLinkUpdater) UpdateLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	return runInSpan(
+		ctx,
+		updater.Tracer,
+		updater.Name+".UpdateLink",
+		func(ctx context.Context) error {
+			return updater.LinkUpdater.UpdateLink(ctx, link)
+		},
+		attribute.String("link.code", link.Code),
+	)
+}
+
+// TracedClickStatsGetter ...
+type TracedClickStatsGetter struct {
+	ClickStatsGetter ClickStatsGetter
+	Tracer           trace.Tracer
+	Name             string
+}
+
+// GetClickStats ...
+func (getter TracedClickStatsGetter) GetClickStats(
+	ctx context.Context,
+	code string,
+) (entities.ClickStats, error) {
+	var stats entities.ClickStats
+	err := runInSpan(
+		ctx,
+		getter.Tracer,
+		getter.Name+".GetClickStats",
+		func(ctx context.Context) error {
+			var err error
+			stats, err = getter.ClickStatsGetter.GetClickStats(ctx, code)
+
+			return err
+		},
+		attribute.String("link.code", code),
+	)
+
+	return stats, err
+}
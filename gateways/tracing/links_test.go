@@ -0,0 +1,250 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestTracedLinkGetter_GetLink(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		link     entities.Link
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			link:     entities.Link{Code: "code", URL: "url"},
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			link:     entities.Link{},
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			linkGetter := new(MockLinkGetter)
+			linkGetter.On("GetLink", inSpan, "code").Return(data.link, data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			getter := TracedLinkGetter{
+				LinkGetter: linkGetter,
+				Tracer:     tracer,
+				Name:       "storage",
+			}
+			gotLink, gotErr := getter.GetLink(context.Background(), "code")
+
+			mock.AssertExpectationsForObjects(test, linkGetter)
+			checkSpan(test, recorder, "storage.GetLink", data.wantErr)
+			assert.Equal(test, data.link, gotLink)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
+
+func TestTracedLinkSetter_SetLink(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := entities.Link{Code: "code", URL: "url"}
+			linkSetter := new(MockLinkSetter)
+			linkSetter.On("SetLink", inSpan, link).Return(data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			setter := TracedLinkSetter{
+				LinkSetter: linkSetter,
+				Tracer:     tracer,
+				Name:       "storage",
+			}
+			gotErr := setter.SetLink(context.Background(), link)
+
+			mock.AssertExpectationsForObjects(test, linkSetter)
+			checkSpan(test, recorder, "storage.SetLink", data.wantErr)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
+
+func TestTracedBulkLinkSetter_SetLinks(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		innerErrs []error
+		innerErr  error
+		wantErr   bool
+	}{
+		{
+			name:      "success",
+			innerErrs: []error{nil, entities.ErrLinkConflict},
+			innerErr:  nil,
+			wantErr:   false,
+		},
+		{
+			name:      "error",
+			innerErrs: nil,
+			innerErr:  iotest.ErrTimeout,
+			wantErr:   true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			links := []entities.Link{
+				{Code: "code #1", URL: "url #1"},
+				{Code: "code #2", URL: "url #2"},
+			}
+			bulkLinkSetter := new(MockBulkLinkSetter)
+			bulkLinkSetter.
+				On("SetLinks", inSpan, links).
+				Return(data.innerErrs, data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			setter := TracedBulkLinkSetter{
+				BulkLinkSetter: bulkLinkSetter,
+				Tracer:         tracer,
+				Name:           "storage",
+			}
+			gotErrs, gotErr := setter.SetLinks(context.Background(), links)
+
+			mock.AssertExpectationsForObjects(test, bulkLinkSetter)
+			checkSpan(test, recorder, "storage.SetLinks", data.wantErr)
+			assert.Equal(test, data.innerErrs, gotErrs)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
+
+func TestTracedLinkDeleter_DeleteLink(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := entities.Link{Code: "code", URL: "url"}
+			linkDeleter := new(MockLinkDeleter)
+			linkDeleter.On("DeleteLink", inSpan, link).Return(data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			deleter := TracedLinkDeleter{
+				LinkDeleter: linkDeleter,
+				Tracer:      tracer,
+				Name:        "cache",
+			}
+			gotErr := deleter.DeleteLink(context.Background(), link)
+
+			mock.AssertExpectationsForObjects(test, linkDeleter)
+			checkSpan(test, recorder, "cache.DeleteLink", data.wantErr)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
+
+func TestTracedLinkUpdater_UpdateLink(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := entities.Link{Code: "code", URL: "url", Disabled: true}
+			linkUpdater := new(MockLinkUpdater)
+			linkUpdater.On("UpdateLink", inSpan, link).Return(data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			updater := TracedLinkUpdater{
+				LinkUpdater: linkUpdater,
+				Tracer:      tracer,
+				Name:        "cache",
+			}
+			gotErr := updater.UpdateLink(context.Background(), link)
+
+			mock.AssertExpectationsForObjects(test, linkUpdater)
+			checkSpan(test, recorder, "cache.UpdateLink", data.wantErr)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
+
+func TestTracedClickStatsGetter_GetClickStats(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		stats    entities.ClickStats
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			stats:    entities.ClickStats{Code: "code", TotalCount: 23},
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			stats:    entities.ClickStats{},
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			clickStatsGetter := new(MockClickStatsGetter)
+			clickStatsGetter.
+				On("GetClickStats", inSpan, "code").
+				Return(data.stats, data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			getter := TracedClickStatsGetter{
+				ClickStatsGetter: clickStatsGetter,
+				Tracer:           tracer,
+				Name:             "storage",
+			}
+			gotStats, gotErr := getter.GetClickStats(context.Background(), "code")
+
+			mock.AssertExpectationsForObjects(test, clickStatsGetter)
+			checkSpan(test, recorder, "storage.GetClickStats", data.wantErr)
+			assert.Equal(test, data.stats, gotStats)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName ...
+//
+// It should be used for getting of the tracer from a provider.
+//
+const InstrumentationName = "github.com/thewizardplusplus/go-link-shortener-backend"
+
+// it runs the handler in a new span and returns the handler error as is,
+// so sentinel errors remain comparable
+func runInSpan(
+	ctx context.Context,
+	tracer trace.Tracer,
+	name string,
+	handler func(ctx context.Context) error,
+	attributes ...attribute.KeyValue,
+) error {
+	ctx, span := tracer.Start(ctx, name, trace.WithAttributes(attributes...))
+	defer span.End()
+
+	err := handler(ctx)
+	if err != nil {
+		span.RecordError(err)
+		// expected errors are recorded, but the span isn't marked as failed
+		if !isExpectedError(err) {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+
+	return err
+}
+
+// it returns true for errors caused by the data, not by a failure
+func isExpectedError(err error) bool {
+	switch errors.Cause(err) {
+	case sql.ErrNoRows,
+		entities.ErrInvalidLink,
+		entities.ErrLinkConflict,
+		entities.ErrLinkExpired,
+		entities.ErrLinkDisabled,
+		entities.ErrLinkBlocked:
+		return true
+	default:
+		return false
+	}
+}
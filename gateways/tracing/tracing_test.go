@@ -0,0 +1,142 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_runInSpan(test *testing.T) {
+	for _, data := range []struct {
+		name           string
+		handlerErr     error
+		wantStatusCode codes.Code
+		wantEventCount int
+	}{
+		{
+			name:           "success",
+			handlerErr:     nil,
+			wantStatusCode: codes.Unset,
+			wantEventCount: 0,
+		},
+		{
+			name:           "expected error",
+			handlerErr:     errors.Wrap(entities.ErrLinkExpired, "dummy"),
+			wantStatusCode: codes.Unset,
+			wantEventCount: 1,
+		},
+		{
+			name:           "unexpected error",
+			handlerErr:     iotest.ErrTimeout,
+			wantStatusCode: codes.Error,
+			wantEventCount: 1,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			tracer, recorder := newRecordingTracer()
+			var handlerSpanContext trace.SpanContext
+			err := runInSpan(
+				context.Background(),
+				tracer,
+				"name",
+				func(ctx context.Context) error {
+					handlerSpanContext = trace.SpanContextFromContext(ctx)
+					return data.handlerErr
+				},
+				attribute.String("key", "value"),
+			)
+
+			spans := recorder.Ended()
+			require.Len(test, spans, 1)
+			assert.Equal(test, "name", spans[0].Name())
+			assert.Equal(test, spans[0].SpanContext(), handlerSpanContext)
+			assert.Equal(
+				test,
+				[]attribute.KeyValue{attribute.String("key", "value")},
+				spans[0].Attributes(),
+			)
+			assert.Equal(test, data.wantStatusCode, spans[0].Status().Code)
+			assert.Len(test, spans[0].Events(), data.wantEventCount)
+			assert.Equal(test, data.handlerErr, err)
+		})
+	}
+}
+
+func Test_isExpectedError(test *testing.T) {
+	for _, data := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "missed link",
+			err:  errors.Wrap(sql.ErrNoRows, "dummy"),
+			want: true,
+		},
+		{
+			name: "invalid link",
+			err:  errors.Wrap(entities.ErrInvalidLink, "dummy"),
+			want: true,
+		},
+		{
+			name: "link conflict",
+			err:  entities.ErrLinkConflict,
+			want: true,
+		},
+		{
+			name: "blocked link",
+			err:  entities.ErrLinkBlocked,
+			want: true,
+		},
+		{
+			name: "failure",
+			err:  errors.Wrap(iotest.ErrTimeout, "dummy"),
+			want: false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := isExpectedError(data.err)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func newRecordingTracer() (trace.Tracer, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	return provider.Tracer(InstrumentationName), recorder
+}
+
+// it matches contexts that carry a span started by a decorator
+var inSpan = mock.MatchedBy(func(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsValid()
+})
+
+func checkSpan(
+	test *testing.T,
+	recorder *tracetest.SpanRecorder,
+	wantName string,
+	wantErr bool,
+) {
+	spans := recorder.Ended()
+	require.Len(test, spans, 1)
+	assert.Equal(test, wantName, spans[0].Name())
+	if wantErr {
+		assert.Equal(test, codes.Error, spans[0].Status().Code)
+	} else {
+		assert.Equal(test, codes.Unset, spans[0].Status().Code)
+	}
+}
@@ -0,0 +1,161 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//go:generate mockery --name=LinkCreator --inpackage --case=underscore --testonly
+
+// LinkCreator ...
+type LinkCreator interface {
+	CreateLink(ctx context.Context, link entities.Link) (entities.Link, error)
+}
+
+//go:generate mockery --name=LinkBulkCreator --inpackage --case=underscore --testonly
+
+// LinkBulkCreator ...
+type LinkBulkCreator interface {
+	CreateLinks(
+		ctx context.Context,
+		links []entities.Link,
+	) ([]entities.LinkResult, error)
+}
+
+//go:generate mockery --name=LinkRemover --inpackage --case=underscore --testonly
+
+// LinkRemover ...
+type LinkRemover interface {
+	RemoveLink(ctx context.Context, code string) (entities.Link, error)
+}
+
+//go:generate mockery --name=LinkDisabler --inpackage --case=underscore --testonly
+
+// LinkDisabler ...
+type LinkDisabler interface {
+	DisableLink(
+		ctx context.Context,
+		code string,
+		disabled bool,
+	) (entities.Link, error)
+}
+
+// TracedLinkCreator ...
+type TracedLinkCreator struct {
+	LinkCreator LinkCreator
+	Tracer      trace.Tracer
+	Name        string
+}
+
+// CreateLink ...
+func (creator TracedLinkCreator) CreateLink(
+	ctx context.Context,
+	link entities.Link,
+) (entities.Link, error) {
+	var createdLink entities.Link
+	err := runInSpan(
+		ctx,
+		creator.Tracer,
+		creator.Name+".CreateLink",
+		func(ctx context.Context) error {
+			var err error
+			createdLink, err = creator.LinkCreator.CreateLink(ctx, link)
+
+			return err
+		},
+	)
+
+	return createdLink, err
+}
+
+// TracedLinkBulkCreator ...
+type TracedLinkBulkCreator struct {
+	LinkBulkCreator LinkBulkCreator
+	Tracer          trace.Tracer
+	Name            string
+}
+
+// CreateLinks ...
+func (creator TracedLinkBulkCreator) CreateLinks(
+	ctx context.Context,
+	links []entities.Link,
+) ([]entities.LinkResult, error) {
+	var results []entities.LinkResult
+	err := runInSpan(
+		ctx,
+		creator.Tracer,
+		creator.Name+".CreateLinks",
+		func(ctx context.Context) error {
+			var err error
+			results, err = creator.LinkBulkCreator.CreateLinks(ctx, links)
+
+			return err
+		},
+		attribute.Int("link.count", len(links)),
+	)
+
+	return results, err
+}
+
+// TracedLinkRemover ...
+type TracedLinkRemover struct {
+	LinkRemover LinkRemover
+	Tracer      trace.Tracer
+	Name        string
+}
+
+// RemoveLink ...
+func (remover TracedLinkRemover) RemoveLink(
+	ctx context.Context,
+	code string,
+) (entities.Link, error) {
+	var link entities.Link
+	err := runInSpan(
+		ctx,
+		remover.Tracer,
+		remover.Name+".RemoveLink",
+		func(ctx context.Context) error {
+			var err error
+			link, err = remover.LinkRemover.RemoveLink(ctx, code)
+
+			return err
+		},
+		attribute.String("link.code", code),
+	)
+
+	return link, err
+}
+
+// TracedLinkDisabler ...
+type TracedLinkDisabler struct {
+	LinkDisabler LinkDisabler
+	Tracer       trace.Tracer
+	Name         string
+}
+
+// DisableLink ...
+func (disabler TracedLinkDisabler) DisableLink(
+	ctx context.Context,
+	code string,
+	disabled bool,
+) (entities.Link, error) {
+	var link entities.Link
+	err := runInSpan(
+		ctx,
+		disabler.Tracer,
+		disabler.Name+".DisableLink",
+		func(ctx context.Context) error {
+			var err error
+			link, err = disabler.LinkDisabler.DisableLink(ctx, code, disabled)
+
+			return err
+		},
+		attribute.String("link.code", code),
+		attribute.Bool("link.disabled", disabled),
+	)
+
+	return link, err
+}
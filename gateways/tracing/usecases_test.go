@@ -0,0 +1,190 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestTracedLinkCreator_CreateLink(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		link     entities.Link
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			link:     entities.Link{Code: "code", URL: "url"},
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "expected error",
+			link:     entities.Link{},
+			innerErr: entities.ErrInvalidLink,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			link:     entities.Link{},
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			linkCreator := new(MockLinkCreator)
+			linkCreator.
+				On("CreateLink", inSpan, entities.Link{URL: "url"}).
+				Return(data.link, data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			creator := TracedLinkCreator{
+				LinkCreator: linkCreator,
+				Tracer:      tracer,
+				Name:        "usecases",
+			}
+			gotLink, gotErr :=
+				creator.CreateLink(context.Background(), entities.Link{URL: "url"})
+
+			mock.AssertExpectationsForObjects(test, linkCreator)
+			checkSpan(test, recorder, "usecases.CreateLink", data.wantErr)
+			assert.Equal(test, data.link, gotLink)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
+
+func TestTracedLinkBulkCreator_CreateLinks(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		results  []entities.LinkResult
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name: "success",
+			results: []entities.LinkResult{
+				{Link: entities.Link{Code: "code", URL: "url"}},
+			},
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			results:  nil,
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			links := []entities.Link{{URL: "url"}}
+			linkBulkCreator := new(MockLinkBulkCreator)
+			linkBulkCreator.
+				On("CreateLinks", inSpan, links).
+				Return(data.results, data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			creator := TracedLinkBulkCreator{
+				LinkBulkCreator: linkBulkCreator,
+				Tracer:          tracer,
+				Name:            "usecases",
+			}
+			gotResults, gotErr := creator.CreateLinks(context.Background(), links)
+
+			mock.AssertExpectationsForObjects(test, linkBulkCreator)
+			checkSpan(test, recorder, "usecases.CreateLinks", data.wantErr)
+			assert.Equal(test, data.results, gotResults)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
+
+func TestTracedLinkRemover_RemoveLink(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		link     entities.Link
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			link:     entities.Link{Code: "code", URL: "url"},
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			link:     entities.Link{},
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			linkRemover := new(MockLinkRemover)
+			linkRemover.
+				On("RemoveLink", inSpan, "code").
+				Return(data.link, data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			remover := TracedLinkRemover{
+				LinkRemover: linkRemover,
+				Tracer:      tracer,
+				Name:        "usecases",
+			}
+			gotLink, gotErr := remover.RemoveLink(context.Background(), "code")
+
+			mock.AssertExpectationsForObjects(test, linkRemover)
+			checkSpan(test, recorder, "usecases.RemoveLink", data.wantErr)
+			assert.Equal(test, data.link, gotLink)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
+
+func TestTracedLinkDisabler_DisableLink(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		link     entities.Link
+		innerErr error
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			link:     entities.Link{Code: "code", URL: "url", Disabled: true},
+			innerErr: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "error",
+			link:     entities.Link{},
+			innerErr: iotest.ErrTimeout,
+			wantErr:  true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			linkDisabler := new(MockLinkDisabler)
+			linkDisabler.
+				On("DisableLink", inSpan, "code", true).
+				Return(data.link, data.innerErr)
+
+			tracer, recorder := newRecordingTracer()
+			disabler := TracedLinkDisabler{
+				LinkDisabler: linkDisabler,
+				Tracer:       tracer,
+				Name:         "usecases",
+			}
+			gotLink, gotErr :=
+				disabler.DisableLink(context.Background(), "code", true)
+
+			mock.AssertExpectationsForObjects(test, linkDisabler)
+			checkSpan(test, recorder, "usecases.DisableLink", data.wantErr)
+			assert.Equal(test, data.link, gotLink)
+			assert.Equal(test, data.innerErr, gotErr)
+		})
+	}
+}
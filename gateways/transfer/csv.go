@@ -0,0 +1,234 @@
+package transfer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// CSV columns; they are named as the link fields in the API.
+const (
+	CodeColumn            = "Code"
+	URLColumn             = "URL"
+	ExpirationTimeColumn  = "ExpirationTime"
+	DisabledColumn        = "Disabled"
+	RedirectCodeColumn    = "RedirectCode"
+	QueryForwardingColumn = "QueryForwarding"
+	PathForwardingColumn  = "PathForwarding"
+	RulesColumn           = "Rules"
+	VariantsColumn        = "Variants"
+)
+
+var csvColumns = []string{
+	CodeColumn,
+	URLColumn,
+	ExpirationTimeColumn,
+	DisabledColumn,
+	RedirectCodeColumn,
+	QueryForwardingColumn,
+	PathForwardingColumn,
+	RulesColumn,
+	VariantsColumn,
+}
+
+// CSVLinkReader ...
+//
+// The first record should be a header with column names. The code and URL
+// columns are required, the other ones may be omitted, and their order
+// doesn't matter. Empty cells mean zero values.
+//
+// The expiration time is in RFC 3339. The rules and variants are in JSON
+// in the same format as in the API.
+//
+type CSVLinkReader struct {
+	reader  *csv.Reader
+	columns []string
+}
+
+// NewCSVLinkReader ...
+func NewCSVLinkReader(reader io.Reader) (*CSVLinkReader, error) {
+	csvReader := csv.NewReader(reader)
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the header")
+	}
+
+	knownColumns := make(map[string]struct{})
+	for _, column := range csvColumns {
+		knownColumns[column] = struct{}{}
+	}
+
+	seenColumns := make(map[string]struct{})
+	for _, column := range header {
+		if _, ok := knownColumns[column]; !ok {
+			return nil, errors.Errorf("unknown column %q", column)
+		}
+		if _, ok := seenColumns[column]; ok {
+			return nil, errors.Errorf("duplicated column %q", column)
+		}
+
+		seenColumns[column] = struct{}{}
+	}
+	for _, column := range []string{CodeColumn, URLColumn} {
+		if _, ok := seenColumns[column]; !ok {
+			return nil, errors.Errorf("missed column %q", column)
+		}
+	}
+
+	return &CSVLinkReader{reader: csvReader, columns: header}, nil
+}
+
+// ReadLink ...
+func (reader *CSVLinkReader) ReadLink() (entities.Link, error) {
+	record, err := reader.reader.Read()
+	if err != nil {
+		if _, ok := err.(*csv.ParseError); ok {
+			return entities.Link{}, errors.Wrapf(
+				entities.ErrInvalidLink,
+				"unable to parse the record: %v",
+				err,
+			)
+		}
+		if err == io.EOF {
+			return entities.Link{}, io.EOF
+		}
+
+		return entities.Link{}, errors.Wrap(err, "unable to read the record")
+	}
+
+	var link entities.Link
+	for index, column := range reader.columns {
+		if record[index] == "" {
+			continue
+		}
+
+		if err := parseCell(&link, column, record[index]); err != nil {
+			return entities.Link{}, errors.Wrapf(
+				entities.ErrInvalidLink,
+				"unable to parse the %s column: %v",
+				column,
+				err,
+			)
+		}
+	}
+
+	return link, nil
+}
+
+func parseCell(link *entities.Link, column string, cell string) error {
+	var err error
+	switch column {
+	case CodeColumn:
+		link.Code = cell
+	case URLColumn:
+		link.URL = cell
+	case ExpirationTimeColumn:
+		var expirationTime time.Time
+		expirationTime, err = time.Parse(time.RFC3339Nano, cell)
+		link.ExpirationTime = &expirationTime
+	case DisabledColumn:
+		link.Disabled, err = strconv.ParseBool(cell)
+	case RedirectCodeColumn:
+		link.RedirectCode, err = strconv.Atoi(cell)
+	case QueryForwardingColumn:
+		link.QueryForwarding = cell
+	case PathForwardingColumn:
+		link.PathForwarding, err = strconv.ParseBool(cell)
+	case RulesColumn:
+		err = json.Unmarshal([]byte(cell), &link.Rules)
+	case VariantsColumn:
+		err = json.Unmarshal([]byte(cell), &link.Variants)
+	}
+
+	return err
+}
+
+// CSVLinkWriter ...
+//
+// It writes all the columns in the format described for the CSV link reader.
+//
+type CSVLinkWriter struct {
+	writer *csv.Writer
+}
+
+// NewCSVLinkWriter ...
+func NewCSVLinkWriter(writer io.Writer) (*CSVLinkWriter, error) {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(csvColumns); err != nil {
+		return nil, errors.Wrap(err, "unable to write the header")
+	}
+
+	return &CSVLinkWriter{writer: csvWriter}, nil
+}
+
+// WriteLink ...
+func (writer *CSVLinkWriter) WriteLink(link entities.Link) error {
+	var expirationTime string
+	if link.ExpirationTime != nil {
+		expirationTime = link.ExpirationTime.Format(time.RFC3339Nano)
+	}
+
+	var redirectCode string
+	if link.RedirectCode != 0 {
+		redirectCode = strconv.Itoa(link.RedirectCode)
+	}
+
+	var rules string
+	if len(link.Rules) != 0 {
+		rulesAsJSON, err := json.Marshal(link.Rules)
+		if err != nil {
+			return errors.Wrap(err, "unable to marshal the link rules")
+		}
+
+		rules = string(rulesAsJSON)
+	}
+
+	var variants string
+	if len(link.Variants) != 0 {
+		variantsAsJSON, err := json.Marshal(link.Variants)
+		if err != nil {
+			return errors.Wrap(err, "unable to marshal the link variants")
+		}
+
+		variants = string(variantsAsJSON)
+	}
+
+	if err := writer.writer.Write([]string{
+		link.Code,
+		link.URL,
+		expirationTime,
+		formatFlag(link.Disabled),
+		redirectCode,
+		link.QueryForwarding,
+		formatFlag(link.PathForwarding),
+		rules,
+		variants,
+	}); err != nil {
+		return errors.Wrap(err, "unable to write the record")
+	}
+
+	return nil
+}
+
+// Flush ...
+func (writer *CSVLinkWriter) Flush() error {
+	writer.writer.Flush()
+	if err := writer.writer.Error(); err != nil {
+		return errors.Wrap(err, "unable to flush the links")
+	}
+
+	return nil
+}
+
+func formatFlag(flag bool) string {
+	if !flag {
+		return ""
+	}
+
+	return strconv.FormatBool(flag)
+}
@@ -0,0 +1,218 @@
+package transfer
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestNewCSVLinkReader(test *testing.T) {
+	for _, data := range []struct {
+		name    string
+		data    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success with all the columns",
+			data:    strings.Join(csvColumns, ",") + "\n",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with the required columns in another order",
+			data:    "URL,Code\n",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error with an empty file",
+			data:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name:    "error with an unknown column",
+			data:    "Code,URL,Unknown\n",
+			wantErr: assert.Error,
+		},
+		{
+			name:    "error with a duplicated column",
+			data:    "Code,URL,Code\n",
+			wantErr: assert.Error,
+		},
+		{
+			name:    "error with a missed column",
+			data:    "Code,Disabled\n",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			_, err := NewCSVLinkReader(strings.NewReader(data.data))
+
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestCSVLinkReader_ReadLink(test *testing.T) {
+	expirationTime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	for _, data := range []struct {
+		name      string
+		reader    io.Reader
+		wantLinks []entities.Link
+		wantErr   func(test *testing.T, err error)
+	}{
+		{
+			name: "success with all the columns",
+			reader: strings.NewReader(
+				strings.Join(csvColumns, ",") + "\n" +
+					"code #1,url #1,,,,,,,\n" +
+					`code #2,url #2,2006-01-02T15:04:05Z,true,307,merge,true,` +
+					`"[{""OSes"":[""ios""],""URL"":""url #3""}]",` +
+					`"[{""Name"":""a"",""URL"":""url #4"",""Weight"":1}]"` + "\n",
+			),
+			wantLinks: []entities.Link{
+				{Code: "code #1", URL: "url #1"},
+				{
+					Code:            "code #2",
+					URL:             "url #2",
+					ExpirationTime:  &expirationTime,
+					Disabled:        true,
+					RedirectCode:    307,
+					QueryForwarding: "merge",
+					PathForwarding:  true,
+					Rules: []entities.RedirectRule{
+						{OSes: []string{"ios"}, URL: "url #3"},
+					},
+					Variants: []entities.Variant{
+						{Name: "a", URL: "url #4", Weight: 1},
+					},
+				},
+			},
+			wantErr: func(test *testing.T, err error) {
+				assert.Equal(test, io.EOF, err)
+			},
+		},
+		{
+			name:   "success with the required columns in another order",
+			reader: strings.NewReader("URL,Code\nurl,code\n"),
+			wantLinks: []entities.Link{
+				{Code: "code", URL: "url"},
+			},
+			wantErr: func(test *testing.T, err error) {
+				assert.Equal(test, io.EOF, err)
+			},
+		},
+		{
+			name:      "error with an invalid cell",
+			reader:    strings.NewReader("Code,URL,Disabled\ncode,url,incorrect\n"),
+			wantLinks: nil,
+			wantErr: func(test *testing.T, err error) {
+				assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err))
+			},
+		},
+		{
+			name:      "error with an invalid field count",
+			reader:    strings.NewReader("Code,URL\ncode\n"),
+			wantLinks: nil,
+			wantErr: func(test *testing.T, err error) {
+				assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err))
+			},
+		},
+		{
+			name: "error on reading",
+			reader: io.MultiReader(
+				strings.NewReader("Code,URL\n"),
+				iotest.TimeoutReader(strings.NewReader("code")),
+			),
+			wantLinks: nil,
+			wantErr: func(test *testing.T, err error) {
+				assert.Error(test, err)
+				assert.NotEqual(test, io.EOF, err)
+				assert.NotEqual(test, entities.ErrInvalidLink, errors.Cause(err))
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			reader, err := NewCSVLinkReader(data.reader)
+			if !assert.NoError(test, err) {
+				return
+			}
+
+			var gotLinks []entities.Link
+			for {
+				var link entities.Link
+				link, err = reader.ReadLink()
+				if err != nil {
+					break
+				}
+
+				gotLinks = append(gotLinks, link)
+			}
+
+			assert.Equal(test, data.wantLinks, gotLinks)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestCSVLinkWriter(test *testing.T) {
+	expirationTime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	links := []entities.Link{
+		{ServerID: "server", Code: "code #1", URL: "url #1"},
+		{
+			Code:            "code #2",
+			URL:             "url #2",
+			ExpirationTime:  &expirationTime,
+			Disabled:        true,
+			RedirectCode:    307,
+			QueryForwarding: "merge",
+			PathForwarding:  true,
+			Rules: []entities.RedirectRule{
+				{OSes: []string{"ios"}, URL: "url #3"},
+			},
+			Variants: []entities.Variant{
+				{Name: "a", URL: "url #4", Weight: 1},
+			},
+		},
+	}
+
+	var buffer bytes.Buffer
+	writer, err := NewCSVLinkWriter(&buffer)
+	if !assert.NoError(test, err) {
+		return
+	}
+	for _, link := range links {
+		err = writer.WriteLink(link)
+		if !assert.NoError(test, err) {
+			return
+		}
+	}
+	err = writer.Flush()
+	if !assert.NoError(test, err) {
+		return
+	}
+
+	wantData := strings.Join(csvColumns, ",") + "\n" +
+		"code #1,url #1,,,,,,,\n" +
+		`code #2,url #2,2006-01-02T15:04:05Z,true,307,merge,true,` +
+		`"[{""OSes"":[""ios""],""URL"":""url #3""}]",` +
+		`"[{""Name"":""a"",""URL"":""url #4"",""Weight"":1}]"` + "\n"
+	assert.Equal(test, wantData, buffer.String())
+
+	reader, err := NewCSVLinkReader(&buffer)
+	if !assert.NoError(test, err) {
+		return
+	}
+	for _, link := range links {
+		link.ServerID = ""
+
+		gotLink, err := reader.ReadLink()
+		assert.NoError(test, err)
+		assert.Equal(test, link, gotLink)
+	}
+}
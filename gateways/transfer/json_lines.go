@@ -0,0 +1,95 @@
+package transfer
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// links with many rules or variants may be long
+const maximalLineLength = 1 << 20
+
+// JSONLinesLinkReader ...
+//
+// It reads a link per line in the same JSON format as in the API. Empty lines
+// are skipped.
+//
+type JSONLinesLinkReader struct {
+	scanner *bufio.Scanner
+}
+
+// NewJSONLinesLinkReader ...
+func NewJSONLinesLinkReader(reader io.Reader) *JSONLinesLinkReader {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(nil, maximalLineLength)
+
+	return &JSONLinesLinkReader{scanner: scanner}
+}
+
+// ReadLink ...
+func (reader *JSONLinesLinkReader) ReadLink() (entities.Link, error) {
+	for reader.scanner.Scan() {
+		line := reader.scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var link entities.Link
+		if err := json.Unmarshal([]byte(line), &link); err != nil {
+			return entities.Link{}, errors.Wrapf(
+				entities.ErrInvalidLink,
+				"unable to unmarshal the link: %v",
+				err,
+			)
+		}
+
+		return link, nil
+	}
+	if err := reader.scanner.Err(); err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to read the line")
+	}
+
+	return entities.Link{}, io.EOF
+}
+
+// JSONLinesLinkWriter ...
+type JSONLinesLinkWriter struct {
+	writer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+// NewJSONLinesLinkWriter ...
+func NewJSONLinesLinkWriter(writer io.Writer) *JSONLinesLinkWriter {
+	bufferedWriter := bufio.NewWriter(writer)
+	encoder := json.NewEncoder(bufferedWriter)
+	// URLs often contain ampersands, so they are kept readable
+	encoder.SetEscapeHTML(false)
+
+	return &JSONLinesLinkWriter{writer: bufferedWriter, encoder: encoder}
+}
+
+// WriteLink ...
+func (writer *JSONLinesLinkWriter) WriteLink(link entities.Link) error {
+	// the server ID is added by presenters and isn't a part of the link
+	link.ServerID = ""
+
+	// the encoder terminates each value by a newline
+	if err := writer.encoder.Encode(link); err != nil {
+		return errors.Wrap(err, "unable to marshal the link")
+	}
+
+	return nil
+}
+
+// Flush ...
+func (writer *JSONLinesLinkWriter) Flush() error {
+	if err := writer.writer.Flush(); err != nil {
+		return errors.Wrap(err, "unable to flush the links")
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package transfer
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestJSONLinesLinkReader_ReadLink(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		reader    io.Reader
+		wantLinks []entities.Link
+		wantErr   func(test *testing.T, err error)
+	}{
+		{
+			name: "success",
+			reader: strings.NewReader(
+				`{"Code":"code #1","URL":"url #1"}` + "\n\n" +
+					`{"Code":"code #2","URL":"url #2","Disabled":true}` + "\n",
+			),
+			wantLinks: []entities.Link{
+				{Code: "code #1", URL: "url #1"},
+				{Code: "code #2", URL: "url #2", Disabled: true},
+			},
+			wantErr: func(test *testing.T, err error) {
+				assert.Equal(test, io.EOF, err)
+			},
+		},
+		{
+			name:      "success without links",
+			reader:    strings.NewReader(""),
+			wantLinks: nil,
+			wantErr: func(test *testing.T, err error) {
+				assert.Equal(test, io.EOF, err)
+			},
+		},
+		{
+			name: "error with an invalid line",
+			reader: strings.NewReader(
+				`{"Code":"code #1","URL":"url #1"}` + "\nincorrect\n",
+			),
+			wantLinks: []entities.Link{{Code: "code #1", URL: "url #1"}},
+			wantErr: func(test *testing.T, err error) {
+				assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err))
+			},
+		},
+		{
+			name: "error on reading",
+			reader: iotest.TimeoutReader(
+				strings.NewReader(`{"Code":"code","URL":"url"}` + "\n"),
+			),
+			wantLinks: []entities.Link{{Code: "code", URL: "url"}},
+			wantErr: func(test *testing.T, err error) {
+				assert.Error(test, err)
+				assert.NotEqual(test, io.EOF, err)
+				assert.NotEqual(test, entities.ErrInvalidLink, errors.Cause(err))
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			reader := NewJSONLinesLinkReader(data.reader)
+
+			var gotLinks []entities.Link
+			var err error
+			for {
+				var link entities.Link
+				link, err = reader.ReadLink()
+				if err != nil {
+					break
+				}
+
+				gotLinks = append(gotLinks, link)
+			}
+
+			assert.Equal(test, data.wantLinks, gotLinks)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestJSONLinesLinkWriter(test *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewJSONLinesLinkWriter(&buffer)
+	for _, link := range []entities.Link{
+		{ServerID: "server", Code: "code #1", URL: "http://example.com/?a=1&b=2"},
+		{Code: "code #2", URL: "url #2", Disabled: true},
+	} {
+		err := writer.WriteLink(link)
+		if !assert.NoError(test, err) {
+			return
+		}
+	}
+	err := writer.Flush()
+	if !assert.NoError(test, err) {
+		return
+	}
+
+	wantData := `{"Code":"code #1","URL":"http://example.com/?a=1&b=2"}` + "\n" +
+		`{"Code":"code #2","URL":"url #2","Disabled":true}` + "\n"
+	assert.Equal(test, wantData, buffer.String())
+}
@@ -0,0 +1,68 @@
+package transfer
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// Formats of link files.
+const (
+	CSVFormat       = "csv"
+	JSONLinesFormat = "jsonl"
+)
+
+// LinkReader ...
+//
+// It returns io.EOF after the last link. Malformed records are reported
+// by entities.ErrInvalidLink, and the reading may be continued after them.
+//
+type LinkReader interface {
+	ReadLink() (entities.Link, error)
+}
+
+// LinkWriter ...
+//
+// Links may be buffered, so the writer should be flushed after the last link.
+//
+type LinkWriter interface {
+	WriteLink(link entities.Link) error
+	Flush() error
+}
+
+// NewLinkReader ...
+func NewLinkReader(format string, reader io.Reader) (LinkReader, error) {
+	switch format {
+	case CSVFormat:
+		// avoid returning a nil pointer wrapped in a non-nil interface
+		csvLinkReader, err := NewCSVLinkReader(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		return csvLinkReader, nil
+	case JSONLinesFormat:
+		return NewJSONLinesLinkReader(reader), nil
+	default:
+		return nil, errors.Errorf("unknown format %q", format)
+	}
+}
+
+// NewLinkWriter ...
+func NewLinkWriter(format string, writer io.Writer) (LinkWriter, error) {
+	switch format {
+	case CSVFormat:
+		// avoid returning a nil pointer wrapped in a non-nil interface
+		csvLinkWriter, err := NewCSVLinkWriter(writer)
+		if err != nil {
+			return nil, err
+		}
+
+		return csvLinkWriter, nil
+	case JSONLinesFormat:
+		return NewJSONLinesLinkWriter(writer), nil
+	default:
+		return nil, errors.Errorf("unknown format %q", format)
+	}
+}
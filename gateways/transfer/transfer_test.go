@@ -0,0 +1,79 @@
+package transfer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLinkReader(test *testing.T) {
+	for _, data := range []struct {
+		name    string
+		format  string
+		want    LinkReader
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success with the CSV format",
+			format:  CSVFormat,
+			want:    &CSVLinkReader{},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with the JSON Lines format",
+			format:  JSONLinesFormat,
+			want:    &JSONLinesLinkReader{},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error with an unknown format",
+			format:  "unknown",
+			want:    nil,
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewLinkReader(data.format, strings.NewReader("Code,URL\n"))
+
+			assert.IsType(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestNewLinkWriter(test *testing.T) {
+	for _, data := range []struct {
+		name    string
+		format  string
+		want    LinkWriter
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success with the CSV format",
+			format:  CSVFormat,
+			want:    &CSVLinkWriter{},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with the JSON Lines format",
+			format:  JSONLinesFormat,
+			want:    &JSONLinesLinkWriter{},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error with an unknown format",
+			format:  "unknown",
+			want:    nil,
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewLinkWriter(data.format, new(bytes.Buffer))
+
+			assert.IsType(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
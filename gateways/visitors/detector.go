@@ -0,0 +1,60 @@
+package visitors
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-log/log"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=CountryResolver --inpackage --case=underscore --testonly
+
+// CountryResolver ...
+type CountryResolver interface {
+	ResolveCountry(ip net.IP) (string, error)
+}
+
+// Detector ...
+type Detector struct {
+	CountryResolver CountryResolver
+	Logger          log.Logger
+}
+
+// DetectVisitor ...
+//
+// It never fails: an undetected property of the visitor is left empty,
+// so only rules without conditions on it are able to match.
+//
+func (detector Detector) DetectVisitor(request *http.Request) entities.Visitor {
+	browser, os := parseUserAgent(request.UserAgent())
+	return entities.Visitor{
+		Browser:   browser,
+		OS:        os,
+		Languages: parseAcceptLanguage(request.Header.Get("Accept-Language")),
+		Country:   detector.resolveCountry(request.RemoteAddr),
+		Time:      time.Now(),
+	}
+}
+
+func (detector Detector) resolveCountry(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return ""
+	}
+
+	country, err := detector.CountryResolver.ResolveCountry(ip)
+	if err != nil {
+		detector.Logger.Logf("unable to resolve the country: %v", err)
+		return ""
+	}
+
+	return strings.ToUpper(country)
+}
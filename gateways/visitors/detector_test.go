@@ -0,0 +1,174 @@
+package visitors
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/go-log/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestDetector_DetectVisitor(test *testing.T) {
+	type fields struct {
+		CountryResolver CountryResolver
+		Logger          log.Logger
+	}
+	type args struct {
+		request *http.Request
+	}
+
+	makeRequest := func(remoteAddress string) *http.Request {
+		request :=
+			httptest.NewRequest(http.MethodGet, "http://example.com/code", nil)
+		request.RemoteAddr = remoteAddress
+		request.Header.Set(
+			"User-Agent",
+			"Mozilla/5.0 (Linux; Android 10; Pixel 3) AppleWebKit/537.36 "+
+				"(KHTML, like Gecko) Chrome/79.0.3945.116 Mobile Safari/537.36",
+		)
+		request.Header.Set("Accept-Language", "en;q=0.8, de-AT")
+
+		return request
+	}
+
+	for _, data := range []struct {
+		name        string
+		fields      fields
+		args        args
+		wantVisitor entities.Visitor
+	}{
+		{
+			name: "success",
+			fields: fields{
+				CountryResolver: func() CountryResolver {
+					resolver := new(MockCountryResolver)
+					resolver.
+						On("ResolveCountry", net.ParseIP("192.0.2.42")).
+						Return("at", nil)
+
+					return resolver
+				}(),
+				Logger: new(MockLogger),
+			},
+			args: args{makeRequest("192.0.2.42:12345")},
+			wantVisitor: entities.Visitor{
+				Browser:   "chrome",
+				OS:        "android",
+				Languages: []string{"de-AT", "en"},
+				Country:   "AT",
+			},
+		},
+		{
+			name: "success with an IPv6 address",
+			fields: fields{
+				CountryResolver: func() CountryResolver {
+					resolver := new(MockCountryResolver)
+					resolver.
+						On("ResolveCountry", net.ParseIP("2001:db8::1")).
+						Return("DE", nil)
+
+					return resolver
+				}(),
+				Logger: new(MockLogger),
+			},
+			args: args{makeRequest("[2001:db8::1]:12345")},
+			wantVisitor: entities.Visitor{
+				Browser:   "chrome",
+				OS:        "android",
+				Languages: []string{"de-AT", "en"},
+				Country:   "DE",
+			},
+		},
+		{
+			name: "success with an unknown country",
+			fields: fields{
+				CountryResolver: func() CountryResolver {
+					resolver := new(MockCountryResolver)
+					resolver.
+						On("ResolveCountry", net.ParseIP("192.0.2.42")).
+						Return("", nil)
+
+					return resolver
+				}(),
+				Logger: new(MockLogger),
+			},
+			args: args{makeRequest("192.0.2.42:12345")},
+			wantVisitor: entities.Visitor{
+				Browser:   "chrome",
+				OS:        "android",
+				Languages: []string{"de-AT", "en"},
+				Country:   "",
+			},
+		},
+		{
+			name: "error with an incorrect address",
+			fields: fields{
+				CountryResolver: new(MockCountryResolver),
+				Logger:          new(MockLogger),
+			},
+			args: args{makeRequest("incorrect")},
+			wantVisitor: entities.Visitor{
+				Browser:   "chrome",
+				OS:        "android",
+				Languages: []string{"de-AT", "en"},
+				Country:   "",
+			},
+		},
+		{
+			name: "error with the country resolver",
+			fields: fields{
+				CountryResolver: func() CountryResolver {
+					resolver := new(MockCountryResolver)
+					resolver.
+						On("ResolveCountry", net.ParseIP("192.0.2.42")).
+						Return("", iotest.ErrTimeout)
+
+					return resolver
+				}(),
+				Logger: func() Logger {
+					logger := new(MockLogger)
+					logger.
+						On(
+							"Logf",
+							mock.MatchedBy(func(string) bool { return true }),
+							mock.MatchedBy(func(error) bool { return true }),
+						).
+						Return()
+
+					return logger
+				}(),
+			},
+			args: args{makeRequest("192.0.2.42:12345")},
+			wantVisitor: entities.Visitor{
+				Browser:   "chrome",
+				OS:        "android",
+				Languages: []string{"de-AT", "en"},
+				Country:   "",
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			detector := Detector{
+				CountryResolver: data.fields.CountryResolver,
+				Logger:          data.fields.Logger,
+			}
+			gotVisitor := detector.DetectVisitor(data.args.request)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.CountryResolver,
+				data.fields.Logger,
+			)
+			assert.WithinDuration(test, time.Now(), gotVisitor.Time, time.Minute)
+
+			gotVisitor.Time = time.Time{}
+			assert.Equal(test, data.wantVisitor, gotVisitor)
+		})
+	}
+}
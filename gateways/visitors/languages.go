@@ -0,0 +1,60 @@
+package visitors
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type weightedLanguage struct {
+	tag    string
+	weight float64
+}
+
+// it returns language tags of the Accept-Language header ordered
+// by their weights; the wildcard and unacceptable languages are skipped
+func parseAcceptLanguage(header string) []string {
+	var weightedLanguages []weightedLanguage
+	for _, part := range strings.Split(header, ",") {
+		parameters := strings.Split(part, ";")
+		tag := strings.TrimSpace(parameters[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		weight := 1.0
+		for _, parameter := range parameters[1:] {
+			parameter = strings.TrimSpace(parameter)
+			if !strings.HasPrefix(parameter, "q=") {
+				continue
+			}
+
+			parsedWeight, err := strconv.ParseFloat(parameter[len("q="):], 64)
+			if err != nil {
+				parsedWeight = 0
+			}
+
+			weight = parsedWeight
+		}
+		if weight <= 0 {
+			continue
+		}
+
+		weightedLanguages = append(weightedLanguages, weightedLanguage{
+			tag:    tag,
+			weight: weight,
+		})
+	}
+
+	// the stable sorting keeps the header order of languages with equal weights
+	sort.SliceStable(weightedLanguages, func(i int, j int) bool {
+		return weightedLanguages[i].weight > weightedLanguages[j].weight
+	})
+
+	var languages []string
+	for _, weightedLanguage := range weightedLanguages {
+		languages = append(languages, weightedLanguage.tag)
+	}
+
+	return languages
+}
@@ -0,0 +1,62 @@
+package visitors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseAcceptLanguage(test *testing.T) {
+	for _, data := range []struct {
+		name   string
+		header string
+		want   []string
+	}{
+		{
+			name:   "empty",
+			header: "",
+			want:   nil,
+		},
+		{
+			name:   "single language",
+			header: "de-AT",
+			want:   []string{"de-AT"},
+		},
+		{
+			name:   "languages with weights",
+			header: "fr;q=0.5, de-AT, en;q=0.8, de",
+			want:   []string{"de-AT", "de", "en", "fr"},
+		},
+		{
+			name:   "languages with other parameters",
+			header: "fr;level=1;q=0.5, en;level=2",
+			want:   []string{"en", "fr"},
+		},
+		{
+			name:   "languages with the wildcard",
+			header: "en, *;q=0.5",
+			want:   []string{"en"},
+		},
+		{
+			name:   "languages with an unacceptable one",
+			header: "en, fr;q=0",
+			want:   []string{"en"},
+		},
+		{
+			name:   "languages with an incorrect weight",
+			header: "en, fr;q=incorrect",
+			want:   []string{"en"},
+		},
+		{
+			name:   "languages with empty parts",
+			header: "en,, ,fr",
+			want:   []string{"en", "fr"},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := parseAcceptLanguage(data.header)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package visitors
+
+import (
+	"strings"
+)
+
+type family struct {
+	name    string
+	markers []string
+}
+
+// families are checked in order, because user agents mention other ones
+// for compatibility (e.g. Chrome mentions Safari, and Edge mentions Chrome)
+var (
+	browserFamilies = []family{
+		{name: "bot", markers: []string{"bot", "crawler", "spider"}},
+		{name: "edge", markers: []string{"edg/", "edge/", "edga/", "edgios/"}},
+		{name: "opera", markers: []string{"opr/", "opera"}},
+		{name: "samsung", markers: []string{"samsungbrowser/"}},
+		{name: "yandex", markers: []string{"yabrowser/"}},
+		{name: "firefox", markers: []string{"firefox/", "fxios/"}},
+		{name: "chrome", markers: []string{"chrome/", "crios/", "chromium/"}},
+		{name: "ie", markers: []string{"msie ", "trident/"}},
+		{name: "safari", markers: []string{"safari/"}},
+	}
+	osFamilies = []family{
+		{name: "ios", markers: []string{"iphone", "ipad", "ipod"}},
+		{name: "android", markers: []string{"android"}},
+		{name: "windows", markers: []string{"windows"}},
+		{name: "chromeos", markers: []string{"cros "}},
+		{name: "macos", markers: []string{"macintosh", "mac os x"}},
+		{name: "linux", markers: []string{"linux"}},
+	}
+)
+
+// it returns the browser and OS families in lower case;
+// if a family can't be detected, an empty string is returned for it
+func parseUserAgent(userAgent string) (browser string, os string) {
+	userAgent = strings.ToLower(userAgent)
+	return detectFamily(userAgent, browserFamilies),
+		detectFamily(userAgent, osFamilies)
+}
+
+func detectFamily(userAgent string, families []family) string {
+	for _, family := range families {
+		for _, marker := range family.markers {
+			if strings.Contains(userAgent, marker) {
+				return family.name
+			}
+		}
+	}
+
+	return ""
+}
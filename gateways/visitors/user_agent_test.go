@@ -0,0 +1,113 @@
+package visitors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseUserAgent(test *testing.T) {
+	for _, data := range []struct {
+		name        string
+		userAgent   string
+		wantBrowser string
+		wantOS      string
+	}{
+		{
+			name:        "empty",
+			userAgent:   "",
+			wantBrowser: "",
+			wantOS:      "",
+		},
+		{
+			name:        "unknown",
+			userAgent:   "curl/7.64.1",
+			wantBrowser: "",
+			wantOS:      "",
+		},
+		{
+			name: "Safari on iOS",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 13_3 like Mac OS X) " +
+				"AppleWebKit/605.1.15 (KHTML, like Gecko) Version/13.0.4 " +
+				"Mobile/15E148 Safari/604.1",
+			wantBrowser: "safari",
+			wantOS:      "ios",
+		},
+		{
+			name: "Chrome on iOS",
+			userAgent: "Mozilla/5.0 (iPad; CPU OS 13_3 like Mac OS X) " +
+				"AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/79.0.3945.73 " +
+				"Mobile/15E148 Safari/604.1",
+			wantBrowser: "chrome",
+			wantOS:      "ios",
+		},
+		{
+			name: "Chrome on Android",
+			userAgent: "Mozilla/5.0 (Linux; Android 10; Pixel 3) " +
+				"AppleWebKit/537.36 (KHTML, like Gecko) Chrome/79.0.3945.116 " +
+				"Mobile Safari/537.36",
+			wantBrowser: "chrome",
+			wantOS:      "android",
+		},
+		{
+			name: "Samsung Internet on Android",
+			userAgent: "Mozilla/5.0 (Linux; Android 9; SM-G960F) " +
+				"AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/10.2 " +
+				"Chrome/71.0.3578.99 Mobile Safari/537.36",
+			wantBrowser: "samsung",
+			wantOS:      "android",
+		},
+		{
+			name: "Edge on Windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) " +
+				"AppleWebKit/537.36 (KHTML, like Gecko) Chrome/79.0.3945.117 " +
+				"Safari/537.36 Edg/79.0.309.65",
+			wantBrowser: "edge",
+			wantOS:      "windows",
+		},
+		{
+			name: "Internet Explorer on Windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; WOW64; Trident/7.0; " +
+				"rv:11.0) like Gecko",
+			wantBrowser: "ie",
+			wantOS:      "windows",
+		},
+		{
+			name: "Opera on macOS",
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_2) " +
+				"AppleWebKit/537.36 (KHTML, like Gecko) Chrome/79.0.3945.117 " +
+				"Safari/537.36 OPR/66.0.3515.44",
+			wantBrowser: "opera",
+			wantOS:      "macos",
+		},
+		{
+			name: "Firefox on Linux",
+			userAgent: "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:72.0) " +
+				"Gecko/20100101 Firefox/72.0",
+			wantBrowser: "firefox",
+			wantOS:      "linux",
+		},
+		{
+			name: "Chrome on Chrome OS",
+			userAgent: "Mozilla/5.0 (X11; CrOS x86_64 12607.58.0) " +
+				"AppleWebKit/537.36 (KHTML, like Gecko) Chrome/79.0.3945.86 " +
+				"Safari/537.36",
+			wantBrowser: "chrome",
+			wantOS:      "chromeos",
+		},
+		{
+			name: "bot",
+			userAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; " +
+				"+http://www.google.com/bot.html)",
+			wantBrowser: "bot",
+			wantOS:      "",
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotBrowser, gotOS := parseUserAgent(data.userAgent)
+
+			assert.Equal(test, data.wantBrowser, gotBrowser)
+			assert.Equal(test, data.wantOS, gotOS)
+		})
+	}
+}
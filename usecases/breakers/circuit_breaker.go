@@ -0,0 +1,308 @@
+package breakers
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/go-log/log"
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// ErrOpenCircuit ...
+//
+// It's returned instead of calling of a backend, while the backend is
+// considered as failing.
+//
+var ErrOpenCircuit = errors.New("open circuit")
+
+// State ...
+type State int
+
+// States of a circuit.
+const (
+	ClosedState State = iota
+	HalfOpenState
+	OpenState
+)
+
+// String ...
+func (state State) String() string {
+	switch state {
+	case ClosedState:
+		return "closed"
+	case HalfOpenState:
+		return "half-open"
+	case OpenState:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+//go:generate mockery --name=Observer --inpackage --case=underscore --testonly
+
+// Observer ...
+//
+// It's notified about the breaker state, e.g. for collecting of metrics.
+// It's called under the breaker lock, so it should be fast.
+//
+type Observer interface {
+	ObserveStateChange(name string, state State)
+	ObserveRejectedCall(name string)
+}
+
+// FailureChecker ...
+type FailureChecker func(err error) bool
+
+// Clock ...
+type Clock func() time.Time
+
+// CircuitBreakerOption ...
+type CircuitBreakerOption func(breaker *CircuitBreaker)
+
+// WithFailureThreshold ...
+//
+// It sets a count of consecutive failures, which opens the circuit.
+// A non-positive threshold means that the circuit is never opened.
+//
+func WithFailureThreshold(threshold int) CircuitBreakerOption {
+	return func(breaker *CircuitBreaker) {
+		breaker.failureThreshold = threshold
+	}
+}
+
+// WithOpenTimeout ...
+//
+// It sets a time, after which the open circuit lets probing calls through.
+//
+func WithOpenTimeout(timeout time.Duration) CircuitBreakerOption {
+	return func(breaker *CircuitBreaker) {
+		breaker.openTimeout = timeout
+	}
+}
+
+// WithProbeCount ...
+//
+// It sets a count of successful probing calls, which closes the half-open
+// circuit. It's also the maximal count of concurrent probing calls.
+//
+func WithProbeCount(count int) CircuitBreakerOption {
+	return func(breaker *CircuitBreaker) {
+		breaker.probeCount = count
+	}
+}
+
+// WithFailureChecker ...
+func WithFailureChecker(checker FailureChecker) CircuitBreakerOption {
+	return func(breaker *CircuitBreaker) {
+		breaker.failureChecker = checker
+	}
+}
+
+// WithObserver ...
+func WithObserver(observer Observer) CircuitBreakerOption {
+	return func(breaker *CircuitBreaker) {
+		breaker.observer = observer
+	}
+}
+
+// WithLogger ...
+func WithLogger(logger log.Logger) CircuitBreakerOption {
+	return func(breaker *CircuitBreaker) {
+		breaker.logger = logger
+	}
+}
+
+// WithClock ...
+func WithClock(clock Clock) CircuitBreakerOption {
+	return func(breaker *CircuitBreaker) {
+		breaker.clock = clock
+	}
+}
+
+// CircuitBreaker ...
+//
+// It's shared by all the calls of a single backend, so they are rejected
+// together, when the backend is failing.
+//
+type CircuitBreaker struct {
+	name             string
+	failureThreshold int
+	openTimeout      time.Duration
+	probeCount       int
+	failureChecker   FailureChecker
+	observer         Observer
+	logger           log.Logger
+	clock            Clock
+
+	locker       sync.Mutex
+	state        State
+	failureCount int
+	successCount int
+	probingCount int
+	openingTime  time.Time
+}
+
+// Default settings of a circuit breaker.
+const (
+	DefaultFailureThreshold = 5
+	DefaultOpenTimeout      = 10 * time.Second
+	DefaultProbeCount       = 1
+)
+
+// NewCircuitBreaker ...
+func NewCircuitBreaker(
+	name string,
+	options ...CircuitBreakerOption,
+) *CircuitBreaker {
+	breaker := &CircuitBreaker{
+		name:             name,
+		failureThreshold: DefaultFailureThreshold,
+		openTimeout:      DefaultOpenTimeout,
+		probeCount:       DefaultProbeCount,
+		failureChecker:   IsFailure,
+		clock:            time.Now,
+		state:            ClosedState,
+	}
+	for _, option := range options {
+		option(breaker)
+	}
+	if breaker.probeCount < 1 {
+		breaker.probeCount = 1
+	}
+
+	return breaker
+}
+
+// State ...
+func (breaker *CircuitBreaker) State() State {
+	breaker.locker.Lock()
+	defer breaker.locker.Unlock()
+
+	return breaker.state
+}
+
+// Run ...
+//
+// It returns the ErrOpenCircuit error without calling of the handler,
+// if the circuit is open. Otherwise, it returns the handler error as is,
+// so sentinel errors remain comparable.
+//
+func (breaker *CircuitBreaker) Run(
+	ctx context.Context,
+	handler func(ctx context.Context) error,
+) error {
+	probing, err := breaker.acquire()
+	if err != nil {
+		return err
+	}
+
+	err = handler(ctx)
+	breaker.release(probing, breaker.failureChecker(err))
+
+	return err
+}
+
+func (breaker *CircuitBreaker) acquire() (probing bool, err error) {
+	breaker.locker.Lock()
+	defer breaker.locker.Unlock()
+
+	if breaker.state == OpenState {
+		if breaker.clock().Sub(breaker.openingTime) < breaker.openTimeout {
+			return false, breaker.reject()
+		}
+
+		breaker.setState(HalfOpenState)
+	}
+	if breaker.state == HalfOpenState {
+		if breaker.probingCount >= breaker.probeCount {
+			return false, breaker.reject()
+		}
+
+		breaker.probingCount++
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (breaker *CircuitBreaker) release(probing bool, failure bool) {
+	breaker.locker.Lock()
+	defer breaker.locker.Unlock()
+
+	// results of calls started in another state are outdated
+	switch {
+	case probing && breaker.state == HalfOpenState:
+		breaker.probingCount--
+		if failure {
+			breaker.setState(OpenState)
+			break
+		}
+
+		breaker.successCount++
+		if breaker.successCount >= breaker.probeCount {
+			breaker.setState(ClosedState)
+		}
+	case !probing && breaker.state == ClosedState:
+		if !failure {
+			breaker.failureCount = 0
+			break
+		}
+
+		breaker.failureCount++
+		if breaker.failureThreshold > 0 &&
+			breaker.failureCount >= breaker.failureThreshold {
+			breaker.setState(OpenState)
+		}
+	}
+}
+
+func (breaker *CircuitBreaker) reject() error {
+	if breaker.observer != nil {
+		breaker.observer.ObserveRejectedCall(breaker.name)
+	}
+
+	return errors.Wrapf(ErrOpenCircuit, "the circuit %q is open", breaker.name)
+}
+
+func (breaker *CircuitBreaker) setState(state State) {
+	breaker.state = state
+	breaker.failureCount = 0
+	breaker.successCount = 0
+	breaker.probingCount = 0
+	if state == OpenState {
+		breaker.openingTime = breaker.clock()
+	}
+
+	if breaker.logger != nil {
+		breaker.logger.Logf("the circuit %q is %s", breaker.name, state)
+	}
+	if breaker.observer != nil {
+		breaker.observer.ObserveStateChange(breaker.name, state)
+	}
+}
+
+// IsFailure ...
+//
+// It's the default failure checker. It ignores errors caused by the data
+// or by the caller, e.g. missed links or client disconnects, because they
+// don't mean that the backend is failing.
+//
+func IsFailure(err error) bool {
+	switch errors.Cause(err) {
+	case nil,
+		sql.ErrNoRows,
+		context.Canceled,
+		entities.ErrInvalidLink,
+		entities.ErrLinkConflict,
+		entities.ErrLinkExpired,
+		entities.ErrLinkDisabled,
+		entities.ErrLinkBlocked:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,281 @@
+package breakers
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+type step struct {
+	delay      time.Duration
+	handlerErr error
+	wantCalled bool
+	wantCause  error
+}
+
+func TestState_String(test *testing.T) {
+	for _, data := range []struct {
+		name  string
+		state State
+		want  string
+	}{
+		{"closed", ClosedState, "closed"},
+		{"half-open", HalfOpenState, "half-open"},
+		{"open", OpenState, "open"},
+		{"unknown", State(23), "unknown"},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := data.state.String()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestNewCircuitBreaker(test *testing.T) {
+	test.Run("with defaults", func(test *testing.T) {
+		got := NewCircuitBreaker("test")
+
+		require.NotNil(test, got)
+		assert.Equal(test, "test", got.name)
+		assert.Equal(test, DefaultFailureThreshold, got.failureThreshold)
+		assert.Equal(test, DefaultOpenTimeout, got.openTimeout)
+		assert.Equal(test, DefaultProbeCount, got.probeCount)
+		assert.NotNil(test, got.failureChecker)
+		assert.Nil(test, got.observer)
+		assert.Nil(test, got.logger)
+		assert.NotNil(test, got.clock)
+		assert.Equal(test, ClosedState, got.State())
+	})
+
+	test.Run("with options", func(test *testing.T) {
+		observer := new(MockObserver)
+		logger := new(MockLogger)
+		got := NewCircuitBreaker(
+			"test",
+			WithFailureThreshold(23),
+			WithOpenTimeout(time.Minute),
+			WithProbeCount(0),
+			WithObserver(observer),
+			WithLogger(logger),
+		)
+
+		mock.AssertExpectationsForObjects(test, observer, logger)
+		require.NotNil(test, got)
+		assert.Equal(test, 23, got.failureThreshold)
+		assert.Equal(test, time.Minute, got.openTimeout)
+		// at least one probe is required to close the circuit
+		assert.Equal(test, 1, got.probeCount)
+		assert.Equal(test, observer, got.observer)
+		assert.Equal(test, logger, got.logger)
+	})
+}
+
+func TestCircuitBreaker_Run(test *testing.T) {
+	for _, data := range []struct {
+		name             string
+		probeCount       int
+		steps            []step
+		wantState        State
+		wantStateChanges []State
+		wantRejections   int
+	}{
+		{
+			name:       "success",
+			probeCount: 1,
+			steps: []step{
+				{handlerErr: nil, wantCalled: true, wantCause: nil},
+				{handlerErr: sql.ErrNoRows, wantCalled: true, wantCause: sql.ErrNoRows},
+			},
+			wantState:        ClosedState,
+			wantStateChanges: nil,
+			wantRejections:   0,
+		},
+		{
+			name:       "failures below the threshold",
+			probeCount: 1,
+			steps: []step{
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				// a success resets the count of consecutive failures
+				{handlerErr: nil, wantCalled: true, wantCause: nil},
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+			},
+			wantState:        ClosedState,
+			wantStateChanges: nil,
+			wantRejections:   0,
+		},
+		{
+			name:       "opening",
+			probeCount: 1,
+			steps: []step{
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{wantCalled: false, wantCause: ErrOpenCircuit},
+				{delay: 59 * time.Second, wantCalled: false, wantCause: ErrOpenCircuit},
+			},
+			wantState:        OpenState,
+			wantStateChanges: []State{OpenState},
+			wantRejections:   2,
+		},
+		{
+			name:       "closing after probing",
+			probeCount: 2,
+			steps: []step{
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{delay: time.Minute, handlerErr: nil, wantCalled: true, wantCause: nil},
+				{handlerErr: nil, wantCalled: true, wantCause: nil},
+			},
+			wantState:        ClosedState,
+			wantStateChanges: []State{OpenState, HalfOpenState, ClosedState},
+			wantRejections:   0,
+		},
+		{
+			name:       "reopening after probing",
+			probeCount: 2,
+			steps: []step{
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{delay: time.Minute, handlerErr: nil, wantCalled: true, wantCause: nil},
+				{handlerErr: iotest.ErrTimeout, wantCalled: true, wantCause: iotest.ErrTimeout},
+				{wantCalled: false, wantCause: ErrOpenCircuit},
+			},
+			wantState:        OpenState,
+			wantStateChanges: []State{OpenState, HalfOpenState, OpenState},
+			wantRejections:   1,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			observer := new(MockObserver)
+			logger := new(MockLogger)
+			for _, state := range data.wantStateChanges {
+				observer.On("ObserveStateChange", "test", state).Once()
+				logger.On("Logf", "the circuit %q is %s", "test", state).Once()
+			}
+			if data.wantRejections != 0 {
+				observer.On("ObserveRejectedCall", "test").Times(data.wantRejections)
+			}
+
+			now := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+			breaker := NewCircuitBreaker(
+				"test",
+				WithFailureThreshold(3),
+				WithOpenTimeout(time.Minute),
+				WithProbeCount(data.probeCount),
+				WithObserver(observer),
+				WithLogger(logger),
+				WithClock(func() time.Time { return now }),
+			)
+			for index, step := range data.steps {
+				now = now.Add(step.delay)
+
+				var called bool
+				err := breaker.Run(context.Background(), func(context.Context) error {
+					called = true
+					return step.handlerErr
+				})
+
+				assert.Equal(test, step.wantCalled, called, "step #%d", index+1)
+				assert.Equal(test, step.wantCause, errors.Cause(err), "step #%d", index+1)
+			}
+
+			mock.AssertExpectationsForObjects(test, observer, logger)
+			assert.Equal(test, data.wantState, breaker.State())
+		})
+	}
+}
+
+func TestCircuitBreaker_Run_withConcurrentProbes(test *testing.T) {
+	now := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	breaker := NewCircuitBreaker(
+		"test",
+		WithFailureThreshold(1),
+		WithOpenTimeout(time.Minute),
+		WithClock(func() time.Time { return now }),
+	)
+	err := breaker.Run(context.Background(), func(context.Context) error {
+		return iotest.ErrTimeout
+	})
+	require.Equal(test, iotest.ErrTimeout, err)
+
+	now = now.Add(time.Minute)
+	probeStarted, probeFinished := make(chan struct{}), make(chan struct{})
+	probeErr := make(chan error)
+	go func() {
+		probeErr <- breaker.Run(context.Background(), func(context.Context) error {
+			close(probeStarted)
+			<-probeFinished
+
+			return nil
+		})
+	}()
+	<-probeStarted
+
+	// only a single probe is let through at a time
+	err = breaker.Run(context.Background(), func(context.Context) error {
+		return nil
+	})
+	assert.Equal(test, ErrOpenCircuit, errors.Cause(err))
+
+	close(probeFinished)
+	assert.NoError(test, <-probeErr)
+	assert.Equal(test, ClosedState, breaker.State())
+}
+
+func TestIsFailure(test *testing.T) {
+	for _, data := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "success",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "missed link",
+			err:  errors.Wrap(sql.ErrNoRows, "dummy"),
+			want: false,
+		},
+		{
+			name: "link conflict",
+			err:  errors.Wrap(entities.ErrLinkConflict, "dummy"),
+			want: false,
+		},
+		{
+			name: "client disconnect",
+			err:  errors.Wrap(context.Canceled, "dummy"),
+			want: false,
+		},
+		{
+			name: "timeout",
+			err:  errors.Wrap(context.DeadlineExceeded, "dummy"),
+			want: true,
+		},
+		{
+			name: "failure",
+			err:  iotest.ErrTimeout,
+			want: true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := IsFailure(data.err)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package breakers
+
+import (
+	"context"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/counters"
+)
+
+//go:generate mockery --name=LinkGetter --inpackage --case=underscore --testonly
+
+// LinkGetter ...
+type LinkGetter interface {
+	GetLink(ctx context.Context, query string) (entities.Link, error)
+}
+
+//go:generate mockery --name=LinkSetter --inpackage --case=underscore --testonly
+
+// LinkSetter ...
+type LinkSetter interface {
+	SetLink(ctx context.Context, link entities.Link) error
+}
+
+//go:generate mockery --name=LinkDeleter --inpackage --case=underscore --testonly
+
+// LinkDeleter ...
+type LinkDeleter interface {
+	DeleteLink(ctx context.Context, link entities.Link) error
+}
+
+//go:generate mockery --name=LinkUpdater --inpackage --case=underscore --testonly
+
+// LinkUpdater ...
+type LinkUpdater interface {
+	UpdateLink(ctx context.Context, link entities.Link) error
+}
+
+// BreakingLinkGetter ...
+type BreakingLinkGetter struct {
+	LinkGetter LinkGetter
+	Breaker    *CircuitBreaker
+}
+
+// GetLink ...
+func (getter BreakingLinkGetter) GetLink(
+	ctx context.Context,
+	query string,
+) (entities.Link, error) {
+	var link entities.Link
+	err := getter.Breaker.Run(ctx, func(ctx context.Context) error {
+		var err error
+		link, err = getter.LinkGetter.GetLink(ctx, query)
+
+		return err
+	})
+
+	return link, err
+}
+
+// BreakingLinkSetter ...
+type BreakingLinkSetter struct {
+	LinkSetter LinkSetter
+	Breaker    *CircuitBreaker
+}
+
+// SetLink ...
+func (setter BreakingLinkSetter) SetLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	return setter.Breaker.Run(ctx, func(ctx context.Context) error {
+		return setter.LinkSetter.SetLink(ctx, link)
+	})
+}
+
+// BreakingLinkDeleter ...
+type BreakingLinkDeleter struct {
+	LinkDeleter LinkDeleter
+	Breaker     *CircuitBreaker
+}
+
+// DeleteLink ...
+func (deleter BreakingLinkDeleter) DeleteLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	return deleter.Breaker.Run(ctx, func(ctx context.Context) error {
+		return deleter.LinkDeleter.DeleteLink(ctx, link)
+	})
+}
+
+// BreakingLinkUpdater ...
+type BreakingLinkUpdater struct {
+	LinkUpdater LinkUpdater
+	Breaker     *CircuitBreaker
+}
+
+// UpdateLink ...
+func (updater BreakingLinkUpdater) UpdateLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	return updater.Breaker.Run(ctx, func(ctx context.Context) error {
+		return updater.LinkUpdater.UpdateLink(ctx, link)
+	})
+}
+
+// BreakingCounter ...
+type BreakingCounter struct {
+	DistributedCounter counters.DistributedCounter
+	Breaker            *CircuitBreaker
+}
+
+// NextCountChunk ...
+func (counter BreakingCounter) NextCountChunk(
+	ctx context.Context,
+) (uint64, error) {
+	var countChunk uint64
+	err := counter.Breaker.Run(ctx, func(ctx context.Context) error {
+		var err error
+		countChunk, err = counter.DistributedCounter.NextCountChunk(ctx)
+
+		return err
+	})
+
+	return countChunk, err
+}
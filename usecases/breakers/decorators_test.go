@@ -0,0 +1,265 @@
+package breakers
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestBreakingLinkGetter_GetLink(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		open      bool
+		innerLink entities.Link
+		innerErr  error
+		wantLink  entities.Link
+		wantCause error
+	}{
+		{
+			name:      "success",
+			open:      false,
+			innerLink: entities.Link{Code: "code", URL: "url"},
+			innerErr:  nil,
+			wantLink:  entities.Link{Code: "code", URL: "url"},
+			wantCause: nil,
+		},
+		{
+			name:      "error",
+			open:      false,
+			innerLink: entities.Link{},
+			innerErr:  iotest.ErrTimeout,
+			wantLink:  entities.Link{},
+			wantCause: iotest.ErrTimeout,
+		},
+		{
+			name:      "error with the open circuit",
+			open:      true,
+			wantLink:  entities.Link{},
+			wantCause: ErrOpenCircuit,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			linkGetter := new(MockLinkGetter)
+			if !data.open {
+				linkGetter.
+					On("GetLink", context.Background(), "query").
+					Return(data.innerLink, data.innerErr)
+			}
+
+			getter := BreakingLinkGetter{
+				LinkGetter: linkGetter,
+				Breaker:    newTestBreaker(data.open),
+			}
+			gotLink, gotErr := getter.GetLink(context.Background(), "query")
+
+			mock.AssertExpectationsForObjects(test, linkGetter)
+			assert.Equal(test, data.wantLink, gotLink)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestBreakingLinkSetter_SetLink(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		open      bool
+		innerErr  error
+		wantCause error
+	}{
+		{
+			name:      "success",
+			open:      false,
+			innerErr:  nil,
+			wantCause: nil,
+		},
+		{
+			name:      "error",
+			open:      false,
+			innerErr:  iotest.ErrTimeout,
+			wantCause: iotest.ErrTimeout,
+		},
+		{
+			name:      "error with the open circuit",
+			open:      true,
+			wantCause: ErrOpenCircuit,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := entities.Link{Code: "code", URL: "url"}
+			linkSetter := new(MockLinkSetter)
+			if !data.open {
+				linkSetter.
+					On("SetLink", context.Background(), link).
+					Return(data.innerErr)
+			}
+
+			setter := BreakingLinkSetter{
+				LinkSetter: linkSetter,
+				Breaker:    newTestBreaker(data.open),
+			}
+			gotErr := setter.SetLink(context.Background(), link)
+
+			mock.AssertExpectationsForObjects(test, linkSetter)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestBreakingLinkDeleter_DeleteLink(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		open      bool
+		innerErr  error
+		wantCause error
+	}{
+		{
+			name:      "success",
+			open:      false,
+			innerErr:  nil,
+			wantCause: nil,
+		},
+		{
+			name:      "error",
+			open:      false,
+			innerErr:  iotest.ErrTimeout,
+			wantCause: iotest.ErrTimeout,
+		},
+		{
+			name:      "error with the open circuit",
+			open:      true,
+			wantCause: ErrOpenCircuit,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := entities.Link{Code: "code", URL: "url"}
+			linkDeleter := new(MockLinkDeleter)
+			if !data.open {
+				linkDeleter.
+					On("DeleteLink", context.Background(), link).
+					Return(data.innerErr)
+			}
+
+			deleter := BreakingLinkDeleter{
+				LinkDeleter: linkDeleter,
+				Breaker:     newTestBreaker(data.open),
+			}
+			gotErr := deleter.DeleteLink(context.Background(), link)
+
+			mock.AssertExpectationsForObjects(test, linkDeleter)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestBreakingLinkUpdater_UpdateLink(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		open      bool
+		innerErr  error
+		wantCause error
+	}{
+		{
+			name:      "success",
+			open:      false,
+			innerErr:  nil,
+			wantCause: nil,
+		},
+		{
+			name:      "error",
+			open:      false,
+			innerErr:  iotest.ErrTimeout,
+			wantCause: iotest.ErrTimeout,
+		},
+		{
+			name:      "error with the open circuit",
+			open:      true,
+			wantCause: ErrOpenCircuit,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := entities.Link{Code: "code", URL: "url"}
+			linkUpdater := new(MockLinkUpdater)
+			if !data.open {
+				linkUpdater.
+					On("UpdateLink", context.Background(), link).
+					Return(data.innerErr)
+			}
+
+			updater := BreakingLinkUpdater{
+				LinkUpdater: linkUpdater,
+				Breaker:     newTestBreaker(data.open),
+			}
+			gotErr := updater.UpdateLink(context.Background(), link)
+
+			mock.AssertExpectationsForObjects(test, linkUpdater)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestBreakingCounter_NextCountChunk(test *testing.T) {
+	for _, data := range []struct {
+		name           string
+		open           bool
+		innerErr       error
+		wantCountChunk uint64
+		wantCause      error
+	}{
+		{
+			name:           "success",
+			open:           false,
+			innerErr:       nil,
+			wantCountChunk: 23,
+			wantCause:      nil,
+		},
+		{
+			name:           "error",
+			open:           false,
+			innerErr:       iotest.ErrTimeout,
+			wantCountChunk: 0,
+			wantCause:      iotest.ErrTimeout,
+		},
+		{
+			name:           "error with the open circuit",
+			open:           true,
+			wantCountChunk: 0,
+			wantCause:      ErrOpenCircuit,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			distributedCounter := new(MockDistributedCounter)
+			if !data.open {
+				distributedCounter.
+					On("NextCountChunk", context.Background()).
+					Return(data.wantCountChunk, data.innerErr)
+			}
+
+			counter := BreakingCounter{
+				DistributedCounter: distributedCounter,
+				Breaker:            newTestBreaker(data.open),
+			}
+			gotCountChunk, gotErr := counter.NextCountChunk(context.Background())
+
+			mock.AssertExpectationsForObjects(test, distributedCounter)
+			assert.Equal(test, data.wantCountChunk, gotCountChunk)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func newTestBreaker(open bool) *CircuitBreaker {
+	breaker := NewCircuitBreaker("test", WithFailureThreshold(1))
+	if open {
+		// nolint: errcheck
+		breaker.Run(context.Background(), func(context.Context) error {
+			return iotest.ErrTimeout
+		})
+	}
+
+	return breaker
+}
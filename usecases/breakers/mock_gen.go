@@ -0,0 +1,27 @@
+package breakers
+
+// nolint: lll
+import (
+	"github.com/go-log/log"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/counters"
+)
+
+//go:generate mockery --name=Logger --inpackage --case=underscore --testonly
+
+// Logger ...
+//
+// It is used only for mock generating.
+//
+type Logger interface {
+	log.Logger
+}
+
+//go:generate mockery --name=DistributedCounter --inpackage --case=underscore --testonly
+
+// DistributedCounter ...
+//
+// It is used only for mock generating.
+//
+type DistributedCounter interface {
+	counters.DistributedCounter
+}
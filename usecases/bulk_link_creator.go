@@ -0,0 +1,168 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=BulkCodeGenerator --inpackage --case=underscore --testonly
+
+// BulkCodeGenerator ...
+type BulkCodeGenerator interface {
+	GenerateCodes(ctx context.Context, count int) ([]string, error)
+}
+
+// BulkLinkCreator ...
+type BulkLinkCreator struct {
+	LinkCreator   LinkCreator
+	LinkSetter    BulkLinkSetter
+	CodeGenerator BulkCodeGenerator
+	MaximalCount  int
+}
+
+// CreateLinks ...
+//
+// Each link is validated and looked up in the same way as by the link creator.
+// Then the codes for all the new links are generated at once, and the links
+// are set at once too.
+//
+// Errors of particular links are returned in their results and don't prevent
+// creating of the other links. Links with the same normalized URL share
+// the result of the first one.
+//
+func (creator BulkLinkCreator) CreateLinks(
+	ctx context.Context,
+	links []entities.Link,
+) ([]entities.LinkResult, error) {
+	if len(links) > creator.MaximalCount {
+		return nil, errors.Wrapf(
+			entities.ErrInvalidLink,
+			"the number of links exceeds the maximum of %d",
+			creator.MaximalCount,
+		)
+	}
+
+	results := make([]entities.LinkResult, len(links))
+	preparedLinks := make([]entities.Link, len(links))
+	firstIndices := make(map[string]int)
+	duplicateIndices := make(map[int]int)
+	var newIndices []int
+	for index, link := range links {
+		preparedLink, err := creator.LinkCreator.prepareLink(link)
+		if err != nil {
+			results[index].Err = err
+			continue
+		}
+
+		preparedLinks[index] = preparedLink
+		if firstIndex, ok := firstIndices[preparedLink.URL]; ok {
+			duplicateIndices[index] = firstIndex
+			continue
+		}
+
+		firstIndices[preparedLink.URL] = index
+
+		existingLink, ok, err := creator.LinkCreator.findLink(ctx, preparedLink)
+		switch {
+		case err != nil:
+			results[index].Err = err
+		case ok:
+			results[index].Link = existingLink
+		default:
+			newIndices = append(newIndices, index)
+		}
+	}
+
+	for len(newIndices) != 0 {
+		retriedIndices, err :=
+			creator.setLinks(ctx, preparedLinks, newIndices, results)
+		if err != nil {
+			return nil, err
+		}
+
+		newIndices = retriedIndices
+	}
+
+	for index, firstIndex := range duplicateIndices {
+		firstResult := results[firstIndex]
+		code := preparedLinks[index].Code
+		if firstResult.Err == nil && code != "" && code != firstResult.Link.Code {
+			results[index].Err = errors.Wrap(
+				entities.ErrLinkConflict,
+				"the URL already has another code",
+			)
+			continue
+		}
+		if firstResult.Err == nil &&
+			!preparedLinks[index].HasSameOptions(preparedLinks[firstIndex]) {
+			results[index].Err = errors.Wrap(
+				entities.ErrLinkConflict,
+				"the URL already has a link with other options",
+			)
+			continue
+		}
+
+		results[index] = firstResult
+	}
+
+	return results, nil
+}
+
+// it returns the indices of the links that should be set again
+func (creator BulkLinkCreator) setLinks(
+	ctx context.Context,
+	preparedLinks []entities.Link,
+	indices []int,
+	results []entities.LinkResult,
+) ([]int, error) {
+	var count int
+	for _, index := range indices {
+		if preparedLinks[index].Code == "" {
+			count++
+		}
+	}
+
+	var codes []string
+	if count != 0 {
+		var err error
+		codes, err = creator.CodeGenerator.GenerateCodes(ctx, count)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to generate codes")
+		}
+	}
+
+	links := make([]entities.Link, 0, len(indices))
+	for _, index := range indices {
+		link := preparedLinks[index]
+		if link.Code == "" {
+			link.Code, codes = codes[0], codes[1:]
+		}
+
+		links = append(links, link)
+	}
+
+	errs, err := creator.LinkSetter.SetLinks(ctx, links)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to set the links")
+	}
+
+	var retriedIndices []int
+	for position, index := range indices {
+		err := errs[position]
+		switch {
+		case err == nil:
+			results[index].Link = links[position]
+		// the generated code may be already taken by an alias,
+		// so just try the next one
+		case preparedLinks[index].Code == "" &&
+			errors.Cause(err) == entities.ErrLinkConflict:
+			retriedIndices = append(retriedIndices, index)
+		default:
+			results[index].Err = errors.Wrap(err, "unable to set the link")
+		}
+	}
+
+	return retriedIndices, nil
+}
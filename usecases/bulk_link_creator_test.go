@@ -0,0 +1,361 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestBulkLinkCreator_CreateLinks(test *testing.T) {
+	type fields struct {
+		LinkGetter    LinkGetter
+		LinkSetter    BulkLinkSetter
+		URLNormalizer URLNormalizer
+		CodeChecker   CodeChecker
+		CodeGenerator BulkCodeGenerator
+		MaximalCount  int
+	}
+	type args struct {
+		links []entities.Link
+	}
+
+	newURLNormalizer := func() *MockURLNormalizer {
+		normalizer := new(MockURLNormalizer)
+		normalizer.
+			On("NormalizeURL", mock.AnythingOfType("string")).
+			Return(func(url string) string { return url }, nil)
+
+		return normalizer
+	}
+	for _, data := range []struct {
+		name        string
+		fields      fields
+		args        args
+		wantResults []entities.LinkResult
+		wantErr     assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url #1").
+						Return(entities.Link{Code: "code #1", URL: "url #1"}, nil)
+					getter.On("GetLink", context.Background(), "url #2").Return(entities.Link{}, sql.ErrNoRows)
+					getter.On("GetLink", context.Background(), "url #3").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() BulkLinkSetter {
+					setter := new(MockBulkLinkSetter)
+					setter.
+						On("SetLinks", context.Background(), []entities.Link{
+							{Code: "code #2", URL: "url #2"},
+							{Code: "alias", URL: "url #3"},
+						}).
+						Return([]error{nil, nil}, nil)
+
+					return setter
+				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url #4").Return("", iotest.ErrTimeout)
+					normalizer.
+						On("NormalizeURL", mock.AnythingOfType("string")).
+						Return(func(url string) string { return url }, nil)
+
+					return normalizer
+				}(),
+				CodeChecker: func() CodeChecker {
+					checker := new(MockCodeChecker)
+					checker.On("CheckCode", "alias").Return(nil)
+
+					return checker
+				}(),
+				CodeGenerator: func() BulkCodeGenerator {
+					generator := new(MockBulkCodeGenerator)
+					generator.On("GenerateCodes", context.Background(), 1).Return([]string{"code #2"}, nil)
+
+					return generator
+				}(),
+				MaximalCount: 10,
+			},
+			args: args{
+				links: []entities.Link{
+					{URL: "url #1"},
+					{URL: "url #2"},
+					{Code: "alias", URL: "url #3"},
+					{URL: "url #4"},
+					{URL: "url #2"},
+				},
+			},
+			wantResults: []entities.LinkResult{
+				{Link: entities.Link{Code: "code #1", URL: "url #1"}},
+				{Link: entities.Link{Code: "code #2", URL: "url #2"}},
+				{Link: entities.Link{Code: "alias", URL: "url #3"}},
+				{Err: iotest.ErrTimeout},
+				{Link: entities.Link{Code: "code #2", URL: "url #2"}},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with a conflict of a generated code",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() BulkLinkSetter {
+					setter := new(MockBulkLinkSetter)
+					setter.
+						On("SetLinks", context.Background(), []entities.Link{{Code: "code #1", URL: "url"}}).
+						Return([]error{entities.ErrLinkConflict}, nil)
+					setter.
+						On("SetLinks", context.Background(), []entities.Link{{Code: "code #2", URL: "url"}}).
+						Return([]error{nil}, nil)
+
+					return setter
+				}(),
+				URLNormalizer: newURLNormalizer(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: func() BulkCodeGenerator {
+					generator := new(MockBulkCodeGenerator)
+					generator.On("GenerateCodes", context.Background(), 1).Return([]string{"code #1"}, nil).Once()
+					generator.On("GenerateCodes", context.Background(), 1).Return([]string{"code #2"}, nil).Once()
+
+					return generator
+				}(),
+				MaximalCount: 10,
+			},
+			args: args{
+				links: []entities.Link{{URL: "url"}},
+			},
+			wantResults: []entities.LinkResult{
+				{Link: entities.Link{Code: "code #2", URL: "url"}},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with a conflict of an alias",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() BulkLinkSetter {
+					setter := new(MockBulkLinkSetter)
+					setter.
+						On("SetLinks", context.Background(), []entities.Link{{Code: "alias", URL: "url"}}).
+						Return([]error{entities.ErrLinkConflict}, nil)
+
+					return setter
+				}(),
+				URLNormalizer: newURLNormalizer(),
+				CodeChecker: func() CodeChecker {
+					checker := new(MockCodeChecker)
+					checker.On("CheckCode", "alias").Return(nil)
+
+					return checker
+				}(),
+				CodeGenerator: new(MockBulkCodeGenerator),
+				MaximalCount:  10,
+			},
+			args: args{
+				links: []entities.Link{{Code: "alias", URL: "url"}},
+			},
+			wantResults: []entities.LinkResult{{Err: entities.ErrLinkConflict}},
+			wantErr:     assert.NoError,
+		},
+		{
+			name: "success with a duplicate with another alias",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkSetter:    new(MockBulkLinkSetter),
+				URLNormalizer: newURLNormalizer(),
+				CodeChecker: func() CodeChecker {
+					checker := new(MockCodeChecker)
+					checker.On("CheckCode", "alias").Return(nil)
+
+					return checker
+				}(),
+				CodeGenerator: new(MockBulkCodeGenerator),
+				MaximalCount:  10,
+			},
+			args: args{
+				links: []entities.Link{{URL: "url"}, {Code: "alias", URL: "url"}},
+			},
+			wantResults: []entities.LinkResult{
+				{Link: entities.Link{Code: "code", URL: "url"}},
+				{Err: entities.ErrLinkConflict},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with a duplicate with other options",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkSetter:    new(MockBulkLinkSetter),
+				URLNormalizer: newURLNormalizer(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockBulkCodeGenerator),
+				MaximalCount:  10,
+			},
+			args: args{
+				links: []entities.Link{{URL: "url"}, {URL: "url", PathForwarding: true}},
+			},
+			wantResults: []entities.LinkResult{
+				{Link: entities.Link{Code: "code", URL: "url"}},
+				{Err: entities.ErrLinkConflict},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success without links",
+			fields: fields{
+				LinkGetter:    new(MockLinkGetter),
+				LinkSetter:    new(MockBulkLinkSetter),
+				URLNormalizer: new(MockURLNormalizer),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockBulkCodeGenerator),
+				MaximalCount:  10,
+			},
+			args:        args{links: nil},
+			wantResults: []entities.LinkResult{},
+			wantErr:     assert.NoError,
+		},
+		{
+			name: "error with too many links",
+			fields: fields{
+				LinkGetter:    new(MockLinkGetter),
+				LinkSetter:    new(MockBulkLinkSetter),
+				URLNormalizer: new(MockURLNormalizer),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockBulkCodeGenerator),
+				MaximalCount:  1,
+			},
+			args: args{
+				links: []entities.Link{{URL: "url #1"}, {URL: "url #2"}},
+			},
+			wantResults: nil,
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err))
+			},
+		},
+		{
+			name: "error with the code generator",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter:    new(MockBulkLinkSetter),
+				URLNormalizer: newURLNormalizer(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: func() BulkCodeGenerator {
+					generator := new(MockBulkCodeGenerator)
+					generator.On("GenerateCodes", context.Background(), 1).Return(nil, iotest.ErrTimeout)
+
+					return generator
+				}(),
+				MaximalCount: 10,
+			},
+			args: args{
+				links: []entities.Link{{URL: "url"}},
+			},
+			wantResults: nil,
+			wantErr:     assert.Error,
+		},
+		{
+			name: "error with the link setter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() BulkLinkSetter {
+					setter := new(MockBulkLinkSetter)
+					setter.
+						On("SetLinks", context.Background(), []entities.Link{{Code: "code", URL: "url"}}).
+						Return(nil, iotest.ErrTimeout)
+
+					return setter
+				}(),
+				URLNormalizer: newURLNormalizer(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: func() BulkCodeGenerator {
+					generator := new(MockBulkCodeGenerator)
+					generator.On("GenerateCodes", context.Background(), 1).Return([]string{"code"}, nil)
+
+					return generator
+				}(),
+				MaximalCount: 10,
+			},
+			args: args{
+				links: []entities.Link{{URL: "url"}},
+			},
+			wantResults: nil,
+			wantErr:     assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			urlChecker := new(MockURLChecker)
+			urlChecker.On("CheckURL", mock.AnythingOfType("string")).Return(nil)
+
+			creator := BulkLinkCreator{
+				LinkCreator: LinkCreator{
+					LinkGetter:    data.fields.LinkGetter,
+					URLNormalizer: data.fields.URLNormalizer,
+					URLChecker:    urlChecker,
+					CodeChecker:   data.fields.CodeChecker,
+				},
+				LinkSetter:    data.fields.LinkSetter,
+				CodeGenerator: data.fields.CodeGenerator,
+				MaximalCount:  data.fields.MaximalCount,
+			}
+			gotResults, gotErr := creator.CreateLinks(context.Background(), data.args.links)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkGetter,
+				data.fields.LinkSetter,
+				data.fields.URLNormalizer,
+				data.fields.CodeChecker,
+				data.fields.CodeGenerator,
+			)
+			for index, result := range gotResults {
+				gotResults[index].Err = errors.Cause(result.Err)
+			}
+			assert.Equal(test, data.wantResults, gotResults)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=KeyIterator --inpackage --case=underscore --testonly
+
+// KeyIterator ...
+type KeyIterator interface {
+	IterateKeys(ctx context.Context, handler func(key string) error) error
+}
+
+// KeyIteratorGroup ...
+type KeyIteratorGroup []KeyIterator
+
+// IterateKeys ...
+func (iterators KeyIteratorGroup) IterateKeys(
+	ctx context.Context,
+	handler func(key string) error,
+) error {
+	for _, iterator := range iterators {
+		if err := iterator.IterateKeys(ctx, handler); err != nil {
+			return errors.Wrap(err, "unable to iterate over the keys")
+		}
+	}
+
+	return nil
+}
+
+// CacheInconsistency ...
+//
+// The cached link is nil, if it's unable to be got. The stored link is nil,
+// if it's missed in the storage.
+//
+type CacheInconsistency struct {
+	Key        string
+	CachedLink *entities.Link
+	StoredLink *entities.Link
+	Reason     string
+}
+
+// CacheVerifier ...
+//
+// The cache link getter should return all the errors as is, i.e. it shouldn't
+// be silent.
+//
+type CacheVerifier struct {
+	KeyIterator     KeyIterator
+	CacheLinkGetter LinkGetter
+	CodeLinkGetter  LinkGetter
+	URLLinkGetter   LinkGetter
+}
+
+// VerifyCache ...
+//
+// It compares each link in the cache with the link in the storage by the same
+// key. Links cached by a code and by an URL are recognized by their keys.
+// Only fields, which may be changed after creating of a link, are compared.
+//
+func (verifier CacheVerifier) VerifyCache(
+	ctx context.Context,
+	handler func(inconsistency CacheInconsistency) error,
+) error {
+	err := verifier.KeyIterator.IterateKeys(ctx, func(key string) error {
+		inconsistency, ok, err := verifier.verifyKey(ctx, key)
+		if err != nil {
+			return errors.Wrapf(err, "unable to verify the key %q", key)
+		}
+		if !ok {
+			return nil
+		}
+
+		if err := handler(inconsistency); err != nil {
+			return errors.Wrap(err, "unable to handle the inconsistency")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to iterate over the keys")
+	}
+
+	return nil
+}
+
+func (verifier CacheVerifier) verifyKey(
+	ctx context.Context,
+	key string,
+) (CacheInconsistency, bool, error) {
+	cachedLink, err := verifier.CacheLinkGetter.GetLink(ctx, key)
+	switch errors.Cause(err) {
+	case nil:
+	// the link was deleted or expired after the key was got
+	case sql.ErrNoRows, entities.ErrLinkExpired:
+		return CacheInconsistency{}, false, nil
+	default:
+		return CacheInconsistency{
+			Key:    key,
+			Reason: fmt.Sprintf("unable to get the cached link: %v", err),
+		}, true, nil
+	}
+
+	var storageGetter LinkGetter
+	switch key {
+	case cachedLink.Code:
+		storageGetter = verifier.CodeLinkGetter
+	case cachedLink.URL:
+		storageGetter = verifier.URLLinkGetter
+	default:
+		return CacheInconsistency{
+			Key:        key,
+			CachedLink: &cachedLink,
+			Reason:     "the key is neither the code nor the URL of the cached link",
+		}, true, nil
+	}
+
+	storedLink, err := storageGetter.GetLink(ctx, key)
+	switch errors.Cause(err) {
+	case nil:
+	case sql.ErrNoRows, entities.ErrLinkExpired:
+		return CacheInconsistency{
+			Key:        key,
+			CachedLink: &cachedLink,
+			Reason:     "the link is missed in the storage",
+		}, true, nil
+	default:
+		return CacheInconsistency{}, false,
+			errors.Wrap(err, "unable to get the stored link")
+	}
+
+	reasons := compareLinks(cachedLink, storedLink)
+	if len(reasons) == 0 {
+		return CacheInconsistency{}, false, nil
+	}
+
+	return CacheInconsistency{
+		Key:        key,
+		CachedLink: &cachedLink,
+		StoredLink: &storedLink,
+		Reason:     strings.Join(reasons, "; "),
+	}, true, nil
+}
+
+func compareLinks(cachedLink entities.Link, storedLink entities.Link) []string {
+	var reasons []string
+	if cachedLink.Code != storedLink.Code {
+		reasons = append(reasons, fmt.Sprintf(
+			"the cached link has the code %q, but the stored one has %q",
+			cachedLink.Code,
+			storedLink.Code,
+		))
+	}
+	if cachedLink.URL != storedLink.URL {
+		reasons = append(reasons, fmt.Sprintf(
+			"the cached link has the URL %q, but the stored one has %q",
+			cachedLink.URL,
+			storedLink.URL,
+		))
+	}
+	if cachedLink.Disabled != storedLink.Disabled {
+		reasons = append(reasons, fmt.Sprintf(
+			"the cached link has the disabling flag %t, but the stored one has %t",
+			cachedLink.Disabled,
+			storedLink.Disabled,
+		))
+	}
+	if !entities.EqualTimes(cachedLink.ExpirationTime, storedLink.ExpirationTime) {
+		reasons = append(
+			reasons,
+			"the cached link and the stored one have different expiration times",
+		)
+	}
+
+	return reasons
+}
@@ -0,0 +1,365 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestKeyIteratorGroup_IterateKeys(test *testing.T) {
+	newKeyIterator := func(keys []string) *MockKeyIterator {
+		iterator := new(MockKeyIterator)
+		iterator.
+			On("IterateKeys", context.Background(), mock.AnythingOfType("func(string) error")).
+			Return(func(ctx context.Context, handler func(key string) error) error {
+				for _, key := range keys {
+					if err := handler(key); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+
+		return iterator
+	}
+
+	for _, data := range []struct {
+		name      string
+		iterators KeyIteratorGroup
+		wantKeys  []string
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "success without iterators",
+			iterators: nil,
+			wantKeys:  nil,
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "success with iterators",
+			iterators: KeyIteratorGroup{
+				newKeyIterator([]string{"key #1", "key #2"}),
+				newKeyIterator([]string{"key #3"}),
+			},
+			wantKeys: []string{"key #1", "key #2", "key #3"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "error",
+			iterators: KeyIteratorGroup{
+				newKeyIterator([]string{"key #1"}),
+				func() KeyIterator {
+					iterator := new(MockKeyIterator)
+					iterator.
+						On("IterateKeys", context.Background(), mock.AnythingOfType("func(string) error")).
+						Return(iotest.ErrTimeout)
+
+					return iterator
+				}(),
+				new(MockKeyIterator),
+			},
+			wantKeys: []string{"key #1"},
+			wantErr:  assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var gotKeys []string
+			gotErr := data.iterators.IterateKeys(context.Background(), func(key string) error {
+				gotKeys = append(gotKeys, key)
+				return nil
+			})
+
+			for _, iterator := range data.iterators {
+				mock.AssertExpectationsForObjects(test, iterator)
+			}
+			assert.Equal(test, data.wantKeys, gotKeys)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func TestCacheVerifier_VerifyCache(test *testing.T) {
+	type fields struct {
+		CacheLinkGetter LinkGetter
+		CodeLinkGetter  LinkGetter
+		URLLinkGetter   LinkGetter
+	}
+	type args struct {
+		keys       []string
+		handlerErr error
+	}
+
+	expirationTime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	otherExpirationTime := expirationTime.Add(time.Hour)
+	for _, data := range []struct {
+		name                string
+		fields              fields
+		args                args
+		wantInconsistencies []CacheInconsistency
+		wantErr             assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success without inconsistencies",
+			fields: fields{
+				CacheLinkGetter: func() LinkGetter {
+					link := entities.Link{
+						Code:           "code",
+						URL:            "url",
+						ExpirationTime: &expirationTime,
+					}
+
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "code").Return(link, nil)
+					getter.On("GetLink", context.Background(), "url").Return(link, nil)
+					getter.On("GetLink", context.Background(), "deleted").Return(entities.Link{}, sql.ErrNoRows)
+					getter.
+						On("GetLink", context.Background(), "expired").
+						Return(entities.Link{}, entities.ErrLinkExpired)
+
+					return getter
+				}(),
+				CodeLinkGetter: func() LinkGetter {
+					// MongoDB returns times with the millisecond precision
+					// and in the local location
+					expirationTime := expirationTime.Local()
+
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "code").
+						Return(entities.Link{
+							Code:           "code",
+							URL:            "url",
+							ExpirationTime: &expirationTime,
+						}, nil)
+
+					return getter
+				}(),
+				URLLinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{
+							Code:           "code",
+							URL:            "url",
+							ExpirationTime: &expirationTime,
+						}, nil)
+
+					return getter
+				}(),
+			},
+			args: args{
+				keys:       []string{"code", "url", "deleted", "expired"},
+				handlerErr: nil,
+			},
+			wantInconsistencies: nil,
+			wantErr:             assert.NoError,
+		},
+		{
+			name: "success with inconsistencies",
+			fields: fields{
+				CacheLinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "broken").
+						Return(entities.Link{}, iotest.ErrTimeout)
+					getter.
+						On("GetLink", context.Background(), "key").
+						Return(entities.Link{Code: "code #1", URL: "url #1"}, nil)
+					getter.
+						On("GetLink", context.Background(), "code #2").
+						Return(entities.Link{Code: "code #2", URL: "url #2"}, nil)
+					getter.
+						On("GetLink", context.Background(), "code #3").
+						Return(entities.Link{
+							Code:           "code #3",
+							URL:            "url #3",
+							ExpirationTime: &expirationTime,
+						}, nil)
+					getter.
+						On("GetLink", context.Background(), "url #4").
+						Return(entities.Link{Code: "code #4", URL: "url #4"}, nil)
+
+					return getter
+				}(),
+				CodeLinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "code #2").Return(entities.Link{}, sql.ErrNoRows)
+					getter.
+						On("GetLink", context.Background(), "code #3").
+						Return(entities.Link{
+							Code:           "code #3",
+							URL:            "url #3.1",
+							ExpirationTime: &otherExpirationTime,
+							Disabled:       true,
+						}, nil)
+
+					return getter
+				}(),
+				URLLinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url #4").
+						Return(entities.Link{Code: "code #4.1", URL: "url #4"}, nil)
+
+					return getter
+				}(),
+			},
+			args: args{
+				keys:       []string{"broken", "key", "code #2", "code #3", "url #4"},
+				handlerErr: nil,
+			},
+			wantInconsistencies: []CacheInconsistency{
+				{
+					Key:    "broken",
+					Reason: "unable to get the cached link: timeout",
+				},
+				{
+					Key:        "key",
+					CachedLink: &entities.Link{Code: "code #1", URL: "url #1"},
+					Reason: "the key is neither the code nor the URL " +
+						"of the cached link",
+				},
+				{
+					Key:        "code #2",
+					CachedLink: &entities.Link{Code: "code #2", URL: "url #2"},
+					Reason:     "the link is missed in the storage",
+				},
+				{
+					Key: "code #3",
+					CachedLink: &entities.Link{
+						Code:           "code #3",
+						URL:            "url #3",
+						ExpirationTime: &expirationTime,
+					},
+					StoredLink: &entities.Link{
+						Code:           "code #3",
+						URL:            "url #3.1",
+						ExpirationTime: &otherExpirationTime,
+						Disabled:       true,
+					},
+					Reason: `the cached link has the URL "url #3", ` +
+						`but the stored one has "url #3.1"; ` +
+						"the cached link has the disabling flag false, " +
+						"but the stored one has true; " +
+						"the cached link and the stored one " +
+						"have different expiration times",
+				},
+				{
+					Key:        "url #4",
+					CachedLink: &entities.Link{Code: "code #4", URL: "url #4"},
+					StoredLink: &entities.Link{Code: "code #4.1", URL: "url #4"},
+					Reason: `the cached link has the code "code #4", ` +
+						`but the stored one has "code #4.1"`,
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error with the stored link getting",
+			fields: fields{
+				CacheLinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "code").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				CodeLinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "code").Return(entities.Link{}, iotest.ErrTimeout)
+
+					return getter
+				}(),
+				URLLinkGetter: new(MockLinkGetter),
+			},
+			args: args{
+				keys:       []string{"code"},
+				handlerErr: nil,
+			},
+			wantInconsistencies: nil,
+			wantErr:             assert.Error,
+		},
+		{
+			name: "error with the handler",
+			fields: fields{
+				CacheLinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "code").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				CodeLinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "code").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				URLLinkGetter: new(MockLinkGetter),
+			},
+			args: args{
+				keys:       []string{"code", "url"},
+				handlerErr: iotest.ErrTimeout,
+			},
+			wantInconsistencies: []CacheInconsistency{
+				{
+					Key:        "code",
+					CachedLink: &entities.Link{Code: "code", URL: "url"},
+					Reason:     "the link is missed in the storage",
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.Equal(test, iotest.ErrTimeout, errors.Cause(err))
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			keyIterator := new(MockKeyIterator)
+			keyIterator.
+				On("IterateKeys", context.Background(), mock.AnythingOfType("func(string) error")).
+				Return(func(ctx context.Context, handler func(key string) error) error {
+					for _, key := range data.args.keys {
+						if err := handler(key); err != nil {
+							return err
+						}
+					}
+
+					return nil
+				})
+
+			var gotInconsistencies []CacheInconsistency
+			verifier := CacheVerifier{
+				KeyIterator:     keyIterator,
+				CacheLinkGetter: data.fields.CacheLinkGetter,
+				CodeLinkGetter:  data.fields.CodeLinkGetter,
+				URLLinkGetter:   data.fields.URLLinkGetter,
+			}
+			gotErr := verifier.VerifyCache(context.Background(), func(
+				inconsistency CacheInconsistency,
+			) error {
+				gotInconsistencies = append(gotInconsistencies, inconsistency)
+				return data.args.handlerErr
+			})
+
+			mock.AssertExpectationsForObjects(
+				test,
+				keyIterator,
+				data.fields.CacheLinkGetter,
+				data.fields.CodeLinkGetter,
+				data.fields.URLLinkGetter,
+			)
+			assert.Equal(test, data.wantInconsistencies, gotInconsistencies)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package checkers
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// AliasChecker ...
+type AliasChecker struct {
+	Alphabet      string
+	MinimalLength int
+	MaximalLength int
+	ReservedCodes []string
+}
+
+// CheckCode ...
+func (checker AliasChecker) CheckCode(code string) error {
+	length := utf8.RuneCountInString(code)
+	if length < checker.MinimalLength {
+		return errors.Wrapf(
+			entities.ErrInvalidLink,
+			"the code is shorter than %d characters",
+			checker.MinimalLength,
+		)
+	}
+	if length > checker.MaximalLength {
+		return errors.Wrapf(
+			entities.ErrInvalidLink,
+			"the code is longer than %d characters",
+			checker.MaximalLength,
+		)
+	}
+
+	for _, symbol := range code {
+		if !strings.ContainsRune(checker.Alphabet, symbol) {
+			return errors.Wrapf(
+				entities.ErrInvalidLink,
+				"the code contains the disallowed character %q",
+				symbol,
+			)
+		}
+	}
+
+	for _, reservedCode := range checker.ReservedCodes {
+		if strings.EqualFold(code, reservedCode) {
+			return errors.Wrapf(entities.ErrInvalidLink, "the code %q is reserved", code)
+		}
+	}
+
+	return nil
+}
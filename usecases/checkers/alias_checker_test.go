@@ -0,0 +1,104 @@
+package checkers
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestAliasChecker_CheckCode(test *testing.T) {
+	type fields struct {
+		Alphabet      string
+		MinimalLength int
+		MaximalLength int
+		ReservedCodes []string
+	}
+	type args struct {
+		code string
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Alphabet:      "abcdefghijklmnopqrstuvwxyz-",
+				MinimalLength: 3,
+				MaximalLength: 12,
+				ReservedCodes: []string{"api", "redirect"},
+			},
+			args:    args{"spring-sale"},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error with a too short code",
+			fields: fields{
+				Alphabet:      "abcdefghijklmnopqrstuvwxyz-",
+				MinimalLength: 3,
+				MaximalLength: 12,
+				ReservedCodes: []string{"api", "redirect"},
+			},
+			args: args{"ab"},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with a too long code",
+			fields: fields{
+				Alphabet:      "abcdefghijklmnopqrstuvwxyz-",
+				MinimalLength: 3,
+				MaximalLength: 12,
+				ReservedCodes: []string{"api", "redirect"},
+			},
+			args: args{"spring-sale-2021"},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with a disallowed character",
+			fields: fields{
+				Alphabet:      "abcdefghijklmnopqrstuvwxyz-",
+				MinimalLength: 3,
+				MaximalLength: 12,
+				ReservedCodes: []string{"api", "redirect"},
+			},
+			args: args{"spring:sale"},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with a reserved code",
+			fields: fields{
+				Alphabet:      "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-",
+				MinimalLength: 3,
+				MaximalLength: 12,
+				ReservedCodes: []string{"api", "redirect"},
+			},
+			args: args{"Redirect"},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			checker := AliasChecker{
+				Alphabet:      data.fields.Alphabet,
+				MinimalLength: data.fields.MinimalLength,
+				MaximalLength: data.fields.MaximalLength,
+				ReservedCodes: data.fields.ReservedCodes,
+			}
+			gotErr := checker.CheckCode(data.args.code)
+
+			data.wantErr(test, gotErr)
+		})
+	}
+}
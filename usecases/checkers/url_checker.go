@@ -0,0 +1,226 @@
+package checkers
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+var privateNetworks = mustParseNetworks(
+	"10.0.0.0/8",     // RFC 1918
+	"172.16.0.0/12",  // RFC 1918
+	"192.168.0.0/16", // RFC 1918
+	"100.64.0.0/10",  // RFC 6598, the carrier-grade NAT
+	"fc00::/7",       // RFC 4193, unique local addresses
+)
+
+// URLChecker ...
+//
+// The URL should be already normalized. Hosts match themselves
+// and their subdomains.
+//
+type URLChecker struct {
+	OwnHosts              []string
+	RedirectPrefix        string
+	ShortenerHosts        []string
+	AllowPrivateAddresses bool
+}
+
+// CheckURL ...
+func (checker URLChecker) CheckURL(rawURL string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(
+			entities.ErrInvalidLink,
+			"unable to parse the URL: %v",
+			err,
+		)
+	}
+
+	host := strings.ToLower(parsedURL.Hostname())
+	if matchHost(host, checker.OwnHosts) {
+		// it's the most common case of redirect loops,
+		// so it's reported separately
+		if checker.RedirectPrefix != "" &&
+			strings.HasPrefix(parsedURL.Path, checker.RedirectPrefix+"/") {
+			return errors.Wrapf(
+				entities.ErrInvalidLink,
+				"the URL path has the redirect prefix %q of the service",
+				checker.RedirectPrefix,
+			)
+		}
+
+		return errors.Wrapf(
+			entities.ErrInvalidLink,
+			"the URL host %q belongs to the service itself",
+			host,
+		)
+	}
+	if matchHost(host, checker.ShortenerHosts) {
+		return errors.Wrapf(
+			entities.ErrInvalidLink,
+			"the URL host %q belongs to another link shortener",
+			host,
+		)
+	}
+	if !checker.AllowPrivateAddresses {
+		if reason, ok := checkAddress(host); !ok {
+			return errors.Wrapf(
+				entities.ErrInvalidLink,
+				"the URL host %q is %s",
+				host,
+				reason,
+			)
+		}
+	}
+
+	return nil
+}
+
+func matchHost(host string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if host == pattern || strings.HasSuffix(host, "."+pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkAddress(host string) (reason string, ok bool) {
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return "a loopback host", false
+	}
+
+	// a zone of an IPv6 address isn't supported by the parsing
+	if index := strings.IndexByte(host, '%'); index != -1 {
+		host = host[:index]
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		var isNumeric bool
+		if ip, isNumeric = parseIPv4(host); isNumeric && ip == nil {
+			return "an invalid numeric address", false
+		}
+	}
+
+	switch {
+	case ip == nil:
+		return "", true
+	case ip.IsUnspecified():
+		return "an unspecified address", false
+	case ip.IsLoopback():
+		return "a loopback address", false
+	case ip.IsLinkLocalUnicast(), ip.IsLinkLocalMulticast():
+		return "a link-local address", false
+	}
+
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return "a private address", false
+		}
+	}
+
+	return "", true
+}
+
+// it parses an IPv4 address in all the forms accepted by browsers,
+// i.e. with 1-4 parts, each in the decimal, octal or hexadecimal notation
+// (e.g. "2130706433", "0x7f.0.0.1", "0177.0.0.1" or "127.1");
+// it reports whether the host is numeric, even if it's invalid
+func parseIPv4(host string) (ip net.IP, isNumeric bool) {
+	parts := strings.Split(strings.TrimSuffix(host, "."), ".")
+	if !isIPv4Number(parts[len(parts)-1]) {
+		return nil, false
+	}
+	if len(parts) > net.IPv4len {
+		return nil, true
+	}
+
+	var address uint64
+	for index, part := range parts {
+		number, ok := parseIPv4Number(part)
+		if !ok {
+			return nil, true
+		}
+
+		// the last part fills all the rest bytes
+		width := uint(8)
+		if index == len(parts)-1 {
+			width = 8 * uint(net.IPv4len-index)
+		}
+		if number >= 1<<width {
+			return nil, true
+		}
+
+		address = address<<width | number
+	}
+
+	return net.IPv4(
+		byte(address>>24),
+		byte(address>>16),
+		byte(address>>8),
+		byte(address),
+	), true
+}
+
+func isIPv4Number(part string) bool {
+	if part == "" {
+		return false
+	}
+
+	digits, hexadecimal := part, false
+	if lowerPart := strings.ToLower(part); strings.HasPrefix(lowerPart, "0x") {
+		digits, hexadecimal = lowerPart[2:], true
+	}
+	for _, symbol := range digits {
+		isDigit := symbol >= '0' && symbol <= '9'
+		isHexadecimalDigit := hexadecimal && symbol >= 'a' && symbol <= 'f'
+		if !isDigit && !isHexadecimalDigit {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parseIPv4Number(part string) (uint64, bool) {
+	base := 10
+	switch lowerPart := strings.ToLower(part); {
+	case strings.HasPrefix(lowerPart, "0x"):
+		part, base = lowerPart[2:], 16
+		// browsers consider the bare prefix as zero
+		if part == "" {
+			return 0, true
+		}
+	case len(part) > 1 && part[0] == '0':
+		part, base = part[1:], 8
+	}
+
+	number, err := strconv.ParseUint(part, base, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return number, true
+}
+
+func mustParseNetworks(cidrs ...string) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(errors.Wrapf(err, "unable to parse the network %q", cidr))
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks
+}
@@ -0,0 +1,255 @@
+package checkers
+
+import (
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestURLChecker_CheckURL(test *testing.T) {
+	type fields struct {
+		OwnHosts              []string
+		RedirectPrefix        string
+		ShortenerHosts        []string
+		AllowPrivateAddresses bool
+	}
+	type args struct {
+		rawURL string
+	}
+
+	defaultFields := fields{
+		OwnHosts:       []string{"Short.example"},
+		RedirectPrefix: "/redirect",
+		ShortenerHosts: []string{"bit.ly", "t.co"},
+	}
+	wantInvalidLink :=
+		func(test assert.TestingT, err error, args ...interface{}) bool {
+			return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+		}
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success",
+			fields:  defaultFields,
+			args:    args{"https://example.com/redirect"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with a similar host",
+			fields:  defaultFields,
+			args:    args{"https://notbit.ly/path"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with a public IP address",
+			fields:  defaultFields,
+			args:    args{"http://8.8.8.8/path"},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with a private IP address and its allowing",
+			fields: fields{
+				OwnHosts:              defaultFields.OwnHosts,
+				RedirectPrefix:        defaultFields.RedirectPrefix,
+				ShortenerHosts:        defaultFields.ShortenerHosts,
+				AllowPrivateAddresses: true,
+			},
+			args:    args{"http://192.168.1.1/path"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error with an own host",
+			fields:  defaultFields,
+			args:    args{"https://short.example/code"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a subdomain of an own host",
+			fields:  defaultFields,
+			args:    args{"https://www.short.example:8080/code"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "success with the redirect prefix on another host",
+			fields:  defaultFields,
+			args:    args{"https://github.com/redirect/code"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with a numeric domain label",
+			fields:  defaultFields,
+			args:    args{"http://1.example/path"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with a public IPv4 address in the decimal form",
+			fields:  defaultFields,
+			args:    args{"http://134744072/path"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error with the redirect prefix of an own host",
+			fields:  defaultFields,
+			args:    args{"https://short.example/redirect/code"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a shortener host",
+			fields:  defaultFields,
+			args:    args{"https://bit.ly/code"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a loopback host",
+			fields:  defaultFields,
+			args:    args{"http://api.localhost/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with an unspecified address",
+			fields:  defaultFields,
+			args:    args{"http://0.0.0.0/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a loopback IPv4 address",
+			fields:  defaultFields,
+			args:    args{"http://127.0.0.2:8080/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a loopback IPv6 address",
+			fields:  defaultFields,
+			args:    args{"http://[::1]/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a link-local IPv4 address",
+			fields:  defaultFields,
+			args:    args{"http://169.254.169.254/latest/meta-data"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a link-local IPv6 address with a zone",
+			fields:  defaultFields,
+			args:    args{"http://[fe80::1%25eth0]/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a private IPv4 address",
+			fields:  defaultFields,
+			args:    args{"http://172.20.0.1/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a private IPv6 address",
+			fields:  defaultFields,
+			args:    args{"http://[fd00::1]/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with an IPv4-mapped private address",
+			fields:  defaultFields,
+			args:    args{"http://[::ffff:10.0.0.1]/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a loopback IPv4 address in the decimal form",
+			fields:  defaultFields,
+			args:    args{"http://2130706433/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a loopback IPv4 address in the hexadecimal form",
+			fields:  defaultFields,
+			args:    args{"http://0x7f.0.0.1/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a loopback IPv4 address in the octal form",
+			fields:  defaultFields,
+			args:    args{"http://0177.0.0.1/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a loopback IPv4 address in the shortened form",
+			fields:  defaultFields,
+			args:    args{"http://127.1/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a private IPv4 address in the mixed form",
+			fields:  defaultFields,
+			args:    args{"http://0xc0.0250.257/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with an invalid numeric host",
+			fields:  defaultFields,
+			args:    args{"http://1.2.3.4.5/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a numeric host out of the range",
+			fields:  defaultFields,
+			args:    args{"http://127.0.0.256/path"},
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with an unparsable URL",
+			fields:  defaultFields,
+			args:    args{"http://example.com:port/path"},
+			wantErr: wantInvalidLink,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			checker := URLChecker{
+				OwnHosts:              data.fields.OwnHosts,
+				RedirectPrefix:        data.fields.RedirectPrefix,
+				ShortenerHosts:        data.fields.ShortenerHosts,
+				AllowPrivateAddresses: data.fields.AllowPrivateAddresses,
+			}
+			gotErr := checker.CheckURL(data.args.rawURL)
+
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func TestParseIPv4(test *testing.T) {
+	for _, data := range []struct {
+		name          string
+		host          string
+		wantIP        net.IP
+		wantIsNumeric bool
+	}{
+		{"in the decimal form", "2130706433", net.IPv4(127, 0, 0, 1), true},
+		{"in the hexadecimal form", "0x7f.0.0.1", net.IPv4(127, 0, 0, 1), true},
+		{"in the octal form", "0177.0.0.1", net.IPv4(127, 0, 0, 1), true},
+		{"in the shortened form", "127.1", net.IPv4(127, 0, 0, 1), true},
+		{"in the mixed form", "0xc0.0250.257", net.IPv4(192, 168, 1, 1), true},
+		{"with a trailing dot", "10.0.0.1.", net.IPv4(10, 0, 0, 1), true},
+		{"with a bare prefix", "0x.0x.0x.0X1", net.IPv4(0, 0, 0, 1), true},
+		{"with too many parts", "1.2.3.4.5", nil, true},
+		{"with a part out of the range", "256.0.0.1", nil, true},
+		{"with the last part out of the range", "1.16777216", nil, true},
+		{"with an incorrect part", "1.x.3.4", nil, true},
+		{"with an incorrect octal part", "09.0.0.1", nil, true},
+		{"with a domain", "example.com", nil, false},
+		{"with a numeric domain label", "1.example", nil, false},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotIP, gotIsNumeric := parseIPv4(data.host)
+
+			assert.Equal(test, data.wantIP, gotIP)
+			assert.Equal(test, data.wantIsNumeric, gotIsNumeric)
+		})
+	}
+}
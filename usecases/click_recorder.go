@@ -0,0 +1,140 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-log/log"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=ClickSetter --inpackage --case=underscore --testonly
+
+// ClickSetter ...
+type ClickSetter interface {
+	SetClicks(ctx context.Context, clicks []entities.Click) error
+}
+
+// BufferedClickRecorder ...
+type BufferedClickRecorder struct {
+	clickSetter   ClickSetter
+	batchSize     int
+	flushInterval time.Duration
+	logger        log.Logger
+	clicks        chan entities.Click
+	stop          chan struct{}
+	done          chan struct{}
+}
+
+// NewBufferedClickRecorder ...
+func NewBufferedClickRecorder(
+	clickSetter ClickSetter,
+	bufferSize int,
+	batchSize int,
+	flushInterval time.Duration,
+	logger log.Logger,
+) *BufferedClickRecorder {
+	return &BufferedClickRecorder{
+		clickSetter:   clickSetter,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		logger:        logger,
+		clicks:        make(chan entities.Click, bufferSize),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+}
+
+// RecordClick ...
+//
+// It never blocks: if the buffer is full, the click is dropped. It's also
+// dropped after the Stop() method is called, so in-flight requests may safely
+// record clicks during the shutdown.
+//
+func (recorder *BufferedClickRecorder) RecordClick(click entities.Click) {
+	select {
+	case <-recorder.stop:
+		recorder.logger.Log("unable to record the click: the recorder is stopped")
+		return
+	default:
+	}
+
+	select {
+	case recorder.clicks <- click:
+	default:
+		recorder.logger.Log("unable to record the click: the buffer is full")
+	}
+}
+
+// Run ...
+//
+// It blocks until the Stop() method is called.
+//
+func (recorder *BufferedClickRecorder) Run() {
+	defer close(recorder.done)
+
+	ticker := time.NewTicker(recorder.flushInterval)
+	defer ticker.Stop()
+
+	var clicks []entities.Click
+	for {
+		select {
+		case click := <-recorder.clicks:
+			clicks = recorder.add(clicks, click)
+		case <-ticker.C:
+			clicks = recorder.flush(clicks)
+		case <-recorder.stop:
+			// the clicks channel is never closed, because a concurrent sending
+			// to it would panic, so the clicks buffered before the stop
+			// are drained without blocking
+			for {
+				select {
+				case click := <-recorder.clicks:
+					clicks = recorder.add(clicks, click)
+				default:
+					recorder.flush(clicks)
+					return
+				}
+			}
+		}
+	}
+}
+
+// Stop ...
+//
+// It flushes the buffered clicks and waits for that. After the call,
+// the recorded clicks are dropped. It should be called only once.
+//
+func (recorder *BufferedClickRecorder) Stop() {
+	close(recorder.stop)
+	<-recorder.done
+}
+
+func (recorder *BufferedClickRecorder) add(
+	clicks []entities.Click,
+	click entities.Click,
+) []entities.Click {
+	clicks = append(clicks, click)
+	if len(clicks) >= recorder.batchSize {
+		clicks = recorder.flush(clicks)
+	}
+
+	return clicks
+}
+
+func (recorder *BufferedClickRecorder) flush(
+	clicks []entities.Click,
+) (emptyClicks []entities.Click) {
+	if len(clicks) == 0 {
+		return clicks
+	}
+
+	// the clicks are flushed in the background, apart from the requests
+	// they were recorded in, so there is no context to inherit
+	if err := recorder.clickSetter.
+		SetClicks(context.Background(), clicks); err != nil {
+		recorder.logger.Logf("unable to set the clicks: %v", err)
+	}
+
+	return nil
+}
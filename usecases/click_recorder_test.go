@@ -0,0 +1,250 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/go-log/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestNewBufferedClickRecorder(test *testing.T) {
+	clickSetter := new(MockClickSetter)
+	logger := new(MockLogger)
+	got := NewBufferedClickRecorder(clickSetter, 23, 42, time.Second, logger)
+
+	mock.AssertExpectationsForObjects(test, clickSetter, logger)
+	assert.Equal(test, clickSetter, got.clickSetter)
+	assert.Equal(test, 42, got.batchSize)
+	assert.Equal(test, time.Second, got.flushInterval)
+	assert.Equal(test, logger, got.logger)
+	assert.Equal(test, 23, cap(got.clicks))
+	assert.NotNil(test, got.stop)
+	assert.NotNil(test, got.done)
+}
+
+func TestBufferedClickRecorder_RecordClick(test *testing.T) {
+	type fields struct {
+		logger log.Logger
+		clicks chan entities.Click
+		stop   chan struct{}
+	}
+	type args struct {
+		click entities.Click
+	}
+
+	for _, data := range []struct {
+		name       string
+		fields     fields
+		args       args
+		wantClicks []entities.Click
+	}{
+		{
+			name: "success",
+			fields: fields{
+				logger: new(MockLogger),
+				clicks: make(chan entities.Click, 1),
+				stop:   make(chan struct{}),
+			},
+			args:       args{entities.Click{Code: "code"}},
+			wantClicks: []entities.Click{{Code: "code"}},
+		},
+		{
+			name: "error with a full buffer",
+			fields: fields{
+				logger: func() log.Logger {
+					logger := new(MockLogger)
+					logger.On("Log", mock.MatchedBy(func(string) bool { return true }))
+
+					return logger
+				}(),
+				clicks: make(chan entities.Click),
+				stop:   make(chan struct{}),
+			},
+			args:       args{entities.Click{Code: "code"}},
+			wantClicks: nil,
+		},
+		{
+			name: "error after the stop",
+			fields: fields{
+				logger: func() log.Logger {
+					logger := new(MockLogger)
+					logger.On("Log", mock.MatchedBy(func(string) bool { return true }))
+
+					return logger
+				}(),
+				clicks: make(chan entities.Click, 1),
+				stop: func() chan struct{} {
+					stop := make(chan struct{})
+					close(stop)
+
+					return stop
+				}(),
+			},
+			args:       args{entities.Click{Code: "code"}},
+			wantClicks: nil,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			recorder := &BufferedClickRecorder{
+				logger: data.fields.logger,
+				clicks: data.fields.clicks,
+				stop:   data.fields.stop,
+			}
+			recorder.RecordClick(data.args.click)
+			close(recorder.clicks)
+
+			var gotClicks []entities.Click
+			for click := range recorder.clicks {
+				gotClicks = append(gotClicks, click)
+			}
+
+			mock.AssertExpectationsForObjects(test, data.fields.logger)
+			assert.Equal(test, data.wantClicks, gotClicks)
+		})
+	}
+}
+
+func TestBufferedClickRecorder_Run(test *testing.T) {
+	type fields struct {
+		clickSetter   ClickSetter
+		batchSize     int
+		flushInterval time.Duration
+		logger        log.Logger
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		clicks []entities.Click
+		wait   time.Duration
+	}{
+		{
+			name: "success with flushing by the batch size",
+			fields: fields{
+				clickSetter: func() ClickSetter {
+					setter := new(MockClickSetter)
+					setter.
+						On("SetClicks", context.Background(), []entities.Click{{Code: "code #1"}, {Code: "code #2"}}).
+						Return(nil)
+					setter.On("SetClicks", context.Background(), []entities.Click{{Code: "code #3"}}).Return(nil)
+
+					return setter
+				}(),
+				batchSize:     2,
+				flushInterval: time.Hour,
+				logger:        new(MockLogger),
+			},
+			clicks: []entities.Click{
+				{Code: "code #1"},
+				{Code: "code #2"},
+				{Code: "code #3"},
+			},
+			wait: 0,
+		},
+		{
+			name: "success with flushing by the interval",
+			fields: fields{
+				clickSetter: func() ClickSetter {
+					setter := new(MockClickSetter)
+					setter.
+						On("SetClicks", context.Background(), []entities.Click{{Code: "code #1"}, {Code: "code #2"}}).
+						Return(nil)
+
+					return setter
+				}(),
+				batchSize:     10,
+				flushInterval: 10 * time.Millisecond,
+				logger:        new(MockLogger),
+			},
+			clicks: []entities.Click{{Code: "code #1"}, {Code: "code #2"}},
+			wait:   100 * time.Millisecond,
+		},
+		{
+			name: "success without clicks",
+			fields: fields{
+				clickSetter:   new(MockClickSetter),
+				batchSize:     10,
+				flushInterval: 10 * time.Millisecond,
+				logger:        new(MockLogger),
+			},
+			clicks: nil,
+			wait:   100 * time.Millisecond,
+		},
+		{
+			name: "error",
+			fields: fields{
+				clickSetter: func() ClickSetter {
+					setter := new(MockClickSetter)
+					setter.
+						On("SetClicks", context.Background(), []entities.Click{{Code: "code #1"}, {Code: "code #2"}}).
+						Return(iotest.ErrTimeout)
+
+					return setter
+				}(),
+				batchSize:     10,
+				flushInterval: time.Hour,
+				logger: func() log.Logger {
+					logger := new(MockLogger)
+					logger.On(
+						"Logf",
+						mock.MatchedBy(func(string) bool { return true }),
+						iotest.ErrTimeout,
+					)
+
+					return logger
+				}(),
+			},
+			clicks: []entities.Click{{Code: "code #1"}, {Code: "code #2"}},
+			wait:   0,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			recorder := NewBufferedClickRecorder(
+				data.fields.clickSetter,
+				len(data.clicks),
+				data.fields.batchSize,
+				data.fields.flushInterval,
+				data.fields.logger,
+			)
+			go recorder.Run()
+
+			for _, click := range data.clicks {
+				recorder.RecordClick(click)
+			}
+			time.Sleep(data.wait)
+			recorder.Stop()
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.clickSetter,
+				data.fields.logger,
+			)
+		})
+	}
+}
+
+func TestBufferedClickRecorder_Stop(test *testing.T) {
+	clickSetter := new(MockClickSetter)
+	clickSetter.
+		On("SetClicks", context.Background(), []entities.Click{{Code: "code #1"}}).
+		Return(nil)
+
+	logger := new(MockLogger)
+	logger.On("Log", "unable to record the click: the recorder is stopped")
+
+	recorder :=
+		NewBufferedClickRecorder(clickSetter, 10, 10, time.Hour, logger)
+	go recorder.Run()
+
+	recorder.RecordClick(entities.Click{Code: "code #1"})
+	recorder.Stop()
+	// an in-flight request may still record a click after the stop
+	recorder.RecordClick(entities.Click{Code: "code #2"})
+
+	mock.AssertExpectationsForObjects(test, clickSetter, logger)
+}
@@ -17,6 +17,18 @@ func (counter ChunkedCounter) IsOver() bool {
 	return counter.current >= counter.final
 }
 
+// Remaining ...
+//
+// It returns a count of values remaining in the current chunk.
+//
+func (counter ChunkedCounter) Remaining() uint64 {
+	if counter.IsOver() {
+		return 0
+	}
+
+	return counter.final - counter.current
+}
+
 // Increase ...
 func (counter *ChunkedCounter) Increase() (previous uint64) {
 	previous = counter.current
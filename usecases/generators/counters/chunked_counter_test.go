@@ -65,6 +65,59 @@ func TestChunkedCounter_IsOver(test *testing.T) {
 	}
 }
 
+func TestChunkedCounter_Remaining(test *testing.T) {
+	type fields struct {
+		step    uint64
+		current uint64
+		final   uint64
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   uint64
+	}{
+		{
+			name: "current less than final",
+			fields: fields{
+				step:    23,
+				current: 42,
+				final:   65,
+			},
+			want: 23,
+		},
+		{
+			name: "current equal to final",
+			fields: fields{
+				step:    23,
+				current: 65,
+				final:   65,
+			},
+			want: 0,
+		},
+		{
+			name: "current greater than final",
+			fields: fields{
+				step:    23,
+				current: 100,
+				final:   65,
+			},
+			want: 0,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			counter := ChunkedCounter{
+				step:    data.fields.step,
+				current: data.fields.current,
+				final:   data.fields.final,
+			}
+			got := counter.Remaining()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
 func TestChunkedCounter_Increase(test *testing.T) {
 	counter := ChunkedCounter{current: 23}
 	previous := counter.Increase()
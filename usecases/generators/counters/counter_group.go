@@ -1,23 +1,113 @@
 package counters
 
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
 //go:generate mockery --name=DistributedCounter --inpackage --case=underscore --testonly
 
 // DistributedCounter ...
 type DistributedCounter interface {
-	NextCountChunk() (uint64, error)
+	NextCountChunk(ctx context.Context) (uint64, error)
 }
 
 // RandomSource ...
 type RandomSource func(maximum int) int
 
 // CounterGroup ...
+//
+// If the failover is on, the selected counter is backed by the rest ones
+// in order, so they are tried when the selected one fails.
+//
 type CounterGroup struct {
 	DistributedCounters []DistributedCounter
-	RandomSource        RandomSource
+	Strategy            Strategy
+	Failover            bool
 }
 
 // SelectCounter ...
 func (group CounterGroup) SelectCounter() DistributedCounter {
-	index := group.RandomSource(len(group.DistributedCounters))
-	return group.DistributedCounters[index]
+	index := group.Strategy.SelectIndex(len(group.DistributedCounters))
+	if !group.Failover {
+		return group.counter(index)
+	}
+
+	var counters FailoverCounter
+	for offset := range group.DistributedCounters {
+		counters = append(counters, group.counter(
+			(index+offset)%len(group.DistributedCounters),
+		))
+	}
+
+	return counters
+}
+
+func (group CounterGroup) counter(index int) DistributedCounter {
+	observer, ok := group.Strategy.(ResultObserver)
+	if !ok {
+		return group.DistributedCounters[index]
+	}
+
+	return ObservedCounter{
+		DistributedCounter: group.DistributedCounters[index],
+		Index:              index,
+		Observer:           observer,
+	}
+}
+
+// ObservedCounter ...
+//
+// It notifies the observer about results of getting of count chunks.
+// Interruptions by the context aren't reported, because they don't mean
+// that the counter is failing.
+//
+type ObservedCounter struct {
+	DistributedCounter DistributedCounter
+	Index              int
+	Observer           ResultObserver
+}
+
+// NextCountChunk ...
+func (counter ObservedCounter) NextCountChunk(
+	ctx context.Context,
+) (uint64, error) {
+	countChunk, err := counter.DistributedCounter.NextCountChunk(ctx)
+	if err == nil || ctx.Err() == nil {
+		counter.Observer.ObserveResult(counter.Index, err)
+	}
+
+	return countChunk, err
+}
+
+// FailoverCounter ...
+//
+// It tries the counters in order until one of them succeeds. The failover
+// is stopped, if the context is done, because the rest counters would fail
+// all the same.
+//
+type FailoverCounter []DistributedCounter
+
+// NextCountChunk ...
+func (counters FailoverCounter) NextCountChunk(
+	ctx context.Context,
+) (uint64, error) {
+	if len(counters) == 0 {
+		return 0, errors.New("there are no counters")
+	}
+
+	var err error
+	for _, counter := range counters {
+		var countChunk uint64
+		countChunk, err = counter.NextCountChunk(ctx)
+		if err == nil {
+			return countChunk, nil
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return 0, errors.Wrap(err, "unable to get the count chunk from any counter")
 }
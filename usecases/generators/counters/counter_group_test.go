@@ -1,9 +1,12 @@
 package counters
 
 import (
+	"context"
 	"math/rand"
 	"testing"
+	"testing/iotest"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,7 +28,7 @@ func TestCounterGroup_SelectCounter(test *testing.T) {
 	}
 	group := &CounterGroup{
 		DistributedCounters: distributedCounters,
-		RandomSource:        rand.New(rand.NewSource(1)).Intn,
+		Strategy:            RandomSource(rand.New(rand.NewSource(1)).Intn),
 	}
 	got := group.SelectCounter()
 
@@ -34,3 +37,261 @@ func TestCounterGroup_SelectCounter(test *testing.T) {
 	}
 	assert.Equal(test, NewMarkedDistributedCounter(2), got)
 }
+
+func TestCounterGroup_SelectCounter_withFailover(test *testing.T) {
+	distributedCounters := []DistributedCounter{
+		NewMarkedDistributedCounter(1),
+		NewMarkedDistributedCounter(2),
+		NewMarkedDistributedCounter(3),
+	}
+	group := &CounterGroup{
+		DistributedCounters: distributedCounters,
+		Strategy:            RandomSource(func(maximum int) int { return 1 }),
+		Failover:            true,
+	}
+	got := group.SelectCounter()
+
+	for _, distributedCounter := range distributedCounters {
+		mock.AssertExpectationsForObjects(test, distributedCounter)
+	}
+	assert.Equal(test, FailoverCounter{
+		NewMarkedDistributedCounter(2),
+		NewMarkedDistributedCounter(3),
+		NewMarkedDistributedCounter(1),
+	}, got)
+}
+
+func TestFailoverCounter_NextCountChunk(test *testing.T) {
+	type args struct {
+		ctx func() context.Context
+	}
+
+	for _, data := range []struct {
+		name           string
+		counters       FailoverCounter
+		args           args
+		wantCountChunk uint64
+		wantErr        assert.ErrorAssertionFunc
+	}{
+		{
+			name:           "error without counters",
+			counters:       nil,
+			args:           args{context.Background},
+			wantCountChunk: 0,
+			wantErr:        assert.Error,
+		},
+		{
+			name: "success with the first counter",
+			counters: func() FailoverCounter {
+				counterOne := new(MockDistributedCounter)
+				counterOne.On("NextCountChunk", context.Background()).Return(uint64(23), nil)
+
+				counterTwo := new(MockDistributedCounter)
+
+				return FailoverCounter{counterOne, counterTwo}
+			}(),
+			args:           args{context.Background},
+			wantCountChunk: 23,
+			wantErr:        assert.NoError,
+		},
+		{
+			name: "success not with the first counter",
+			counters: func() FailoverCounter {
+				counterOne := new(MockDistributedCounter)
+				counterOne.On("NextCountChunk", context.Background()).Return(uint64(0), iotest.ErrTimeout)
+
+				counterTwo := new(MockDistributedCounter)
+				counterTwo.On("NextCountChunk", context.Background()).Return(uint64(42), nil)
+
+				return FailoverCounter{counterOne, counterTwo}
+			}(),
+			args:           args{context.Background},
+			wantCountChunk: 42,
+			wantErr:        assert.NoError,
+		},
+		{
+			name: "error with all the counters",
+			counters: func() FailoverCounter {
+				counterOne := new(MockDistributedCounter)
+				counterOne.On("NextCountChunk", context.Background()).Return(uint64(0), iotest.ErrTimeout)
+
+				counterTwo := new(MockDistributedCounter)
+				counterTwo.On("NextCountChunk", context.Background()).Return(uint64(0), iotest.ErrTimeout)
+
+				return FailoverCounter{counterOne, counterTwo}
+			}(),
+			args:           args{context.Background},
+			wantCountChunk: 0,
+			wantErr:        assert.Error,
+		},
+		{
+			name: "error with the done context",
+			counters: func() FailoverCounter {
+				counterOne := new(MockDistributedCounter)
+				counterOne.On("NextCountChunk", mock.Anything).Return(uint64(0), context.Canceled)
+
+				counterTwo := new(MockDistributedCounter)
+
+				return FailoverCounter{counterOne, counterTwo}
+			}(),
+			args: args{
+				ctx: func() context.Context {
+					ctx, cancel := context.WithCancel(context.Background())
+					cancel()
+
+					return ctx
+				},
+			},
+			wantCountChunk: 0,
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, context.Canceled, errors.Cause(err), args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotCountChunk, gotErr := data.counters.NextCountChunk(data.args.ctx())
+
+			for _, counter := range data.counters {
+				mock.AssertExpectationsForObjects(test, counter)
+			}
+			assert.Equal(test, data.wantCountChunk, gotCountChunk)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+type ObservableStrategy struct {
+	*MockStrategy
+	*MockResultObserver
+}
+
+func TestCounterGroup_SelectCounter_withObservableStrategy(test *testing.T) {
+	distributedCounters := []DistributedCounter{
+		NewMarkedDistributedCounter(1),
+		NewMarkedDistributedCounter(2),
+	}
+	strategy := ObservableStrategy{
+		MockStrategy:       new(MockStrategy),
+		MockResultObserver: new(MockResultObserver),
+	}
+	strategy.MockStrategy.On("SelectIndex", 2).Return(1).Twice()
+
+	for _, data := range []struct {
+		name     string
+		failover bool
+		want     DistributedCounter
+	}{
+		{
+			name:     "without failover",
+			failover: false,
+			want: ObservedCounter{
+				DistributedCounter: NewMarkedDistributedCounter(2),
+				Index:              1,
+				Observer:           strategy,
+			},
+		},
+		{
+			name:     "with failover",
+			failover: true,
+			want: FailoverCounter{
+				ObservedCounter{
+					DistributedCounter: NewMarkedDistributedCounter(2),
+					Index:              1,
+					Observer:           strategy,
+				},
+				ObservedCounter{
+					DistributedCounter: NewMarkedDistributedCounter(1),
+					Index:              0,
+					Observer:           strategy,
+				},
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			group := CounterGroup{
+				DistributedCounters: distributedCounters,
+				Strategy:            strategy,
+				Failover:            data.failover,
+			}
+			got := group.SelectCounter()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+
+	mock.AssertExpectationsForObjects(
+		test,
+		strategy.MockStrategy,
+		strategy.MockResultObserver,
+	)
+}
+
+func TestObservedCounter_NextCountChunk(test *testing.T) {
+	type args struct {
+		ctx func() context.Context
+	}
+
+	for _, data := range []struct {
+		name           string
+		innerErr       error
+		args           args
+		wantObserved   bool
+		wantCountChunk uint64
+		wantErr        assert.ErrorAssertionFunc
+	}{
+		{
+			name:           "success",
+			innerErr:       nil,
+			args:           args{context.Background},
+			wantObserved:   true,
+			wantCountChunk: 23,
+			wantErr:        assert.NoError,
+		},
+		{
+			name:           "error",
+			innerErr:       iotest.ErrTimeout,
+			args:           args{context.Background},
+			wantObserved:   true,
+			wantCountChunk: 0,
+			wantErr:        assert.Error,
+		},
+		{
+			name:     "error with the done context",
+			innerErr: context.Canceled,
+			args: args{
+				ctx: func() context.Context {
+					ctx, cancel := context.WithCancel(context.Background())
+					cancel()
+
+					return ctx
+				},
+			},
+			wantObserved:   false,
+			wantCountChunk: 0,
+			wantErr:        assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			distributedCounter := new(MockDistributedCounter)
+			distributedCounter.
+				On("NextCountChunk", mock.Anything).
+				Return(data.wantCountChunk, data.innerErr)
+
+			observer := new(MockResultObserver)
+			if data.wantObserved {
+				observer.On("ObserveResult", 1, data.innerErr).Return()
+			}
+
+			counter := ObservedCounter{
+				DistributedCounter: distributedCounter,
+				Index:              1,
+				Observer:           observer,
+			}
+			gotCountChunk, gotErr := counter.NextCountChunk(data.args.ctx())
+
+			mock.AssertExpectationsForObjects(test, distributedCounter, observer)
+			assert.Equal(test, data.wantCountChunk, gotCountChunk)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package counters
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=Strategy --inpackage --case=underscore --testonly
+
+// Strategy ...
+//
+// It selects an index of a counter in the group of the specified size.
+// It may be called concurrently.
+//
+type Strategy interface {
+	SelectIndex(count int) int
+}
+
+//go:generate mockery --name=ResultObserver --inpackage --case=underscore --testonly
+
+// ResultObserver ...
+//
+// If a strategy implements it, the strategy is notified about results
+// of getting of count chunks from the selected counters, e.g. for tracking
+// of their health. It may be called concurrently.
+//
+type ResultObserver interface {
+	ObserveResult(index int, err error)
+}
+
+// SelectIndex ...
+//
+// It selects a counter at random.
+//
+func (source RandomSource) SelectIndex(count int) int {
+	return source(count)
+}
+
+// RoundRobinStrategy ...
+//
+// It should be used by a pointer, because it keeps the index
+// of the next counter.
+//
+type RoundRobinStrategy struct {
+	next uint64
+}
+
+// SelectIndex ...
+func (strategy *RoundRobinStrategy) SelectIndex(count int) int {
+	index := atomic.AddUint64(&strategy.next, 1) - 1
+	return int(index % uint64(count))
+}
+
+// WeightedStrategy ...
+//
+// It selects a counter at random with a probability proportional to its
+// weight. Counters without weights are never selected, unless all the
+// weights are zero.
+//
+type WeightedStrategy struct {
+	weights      []int
+	randomSource RandomSource
+}
+
+// NewWeightedStrategy ...
+func NewWeightedStrategy(
+	weights []int,
+	randomSource RandomSource,
+) (WeightedStrategy, error) {
+	var total int
+	for index, weight := range weights {
+		if weight < 0 {
+			return WeightedStrategy{},
+				errors.Errorf("the weight #%d is negative", index+1)
+		}
+
+		total += weight
+	}
+	if total == 0 {
+		return WeightedStrategy{}, errors.New("all the weights are zero")
+	}
+
+	strategy := WeightedStrategy{weights: weights, randomSource: randomSource}
+	return strategy, nil
+}
+
+// SelectIndex ...
+func (strategy WeightedStrategy) SelectIndex(count int) int {
+	weights := strategy.weights
+	if len(weights) > count {
+		weights = weights[:count]
+	}
+
+	var total int
+	for _, weight := range weights {
+		total += weight
+	}
+	if total == 0 {
+		return strategy.randomSource(count)
+	}
+
+	point := strategy.randomSource(total)
+	for index, weight := range weights {
+		if point < weight {
+			return index
+		}
+
+		point -= weight
+	}
+
+	// it's unreachable, while the random source returns valid numbers
+	return len(weights) - 1
+}
+
+// Clock ...
+type Clock func() time.Time
+
+// LeastRecentlyFailedOption ...
+type LeastRecentlyFailedOption func(strategy *LeastRecentlyFailedStrategy)
+
+// WithClock ...
+func WithClock(clock Clock) LeastRecentlyFailedOption {
+	return func(strategy *LeastRecentlyFailedStrategy) {
+		strategy.clock = clock
+	}
+}
+
+// LeastRecentlyFailedStrategy ...
+//
+// It excludes a failed counter from selecting for the exclusion time
+// and selects one of the rest counters at random. If all the counters
+// are excluded, it selects the one, which failed least recently.
+//
+type LeastRecentlyFailedStrategy struct {
+	exclusionTime time.Duration
+	randomSource  RandomSource
+	clock         Clock
+
+	locker       sync.Mutex
+	failureTimes map[int]time.Time
+}
+
+// NewLeastRecentlyFailedStrategy ...
+func NewLeastRecentlyFailedStrategy(
+	exclusionTime time.Duration,
+	randomSource RandomSource,
+	options ...LeastRecentlyFailedOption,
+) *LeastRecentlyFailedStrategy {
+	strategy := &LeastRecentlyFailedStrategy{
+		exclusionTime: exclusionTime,
+		randomSource:  randomSource,
+		clock:         time.Now,
+		failureTimes:  make(map[int]time.Time),
+	}
+	for _, option := range options {
+		option(strategy)
+	}
+
+	return strategy
+}
+
+// SelectIndex ...
+func (strategy *LeastRecentlyFailedStrategy) SelectIndex(count int) int {
+	strategy.locker.Lock()
+	defer strategy.locker.Unlock()
+
+	now := strategy.clock()
+	healthyIndices := make([]int, 0, count)
+	leastRecentlyFailedIndex := -1
+	for index := 0; index < count; index++ {
+		failureTime, failed := strategy.failureTimes[index]
+		if !failed || now.Sub(failureTime) >= strategy.exclusionTime {
+			healthyIndices = append(healthyIndices, index)
+			continue
+		}
+
+		if leastRecentlyFailedIndex == -1 ||
+			failureTime.Before(strategy.failureTimes[leastRecentlyFailedIndex]) {
+			leastRecentlyFailedIndex = index
+		}
+	}
+	if len(healthyIndices) == 0 {
+		return leastRecentlyFailedIndex
+	}
+
+	return healthyIndices[strategy.randomSource(len(healthyIndices))]
+}
+
+// ObserveResult ...
+func (strategy *LeastRecentlyFailedStrategy) ObserveResult(
+	index int,
+	err error,
+) {
+	strategy.locker.Lock()
+	defer strategy.locker.Unlock()
+
+	if err != nil {
+		strategy.failureTimes[index] = strategy.clock()
+	} else {
+		delete(strategy.failureTimes, index)
+	}
+}
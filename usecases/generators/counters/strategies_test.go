@@ -0,0 +1,219 @@
+package counters
+
+import (
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomSource_SelectIndex(test *testing.T) {
+	var gotMaximum int
+	source := RandomSource(func(maximum int) int {
+		gotMaximum = maximum
+		return 1
+	})
+	got := source.SelectIndex(3)
+
+	assert.Equal(test, 3, gotMaximum)
+	assert.Equal(test, 1, got)
+}
+
+func TestRoundRobinStrategy_SelectIndex(test *testing.T) {
+	strategy := new(RoundRobinStrategy)
+
+	var got []int
+	for i := 0; i < 7; i++ {
+		got = append(got, strategy.SelectIndex(3))
+	}
+
+	assert.Equal(test, []int{0, 1, 2, 0, 1, 2, 0}, got)
+}
+
+func TestNewWeightedStrategy(test *testing.T) {
+	for _, data := range []struct {
+		name    string
+		weights []int
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success",
+			weights: []int{1, 0, 2},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error with a negative weight",
+			weights: []int{1, -1, 2},
+			wantErr: assert.Error,
+		},
+		{
+			name:    "error with zero weights",
+			weights: []int{0, 0},
+			wantErr: assert.Error,
+		},
+		{
+			name:    "error without weights",
+			weights: nil,
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			randomSource := func(maximum int) int { panic("not implemented") }
+			got, gotErr := NewWeightedStrategy(data.weights, randomSource)
+
+			if gotErr == nil {
+				assert.Equal(test, data.weights, got.weights)
+				assert.NotNil(test, got.randomSource)
+			}
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func TestWeightedStrategy_SelectIndex(test *testing.T) {
+	for _, data := range []struct {
+		name        string
+		weights     []int
+		count       int
+		wantMaximum int
+		wantIndices []int
+	}{
+		{
+			name:        "with all the weights",
+			weights:     []int{1, 0, 2},
+			count:       3,
+			wantMaximum: 3,
+			wantIndices: []int{0, 2, 2},
+		},
+		{
+			name:        "with extra weights",
+			weights:     []int{1, 2, 3},
+			count:       2,
+			wantMaximum: 3,
+			wantIndices: []int{0, 1, 1},
+		},
+		{
+			name:        "with zero weights of the counters",
+			weights:     []int{0, 0, 3},
+			count:       2,
+			wantMaximum: 2,
+			wantIndices: []int{0, 1},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var point int
+			strategy, err := NewWeightedStrategy(
+				data.weights,
+				func(maximum int) int {
+					require.Equal(test, data.wantMaximum, maximum)
+
+					defer func() { point++ }()
+					return point
+				},
+			)
+			require.NoError(test, err)
+
+			var gotIndices []int
+			for range data.wantIndices {
+				gotIndices = append(gotIndices, strategy.SelectIndex(data.count))
+			}
+
+			assert.Equal(test, data.wantIndices, gotIndices)
+		})
+	}
+}
+
+func TestNewLeastRecentlyFailedStrategy(test *testing.T) {
+	randomSource := func(maximum int) int { panic("not implemented") }
+	got := NewLeastRecentlyFailedStrategy(time.Minute, randomSource)
+
+	require.NotNil(test, got)
+	assert.Equal(test, time.Minute, got.exclusionTime)
+	assert.NotNil(test, got.randomSource)
+	assert.NotNil(test, got.clock)
+	assert.Empty(test, got.failureTimes)
+}
+
+func TestLeastRecentlyFailedStrategy(test *testing.T) {
+	type result struct {
+		index int
+		err   error
+	}
+
+	for _, data := range []struct {
+		name        string
+		results     []result
+		delay       time.Duration
+		wantMaximum int
+		wantIndex   int
+	}{
+		{
+			name:        "without failures",
+			results:     nil,
+			wantMaximum: 3,
+			wantIndex:   2,
+		},
+		{
+			name: "with a failure",
+			results: []result{
+				{index: 0, err: iotest.ErrTimeout},
+			},
+			wantMaximum: 2,
+			wantIndex:   2,
+		},
+		{
+			name: "with a failure and a success",
+			results: []result{
+				{index: 0, err: iotest.ErrTimeout},
+				{index: 0, err: nil},
+			},
+			wantMaximum: 3,
+			wantIndex:   2,
+		},
+		{
+			name: "with an expired failure",
+			results: []result{
+				{index: 0, err: iotest.ErrTimeout},
+			},
+			delay:       time.Minute,
+			wantMaximum: 3,
+			wantIndex:   2,
+		},
+		{
+			name: "with failures of all the counters",
+			results: []result{
+				{index: 2, err: iotest.ErrTimeout},
+				{index: 0, err: iotest.ErrTimeout},
+				{index: 1, err: iotest.ErrTimeout},
+			},
+			wantMaximum: 0,
+			wantIndex:   2,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			now := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+			strategy := NewLeastRecentlyFailedStrategy(
+				time.Minute,
+				func(maximum int) int {
+					require.Equal(test, data.wantMaximum, maximum)
+					return maximum - 1
+				},
+				// each call moves the clock, so the failures are ordered
+				WithClock(func() time.Time {
+					now = now.Add(time.Second)
+					return now
+				}),
+			)
+			for _, result := range data.results {
+				strategy.ObserveResult(result.index, result.err)
+			}
+			now = now.Add(data.delay)
+
+			got := strategy.SelectIndex(3)
+
+			assert.Equal(test, data.wantIndex, got)
+		})
+	}
+}
@@ -1,5 +1,9 @@
 package counters
 
+import (
+	"context"
+)
+
 // Transformer ...
 type Transformer func(countChunk uint64) uint64
 
@@ -10,8 +14,10 @@ type TransformedCounter struct {
 }
 
 // NextCountChunk ...
-func (counter TransformedCounter) NextCountChunk() (uint64, error) {
-	countChunk, err := counter.DistributedCounter.NextCountChunk()
+func (counter TransformedCounter) NextCountChunk(
+	ctx context.Context,
+) (uint64, error) {
+	countChunk, err := counter.DistributedCounter.NextCountChunk(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -1,6 +1,7 @@
 package counters
 
 import (
+	"context"
 	"testing"
 	"testing/iotest"
 
@@ -25,7 +26,7 @@ func TestTransformedCounter_NextCountChunk(test *testing.T) {
 			fields: fields{
 				DistributedCounter: func() DistributedCounter {
 					counter := new(MockDistributedCounter)
-					counter.On("NextCountChunk").Return(uint64(5), nil)
+					counter.On("NextCountChunk", context.Background()).Return(uint64(5), nil)
 
 					return counter
 				}(),
@@ -39,7 +40,7 @@ func TestTransformedCounter_NextCountChunk(test *testing.T) {
 			fields: fields{
 				DistributedCounter: func() DistributedCounter {
 					counter := new(MockDistributedCounter)
-					counter.On("NextCountChunk").Return(uint64(0), iotest.ErrTimeout)
+					counter.On("NextCountChunk", context.Background()).Return(uint64(0), iotest.ErrTimeout)
 
 					return counter
 				}(),
@@ -54,7 +55,7 @@ func TestTransformedCounter_NextCountChunk(test *testing.T) {
 				DistributedCounter: data.fields.DistributedCounter,
 				Transformer:        data.fields.Transformer,
 			}
-			gotChunk, gotErr := counter.NextCountChunk()
+			gotChunk, gotErr := counter.NextCountChunk(context.Background())
 
 			mock.AssertExpectationsForObjects(test, data.fields.DistributedCounter)
 			assert.Equal(test, data.wantChunk, gotChunk)
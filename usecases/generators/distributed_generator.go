@@ -2,7 +2,7 @@ package generators
 
 // nolint: lll
 import (
-	"sync"
+	"context"
 
 	"github.com/pkg/errors"
 	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/counters"
@@ -19,12 +19,63 @@ type DistributedCounterGroup interface {
 // Formatter ...
 type Formatter func(code uint64) string
 
+//go:generate mockery --name=Observer --inpackage --case=underscore --testonly
+
+// Observer ...
+//
+// It's notified about the generator state, e.g. for collecting of metrics.
+// It's called under the generator lock, so it should be fast.
+//
+// A refill is synchronous, if generating of codes waited for a count chunk,
+// i.e. it wasn't prefetched in time.
+//
+type Observer interface {
+	ObserveGeneratedCodes(count int)
+	ObserveRefilledChunk()
+	ObserveSynchronousRefill()
+	ObserveRemainingCodes(count uint64)
+}
+
+// DistributedGeneratorOption ...
+type DistributedGeneratorOption func(generator *DistributedGenerator)
+
+// WithObserver ...
+func WithObserver(observer Observer) DistributedGeneratorOption {
+	return func(generator *DistributedGenerator) {
+		generator.observer = observer
+	}
+}
+
+// WithPrefetchWatermark ...
+//
+// It sets a count of remaining codes, on reaching of which the next count
+// chunk is requested in background. Zero disables prefetching.
+//
+func WithPrefetchWatermark(watermark uint64) DistributedGeneratorOption {
+	return func(generator *DistributedGenerator) {
+		generator.prefetchWatermark = watermark
+	}
+}
+
+type prefetchResult struct {
+	countChunk uint64
+	err        error
+}
+
 // DistributedGenerator ...
+//
+// Its lock is a buffered channel instead of a mutex, so waiting for it
+// can be interrupted by a context, e.g. on client disconnects.
+//
 type DistributedGenerator struct {
-	locker              sync.Mutex
+	locker              chan struct{}
 	counter             counters.ChunkedCounter
 	distributedCounters DistributedCounterGroup
 	formatter           Formatter
+	observer            Observer
+	prefetchWatermark   uint64
+	// it isn't nil, while a prefetched count chunk isn't consumed yet
+	prefetching chan prefetchResult
 }
 
 // NewDistributedGenerator ...
@@ -32,36 +83,165 @@ func NewDistributedGenerator(
 	chunkSize uint64,
 	distributedCounters DistributedCounterGroup,
 	formatter Formatter,
+	options ...DistributedGeneratorOption,
 ) *DistributedGenerator {
-	return &DistributedGenerator{
+	generator := &DistributedGenerator{
+		locker:              make(chan struct{}, 1),
 		counter:             counters.NewChunkedCounter(chunkSize),
 		distributedCounters: distributedCounters,
 		formatter:           formatter,
 	}
+	for _, option := range options {
+		option(generator)
+	}
+
+	return generator
 }
 
 // GenerateCode ...
-func (generator *DistributedGenerator) GenerateCode() (string, error) {
-	generator.locker.Lock()
-	defer generator.locker.Unlock()
+func (generator *DistributedGenerator) GenerateCode(
+	ctx context.Context,
+) (string, error) {
+	if err := generator.lock(ctx); err != nil {
+		return "", err
+	}
+	defer generator.unlock()
 
 	if generator.counter.IsOver() {
-		if err := generator.resetCounter(); err != nil {
+		if err := generator.resetCounter(ctx); err != nil {
 			return "", errors.Wrap(err, "unable to reset the counter")
 		}
 	}
 
 	counter := generator.counter.Increase()
+	generator.prefetch()
+	generator.observeCodes(1)
+
 	return generator.formatter(counter), nil
 }
 
-func (generator *DistributedGenerator) resetCounter() error {
-	countChunk, err :=
-		generator.distributedCounters.SelectCounter().NextCountChunk()
+// GenerateCodes ...
+//
+// It reserves all the codes under a single lock acquisition, so they aren't
+// interleaved with codes generated concurrently.
+//
+func (generator *DistributedGenerator) GenerateCodes(
+	ctx context.Context,
+	count int,
+) ([]string, error) {
+	if err := generator.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer generator.unlock()
+
+	codes := make([]string, 0, count)
+	for len(codes) < count {
+		if generator.counter.IsOver() {
+			if err := generator.resetCounter(ctx); err != nil {
+				return nil, errors.Wrap(err, "unable to reset the counter")
+			}
+		}
+
+		counter := generator.counter.Increase()
+		codes = append(codes, generator.formatter(counter))
+		generator.prefetch()
+	}
+	generator.observeCodes(count)
+
+	return codes, nil
+}
+
+func (generator *DistributedGenerator) lock(ctx context.Context) error {
+	select {
+	case generator.locker <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "unable to acquire the generator lock")
+	}
+}
+
+func (generator *DistributedGenerator) unlock() {
+	<-generator.locker
+}
+
+func (generator *DistributedGenerator) resetCounter(
+	ctx context.Context,
+) error {
+	countChunk, synchronous, err := generator.nextCountChunk(ctx)
 	if err != nil {
 		return errors.Wrap(err, "unable to get the next count chunk")
 	}
 
 	generator.counter.Reset(countChunk)
+	if generator.observer != nil {
+		generator.observer.ObserveRefilledChunk()
+		if synchronous {
+			generator.observer.ObserveSynchronousRefill()
+		}
+	}
+
 	return nil
 }
+
+func (generator *DistributedGenerator) nextCountChunk(
+	ctx context.Context,
+) (countChunk uint64, synchronous bool, err error) {
+	if generator.prefetching != nil {
+		var result prefetchResult
+		select {
+		case result = <-generator.prefetching:
+		default:
+			synchronous = true
+			select {
+			case result = <-generator.prefetching:
+			case <-ctx.Done():
+				// the prefetching goes on, so the next caller may get its result
+				return 0, true, errors.Wrap(
+					ctx.Err(),
+					"unable to wait for the prefetched count chunk",
+				)
+			}
+		}
+
+		generator.prefetching = nil
+		if result.err == nil {
+			return result.countChunk, synchronous, nil
+		}
+
+		// a failure of the prefetching is ignored, because the count chunk
+		// is requested once more below, and it reports a persistent failure
+	}
+
+	countChunk, err =
+		generator.distributedCounters.SelectCounter().NextCountChunk(ctx)
+	return countChunk, true, err
+}
+
+func (generator *DistributedGenerator) prefetch() {
+	if generator.prefetchWatermark == 0 ||
+		generator.prefetching != nil ||
+		generator.counter.Remaining() > generator.prefetchWatermark {
+		return
+	}
+
+	// the counter is selected under the generator lock, because the counter
+	// group isn't required to be safe for concurrent use
+	counter := generator.distributedCounters.SelectCounter()
+	prefetching := make(chan prefetchResult, 1)
+	generator.prefetching = prefetching
+	go func() {
+		// the prefetching outlives the request, which triggered it,
+		// so it isn't bound to its context
+		countChunk, err := counter.NextCountChunk(context.Background())
+		prefetching <- prefetchResult{countChunk: countChunk, err: err}
+	}()
+}
+
+func (generator *DistributedGenerator) observeCodes(count int) {
+	if generator.observer == nil {
+		return
+	}
+
+	generator.observer.ObserveGeneratedCodes(count)
+	generator.observer.ObserveRemainingCodes(generator.counter.Remaining())
+}
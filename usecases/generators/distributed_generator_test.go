@@ -2,13 +2,16 @@ package generators
 
 // nolint: lll
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"math/rand"
 	"reflect"
 	"testing"
 	"testing/iotest"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -95,7 +98,7 @@ func TestDistributedGenerator_GenerateCode(test *testing.T) {
 				}(),
 				distributedCounters: func() DistributedCounterGroup {
 					counter := new(MockDistributedCounter)
-					counter.On("NextCountChunk").Return(uint64(100), nil)
+					counter.On("NextCountChunk", context.Background()).Return(uint64(100), nil)
 
 					group := new(MemorableDistributedCounterGroup)
 					group.On("SelectCounter").Return(counter)
@@ -128,7 +131,7 @@ func TestDistributedGenerator_GenerateCode(test *testing.T) {
 				}(),
 				distributedCounters: func() DistributedCounterGroup {
 					counter := new(MockDistributedCounter)
-					counter.On("NextCountChunk").Return(uint64(0), iotest.ErrTimeout)
+					counter.On("NextCountChunk", context.Background()).Return(uint64(0), iotest.ErrTimeout)
 
 					group := new(MemorableDistributedCounterGroup)
 					group.On("SelectCounter").Return(counter)
@@ -152,11 +155,12 @@ func TestDistributedGenerator_GenerateCode(test *testing.T) {
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			generator := &DistributedGenerator{
+				locker:              make(chan struct{}, 1),
 				counter:             data.fields.counter,
 				distributedCounters: data.fields.distributedCounters,
 				formatter:           data.fields.formatter,
 			}
-			gotCode, gotErr := generator.GenerateCode()
+			gotCode, gotErr := generator.GenerateCode(context.Background())
 
 			mock.AssertExpectationsForObjects(test, data.fields.distributedCounters)
 			counters :=
@@ -171,13 +175,169 @@ func TestDistributedGenerator_GenerateCode(test *testing.T) {
 	}
 }
 
+func TestDistributedGenerator_GenerateCodes(test *testing.T) {
+	type fields struct {
+		counter             counters.ChunkedCounter
+		distributedCounters DistributedCounterGroup
+		formatter           Formatter
+	}
+	type args struct {
+		count int
+	}
+
+	for _, data := range []struct {
+		name        string
+		fields      fields
+		args        args
+		wantCounter counters.ChunkedCounter
+		wantCodes   []string
+		wantErr     assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success within the chunk",
+			fields: fields{
+				counter: func() counters.ChunkedCounter {
+					counter := counters.NewChunkedCounter(3)
+					counter.Reset(42)
+
+					return counter
+				}(),
+				distributedCounters: new(MemorableDistributedCounterGroup),
+				formatter:           func(code uint64) string { return fmt.Sprintf("[%d]", code) },
+			},
+			args: args{count: 2},
+			wantCounter: func() counters.ChunkedCounter {
+				counter := counters.NewChunkedCounter(3)
+				counter.Reset(42)
+				counter.Increase()
+				counter.Increase()
+
+				return counter
+			}(),
+			wantCodes: []string{"[42]", "[43]"},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "success with resetting",
+			fields: fields{
+				counter: func() counters.ChunkedCounter {
+					counter := counters.NewChunkedCounter(2)
+					counter.Reset(42)
+					counter.Increase()
+
+					return counter
+				}(),
+				distributedCounters: func() DistributedCounterGroup {
+					counterOne := new(MockDistributedCounter)
+					counterOne.On("NextCountChunk", context.Background()).Return(uint64(100), nil)
+
+					counterTwo := new(MockDistributedCounter)
+					counterTwo.On("NextCountChunk", context.Background()).Return(uint64(200), nil)
+
+					group := new(MemorableDistributedCounterGroup)
+					group.On("SelectCounter").Return(counterOne).Once()
+					group.On("SelectCounter").Return(counterTwo).Once()
+
+					return group
+				}(),
+				formatter: func(code uint64) string { return fmt.Sprintf("[%d]", code) },
+			},
+			args: args{count: 4},
+			wantCounter: func() counters.ChunkedCounter {
+				counter := counters.NewChunkedCounter(2)
+				counter.Reset(200)
+				counter.Increase()
+
+				return counter
+			}(),
+			wantCodes: []string{"[43]", "[100]", "[101]", "[200]"},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "success without codes",
+			fields: fields{
+				counter: func() counters.ChunkedCounter {
+					counter := counters.NewChunkedCounter(3)
+					counter.Reset(42)
+
+					return counter
+				}(),
+				distributedCounters: new(MemorableDistributedCounterGroup),
+				formatter:           func(code uint64) string { panic("not implemented") },
+			},
+			args: args{count: 0},
+			wantCounter: func() counters.ChunkedCounter {
+				counter := counters.NewChunkedCounter(3)
+				counter.Reset(42)
+
+				return counter
+			}(),
+			wantCodes: []string{},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "error with resetting",
+			fields: fields{
+				counter: func() counters.ChunkedCounter {
+					counter := counters.NewChunkedCounter(2)
+					counter.Reset(42)
+					counter.Increase()
+
+					return counter
+				}(),
+				distributedCounters: func() DistributedCounterGroup {
+					counter := new(MockDistributedCounter)
+					counter.On("NextCountChunk", context.Background()).Return(uint64(0), iotest.ErrTimeout)
+
+					group := new(MemorableDistributedCounterGroup)
+					group.On("SelectCounter").Return(counter)
+
+					return group
+				}(),
+				formatter: func(code uint64) string { return fmt.Sprintf("[%d]", code) },
+			},
+			args: args{count: 2},
+			wantCounter: func() counters.ChunkedCounter {
+				counter := counters.NewChunkedCounter(2)
+				counter.Reset(42)
+				counter.Increase()
+				counter.Increase()
+
+				return counter
+			}(),
+			wantCodes: nil,
+			wantErr:   assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			generator := &DistributedGenerator{
+				locker:              make(chan struct{}, 1),
+				counter:             data.fields.counter,
+				distributedCounters: data.fields.distributedCounters,
+				formatter:           data.fields.formatter,
+			}
+			gotCodes, gotErr := generator.GenerateCodes(context.Background(), data.args.count)
+
+			mock.AssertExpectationsForObjects(test, data.fields.distributedCounters)
+			counters :=
+				data.fields.distributedCounters.(*MemorableDistributedCounterGroup).Counters
+			for _, counter := range counters {
+				mock.AssertExpectationsForObjects(test, counter)
+			}
+			assert.Equal(test, data.wantCounter, generator.counter)
+			assert.Equal(test, data.wantCodes, gotCodes)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
 func TestDistributedGenerator_GenerateCode_bulky(test *testing.T) {
 	var countChunkOne uint64
 	counterOne := new(MockDistributedCounter)
 	counterOne.
-		On("NextCountChunk").
+		On("NextCountChunk", context.Background()).
 		Return(
-			func() uint64 {
+			func(ctx context.Context) uint64 {
 				defer func() { countChunkOne++ }()
 				return countChunkOne * 10
 			},
@@ -187,9 +347,9 @@ func TestDistributedGenerator_GenerateCode_bulky(test *testing.T) {
 	var countChunkTwo uint64
 	counterTwo := new(MockDistributedCounter)
 	counterTwo.
-		On("NextCountChunk").
+		On("NextCountChunk", context.Background()).
 		Return(
-			func() uint64 {
+			func(ctx context.Context) uint64 {
 				defer func() { countChunkTwo++ }()
 				return countChunkTwo*10 + 100
 			},
@@ -200,14 +360,14 @@ func TestDistributedGenerator_GenerateCode_bulky(test *testing.T) {
 		10,
 		counters.CounterGroup{
 			DistributedCounters: []counters.DistributedCounter{counterOne, counterTwo},
-			RandomSource:        rand.New(rand.NewSource(1)).Intn,
+			Strategy:            counters.RandomSource(rand.New(rand.NewSource(1)).Intn),
 		},
 		formatters.InBase62,
 	)
 
 	var gotCodes []uint64
 	for i := 0; i < 100; i++ {
-		code, err := generator.GenerateCode()
+		code, err := generator.GenerateCode(context.Background())
 		require.NoError(test, err)
 
 		parsedCode := new(big.Int)
@@ -266,7 +426,7 @@ func TestDistributedGenerator_resetCounter(test *testing.T) {
 				}(),
 				distributedCounters: func() DistributedCounterGroup {
 					counter := new(MockDistributedCounter)
-					counter.On("NextCountChunk").Return(uint64(100), nil)
+					counter.On("NextCountChunk", context.Background()).Return(uint64(100), nil)
 
 					group := new(MemorableDistributedCounterGroup)
 					group.On("SelectCounter").Return(counter)
@@ -294,7 +454,7 @@ func TestDistributedGenerator_resetCounter(test *testing.T) {
 				}(),
 				distributedCounters: func() DistributedCounterGroup {
 					counter := new(MockDistributedCounter)
-					counter.On("NextCountChunk").Return(uint64(0), iotest.ErrTimeout)
+					counter.On("NextCountChunk", context.Background()).Return(uint64(0), iotest.ErrTimeout)
 
 					group := new(MemorableDistributedCounterGroup)
 					group.On("SelectCounter").Return(counter)
@@ -314,11 +474,12 @@ func TestDistributedGenerator_resetCounter(test *testing.T) {
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			generator := &DistributedGenerator{
+				locker:              make(chan struct{}, 1),
 				counter:             data.fields.counter,
 				distributedCounters: data.fields.distributedCounters,
 				formatter:           data.fields.formatter,
 			}
-			gotErr := generator.resetCounter()
+			gotErr := generator.resetCounter(context.Background())
 
 			mock.AssertExpectationsForObjects(test, data.fields.distributedCounters)
 			counters :=
@@ -332,6 +493,200 @@ func TestDistributedGenerator_resetCounter(test *testing.T) {
 	}
 }
 
+func TestDistributedGenerator_withObserver(test *testing.T) {
+	var countChunk uint64
+	counter := new(MockDistributedCounter)
+	counter.
+		On("NextCountChunk", context.Background()).
+		Return(
+			func(ctx context.Context) uint64 {
+				defer func() { countChunk++ }()
+				return countChunk * 2
+			},
+			nil,
+		)
+
+	group := new(MemorableDistributedCounterGroup)
+	group.On("SelectCounter").Return(counter)
+
+	observer := new(MockObserver)
+	observer.On("ObserveRefilledChunk").Return().Times(2)
+	observer.On("ObserveSynchronousRefill").Return().Times(2)
+	observer.On("ObserveGeneratedCodes", 3).Return().Once()
+	observer.On("ObserveRemainingCodes", uint64(1)).Return().Once()
+	observer.On("ObserveGeneratedCodes", 1).Return().Once()
+	observer.On("ObserveRemainingCodes", uint64(0)).Return().Once()
+
+	generator := NewDistributedGenerator(
+		2,
+		group,
+		func(code uint64) string { return fmt.Sprintf("[%d]", code) },
+		WithObserver(observer),
+	)
+	gotCodes, err := generator.GenerateCodes(context.Background(), 3)
+	require.NoError(test, err)
+	gotCode, err := generator.GenerateCode(context.Background())
+	require.NoError(test, err)
+
+	mock.AssertExpectationsForObjects(test, counter, group, observer)
+	assert.Equal(test, []string{"[0]", "[1]", "[2]"}, gotCodes)
+	assert.Equal(test, "[3]", gotCode)
+}
+
+func TestDistributedGenerator_withPrefetching(test *testing.T) {
+	var countChunk uint64
+	counter := new(MockDistributedCounter)
+	counter.
+		On("NextCountChunk", context.Background()).
+		Return(
+			func(ctx context.Context) uint64 {
+				defer func() { countChunk++ }()
+				return countChunk * 4
+			},
+			nil,
+		).
+		Times(2)
+
+	group := new(MemorableDistributedCounterGroup)
+	group.On("SelectCounter").Return(counter).Times(2)
+
+	observer := new(MockObserver)
+	observer.On("ObserveRefilledChunk").Return().Times(2)
+	// only the first refill waits for the count chunk
+	observer.On("ObserveSynchronousRefill").Return().Once()
+	observer.On("ObserveGeneratedCodes", 1).Return().Times(5)
+	observer.On("ObserveRemainingCodes", mock.Anything).Return().Times(5)
+
+	generator := NewDistributedGenerator(
+		4,
+		group,
+		func(code uint64) string { return fmt.Sprintf("[%d]", code) },
+		WithObserver(observer),
+		WithPrefetchWatermark(2),
+	)
+	require.Equal(test, uint64(2), generator.prefetchWatermark)
+
+	var gotCodes []string
+	for i := 0; i < 5; i++ {
+		code, err := generator.GenerateCode(context.Background())
+		require.NoError(test, err)
+
+		gotCodes = append(gotCodes, code)
+		if i == 1 {
+			// the prefetching has been started on reaching of the watermark
+			require.NotNil(test, generator.prefetching)
+			require.Eventually(test, func() bool {
+				return len(generator.prefetching) == 1
+			}, time.Second, time.Millisecond)
+		}
+	}
+
+	mock.AssertExpectationsForObjects(test, counter, group, observer)
+	assert.Equal(test, []string{"[0]", "[1]", "[2]", "[3]", "[4]"}, gotCodes)
+	// the prefetching will be restarted only on reaching of the watermark
+	assert.Nil(test, generator.prefetching)
+}
+
+func TestDistributedGenerator_withFailedPrefetching(test *testing.T) {
+	counter := new(MockDistributedCounter)
+	counter.On("NextCountChunk", context.Background()).Return(uint64(0), nil).Once()
+	counter.
+		On("NextCountChunk", context.Background()).
+		Return(uint64(0), iotest.ErrTimeout).
+		Once()
+	counter.On("NextCountChunk", context.Background()).Return(uint64(10), nil).Once()
+
+	group := new(MemorableDistributedCounterGroup)
+	group.On("SelectCounter").Return(counter).Times(3)
+
+	generator := NewDistributedGenerator(
+		3,
+		group,
+		func(code uint64) string { return fmt.Sprintf("[%d]", code) },
+		WithPrefetchWatermark(1),
+	)
+
+	var gotCodes []string
+	for i := 0; i < 4; i++ {
+		code, err := generator.GenerateCode(context.Background())
+		require.NoError(test, err)
+
+		gotCodes = append(gotCodes, code)
+		if i == 1 {
+			require.Eventually(test, func() bool {
+				return len(generator.prefetching) == 1
+			}, time.Second, time.Millisecond)
+		}
+	}
+
+	mock.AssertExpectationsForObjects(test, counter, group)
+	assert.Equal(test, []string{"[0]", "[1]", "[2]", "[10]"}, gotCodes)
+}
+
+func TestDistributedGenerator_withInterruptedPrefetching(test *testing.T) {
+	release := make(chan struct{})
+	counter := new(MockDistributedCounter)
+	counter.On("NextCountChunk", context.Background()).Return(uint64(0), nil).Once()
+	counter.
+		On("NextCountChunk", context.Background()).
+		Return(uint64(10), nil).
+		Run(func(mock.Arguments) { <-release }).
+		Once()
+
+	group := new(MemorableDistributedCounterGroup)
+	group.On("SelectCounter").Return(counter).Times(2)
+
+	generator := NewDistributedGenerator(
+		4,
+		group,
+		func(code uint64) string { return fmt.Sprintf("[%d]", code) },
+		WithPrefetchWatermark(2),
+	)
+	for _, wantCode := range []string{"[0]", "[1]", "[2]", "[3]"} {
+		code, err := generator.GenerateCode(context.Background())
+		require.NoError(test, err)
+		require.Equal(test, wantCode, code)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	gotCode, gotErr := generator.GenerateCode(ctx)
+	assert.Empty(test, gotCode)
+	assert.Equal(test, context.DeadlineExceeded, errors.Cause(gotErr))
+
+	// the interrupted prefetching is consumed by the next caller
+	close(release)
+	gotCode, gotErr = generator.GenerateCode(context.Background())
+	assert.NoError(test, gotErr)
+	assert.Equal(test, "[10]", gotCode)
+
+	mock.AssertExpectationsForObjects(test, counter, group)
+}
+
 func getPointer(value interface{}) uintptr {
 	return reflect.ValueOf(value).Pointer()
 }
+
+func TestDistributedGenerator_withInterruptedLock(test *testing.T) {
+	distributedCounters := new(MemorableDistributedCounterGroup)
+	generator := NewDistributedGenerator(
+		23,
+		distributedCounters,
+		func(code uint64) string { panic("not implemented") },
+	)
+
+	// the lock is held as by a generating stuck on a distributed counter
+	generator.locker <- struct{}{}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	gotCode, gotCodeErr := generator.GenerateCode(ctx)
+	gotCodes, gotCodesErr := generator.GenerateCodes(ctx, 2)
+
+	mock.AssertExpectationsForObjects(test, distributedCounters)
+	assert.Empty(test, gotCode)
+	assert.Equal(test, context.DeadlineExceeded, errors.Cause(gotCodeErr))
+	assert.Nil(test, gotCodes)
+	assert.Equal(test, context.DeadlineExceeded, errors.Cause(gotCodesErr))
+}
@@ -2,8 +2,16 @@ package formatters
 
 import (
 	"math/big"
+
+	"github.com/pkg/errors"
 )
 
+// Parser ...
+//
+// It's the inverse of a formatter.
+//
+type Parser func(code string) (uint64, error)
+
 // InBase62 ...
 func InBase62(code uint64) string {
 	var wrappedCode big.Int
@@ -11,3 +19,53 @@ func InBase62(code uint64) string {
 
 	return wrappedCode.Text(62)
 }
+
+// FromBase62 ...
+func FromBase62(code string) (uint64, error) {
+	var wrappedCode big.Int
+	if _, ok := wrappedCode.SetString(code, 62); !ok {
+		return 0, errors.Errorf("the code %q isn't a number in the 62 base", code)
+	}
+	if wrappedCode.Sign() < 0 || !wrappedCode.IsUint64() {
+		return 0, errors.Errorf("the code %q is out of the range", code)
+	}
+
+	return wrappedCode.Uint64(), nil
+}
+
+//go:generate mockery --name=Permutation --inpackage --case=underscore --testonly
+
+// Permutation ...
+type Permutation interface {
+	Permute(value uint64) uint64
+	Restore(value uint64) uint64
+}
+
+// NewPermuted ...
+//
+// It permutes a code before formatting, so sequential codes look random.
+// The permutation should be bijective to keep the codes collision-free.
+//
+func NewPermuted(
+	permutation Permutation,
+	formatter func(code uint64) string,
+) func(code uint64) string {
+	return func(code uint64) string {
+		return formatter(permutation.Permute(code))
+	}
+}
+
+// NewPermutedParser ...
+//
+// It's the inverse of the formatter made by the NewPermuted function.
+//
+func NewPermutedParser(permutation Permutation, parser Parser) Parser {
+	return func(code string) (uint64, error) {
+		permutedCode, err := parser(code)
+		if err != nil {
+			return 0, err
+		}
+
+		return permutation.Restore(permutedCode), nil
+	}
+}
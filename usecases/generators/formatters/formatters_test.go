@@ -1,10 +1,13 @@
 package formatters
 
 import (
+	"fmt"
 	"math"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestInBase62(test *testing.T) {
@@ -40,3 +43,121 @@ func TestInBase62(test *testing.T) {
 		})
 	}
 }
+
+func TestFromBase62(test *testing.T) {
+	type args struct {
+		code string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    uint64
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "regular number",
+			args:    args{"8m0Kx"},
+			want:    123456789,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "minimal number",
+			args:    args{"0"},
+			want:    0,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "maximal number",
+			args:    args{"lYGhA16ahyf"},
+			want:    math.MaxUint64,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error with an invalid character",
+			args:    args{"8m0-x"},
+			want:    0,
+			wantErr: assert.Error,
+		},
+		{
+			name:    "error with a negative number",
+			args:    args{"-8m0Kx"},
+			want:    0,
+			wantErr: assert.Error,
+		},
+		{
+			name:    "error with a too big number",
+			args:    args{"lYGhA16ahyg"},
+			want:    0,
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, gotErr := FromBase62(data.args.code)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func TestNewPermuted(test *testing.T) {
+	permutation := new(MockPermutation)
+	permutation.On("Permute", uint64(23)).Return(uint64(42))
+
+	formatter := NewPermuted(permutation, func(code uint64) string {
+		return fmt.Sprintf("[%d]", code)
+	})
+	got := formatter(23)
+
+	mock.AssertExpectationsForObjects(test, permutation)
+	assert.Equal(test, "[42]", got)
+}
+
+func TestNewPermutedParser(test *testing.T) {
+	for _, data := range []struct {
+		name        string
+		permutation Permutation
+		parserErr   error
+		want        uint64
+		wantErr     assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			permutation: func() Permutation {
+				permutation := new(MockPermutation)
+				permutation.On("Restore", uint64(42)).Return(uint64(23))
+
+				return permutation
+			}(),
+			parserErr: nil,
+			want:      23,
+			wantErr:   assert.NoError,
+		},
+		{
+			name:        "error",
+			permutation: new(MockPermutation),
+			parserErr:   iotest.ErrTimeout,
+			want:        0,
+			wantErr:     assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			parser := NewPermutedParser(
+				data.permutation,
+				func(code string) (uint64, error) {
+					if data.parserErr != nil {
+						return 0, data.parserErr
+					}
+
+					return 42, nil
+				},
+			)
+			got, gotErr := parser("code")
+
+			mock.AssertExpectationsForObjects(test, data.permutation)
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
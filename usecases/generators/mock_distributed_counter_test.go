@@ -2,27 +2,31 @@
 
 package generators
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockDistributedCounter is an autogenerated mock type for the DistributedCounter type
 type MockDistributedCounter struct {
 	mock.Mock
 }
 
-// NextCountChunk provides a mock function with given fields:
-func (_m *MockDistributedCounter) NextCountChunk() (uint64, error) {
-	ret := _m.Called()
+// NextCountChunk provides a mock function with given fields: ctx
+func (_m *MockDistributedCounter) NextCountChunk(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
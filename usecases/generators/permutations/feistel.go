@@ -0,0 +1,118 @@
+package permutations
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"math/bits"
+
+	"github.com/pkg/errors"
+)
+
+// it's enough for the result to look random even with short halves
+const roundCount = 8
+
+// Feistel ...
+//
+// It's a keyed bijective permutation of the range [0, size) based on
+// a balanced Feistel network with HMAC-SHA256 as the round function.
+// The network works on the smallest domain of an even bit length, which
+// contains the range; results out of the range are permuted once more
+// (the cycle walking), so they always remain in the range.
+//
+// Values out of the range are permuted by blocks of the same size, so the
+// permutation is bijective on all the uint64 values, and a count chunk
+// exceeding the range doesn't cause collisions. The last incomplete block
+// is left as is.
+//
+type Feistel struct {
+	key      []byte
+	size     uint64
+	halfBits uint
+	halfMask uint64
+	limit    uint64
+}
+
+// NewFeistel ...
+func NewFeistel(key []byte, size uint64) (Feistel, error) {
+	if len(key) == 0 {
+		return Feistel{}, errors.New("the key is empty")
+	}
+	if size == 0 {
+		return Feistel{}, errors.New("the size is zero")
+	}
+
+	halfBits := uint(bits.Len64(size-1)+1) / 2
+	if halfBits == 0 {
+		halfBits = 1
+	}
+
+	feistel := Feistel{
+		key:      key,
+		size:     size,
+		halfBits: halfBits,
+		halfMask: 1<<halfBits - 1,
+		limit:    math.MaxUint64 / size * size,
+	}
+	return feistel, nil
+}
+
+// Permute ...
+func (feistel Feistel) Permute(value uint64) uint64 {
+	return feistel.walk(value, feistel.encrypt)
+}
+
+// Restore ...
+//
+// It's the inverse of the Permute method.
+//
+func (feistel Feistel) Restore(value uint64) uint64 {
+	return feistel.walk(value, feistel.decrypt)
+}
+
+func (feistel Feistel) walk(
+	value uint64,
+	step func(value uint64) uint64,
+) uint64 {
+	if value >= feistel.limit {
+		return value
+	}
+
+	offset := value % feistel.size
+	base := value - offset
+	for {
+		offset = step(offset)
+		if offset < feistel.size {
+			return base + offset
+		}
+	}
+}
+
+func (feistel Feistel) encrypt(value uint64) uint64 {
+	left, right := value>>feistel.halfBits, value&feistel.halfMask
+	for round := 0; round < roundCount; round++ {
+		left, right = right, left^feistel.function(round, right)
+	}
+
+	return left<<feistel.halfBits | right
+}
+
+func (feistel Feistel) decrypt(value uint64) uint64 {
+	left, right := value>>feistel.halfBits, value&feistel.halfMask
+	for round := roundCount - 1; round >= 0; round-- {
+		left, right = right^feistel.function(round, left), left
+	}
+
+	return left<<feistel.halfBits | right
+}
+
+func (feistel Feistel) function(round int, half uint64) uint64 {
+	var data [9]byte
+	data[0] = byte(round)
+	binary.BigEndian.PutUint64(data[1:], half)
+
+	hash := hmac.New(sha256.New, feistel.key)
+	hash.Write(data[:]) // nolint: errcheck
+	return binary.BigEndian.Uint64(hash.Sum(nil)) & feistel.halfMask
+}
@@ -0,0 +1,131 @@
+package permutations
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeistel(test *testing.T) {
+	for _, data := range []struct {
+		name         string
+		key          []byte
+		size         uint64
+		wantHalfBits uint
+		wantErr      assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "success with an odd bit length",
+			key:          []byte("key"),
+			size:         1000,
+			wantHalfBits: 5,
+			wantErr:      assert.NoError,
+		},
+		{
+			name:         "success with an even bit length",
+			key:          []byte("key"),
+			size:         256,
+			wantHalfBits: 4,
+			wantErr:      assert.NoError,
+		},
+		{
+			name:         "success with the minimal size",
+			key:          []byte("key"),
+			size:         1,
+			wantHalfBits: 1,
+			wantErr:      assert.NoError,
+		},
+		{
+			name:         "success with the maximal size",
+			key:          []byte("key"),
+			size:         math.MaxUint64,
+			wantHalfBits: 32,
+			wantErr:      assert.NoError,
+		},
+		{
+			name:    "error with an empty key",
+			key:     nil,
+			size:    1000,
+			wantErr: assert.Error,
+		},
+		{
+			name:    "error with a zero size",
+			key:     []byte("key"),
+			size:    0,
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, gotErr := NewFeistel(data.key, data.size)
+
+			assert.Equal(test, data.wantHalfBits, got.halfBits)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func TestFeistel(test *testing.T) {
+	for _, data := range []struct {
+		name string
+		size uint64
+	}{
+		{"with the minimal size", 1},
+		{"with a small size", 10},
+		{"with an odd bit length", 1000},
+		{"with an even bit length", 1024},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			feistel, err := NewFeistel([]byte("key"), data.size)
+			require.NoError(test, err)
+
+			// two blocks are checked to cover values out of the range too
+			results := make(map[uint64]struct{})
+			var fixedPointCount uint64
+			for value := uint64(0); value < 2*data.size; value++ {
+				result := feistel.Permute(value)
+				require.Equal(test, value/data.size, result/data.size)
+				require.Equal(test, value, feistel.Restore(result))
+
+				results[result] = struct{}{}
+				if result == value {
+					fixedPointCount++
+				}
+			}
+
+			assert.Len(test, results, int(2*data.size))
+			if data.size >= 1000 {
+				assert.True(test, fixedPointCount < data.size/100)
+			}
+		})
+	}
+}
+
+func TestFeistel_withDifferentKeys(test *testing.T) {
+	feistelOne, err := NewFeistel([]byte("one"), 1000)
+	require.NoError(test, err)
+
+	feistelTwo, err := NewFeistel([]byte("two"), 1000)
+	require.NoError(test, err)
+
+	var sameResultCount int
+	for value := uint64(0); value < 1000; value++ {
+		if feistelOne.Permute(value) == feistelTwo.Permute(value) {
+			sameResultCount++
+		}
+	}
+
+	assert.True(test, sameResultCount < 10)
+}
+
+func TestFeistel_withLastBlock(test *testing.T) {
+	feistel, err := NewFeistel([]byte("key"), 1000)
+	require.NoError(test, err)
+
+	// the last incomplete block is left as is
+	for _, value := range []uint64{math.MaxUint64 - 1, math.MaxUint64} {
+		assert.Equal(test, value, feistel.Permute(value))
+		assert.Equal(test, value, feistel.Restore(value))
+	}
+}
@@ -1,44 +1,308 @@
 package usecases
 
 import (
+	"context"
 	"database/sql"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
 )
 
+//go:generate mockery --name=URLNormalizer --inpackage --case=underscore --testonly
+
+// URLNormalizer ...
+type URLNormalizer interface {
+	NormalizeURL(url string) (string, error)
+}
+
+//go:generate mockery --name=CodeChecker --inpackage --case=underscore --testonly
+
+// CodeChecker ...
+type CodeChecker interface {
+	CheckCode(code string) error
+}
+
 //go:generate mockery --name=CodeGenerator --inpackage --case=underscore --testonly
 
 // CodeGenerator ...
 type CodeGenerator interface {
-	GenerateCode() (string, error)
+	GenerateCode(ctx context.Context) (string, error)
 }
 
 // LinkCreator ...
 type LinkCreator struct {
 	LinkGetter    LinkGetter
 	LinkSetter    LinkSetter
+	URLNormalizer URLNormalizer
+	URLChecker    URLChecker
+	CodeChecker   CodeChecker
 	CodeGenerator CodeGenerator
 }
 
 // CreateLink ...
-func (creator LinkCreator) CreateLink(url string) (entities.Link, error) {
-	link, err := creator.LinkGetter.GetLink(url)
-	switch err {
+//
+// If the link code is specified, it's used as an alias instead of a generated
+// one.
+//
+// The link URL is validated and normalized before any lookups, so equivalent
+// URLs share the same link. Then the normalized URL is checked by the policy
+// of allowed targets. URLs of redirect rules and variants are processed
+// in the same way.
+//
+func (creator LinkCreator) CreateLink(
+	ctx context.Context,
+	link entities.Link,
+) (entities.Link, error) {
+	link, err := creator.prepareLink(link)
+	if err != nil {
+		return entities.Link{}, err
+	}
+
+	existingLink, ok, err := creator.findLink(ctx, link)
+	if err != nil {
+		return entities.Link{}, err
+	}
+	if ok {
+		return existingLink, nil
+	}
+
+	if link.Code != "" {
+		return creator.setLink(ctx, link)
+	}
+
+	for {
+		code, err := creator.CodeGenerator.GenerateCode(ctx)
+		if err != nil {
+			return entities.Link{}, errors.Wrap(err, "unable to generate a code")
+		}
+
+		link.Code = code
+
+		createdLink, err := creator.setLink(ctx, link)
+		// the generated code may be already taken by an alias,
+		// so just try the next one
+		if errors.Cause(err) == entities.ErrLinkConflict {
+			continue
+		}
+
+		return createdLink, err
+	}
+}
+
+func (creator LinkCreator) prepareLink(
+	link entities.Link,
+) (entities.Link, error) {
+	if link.IsExpired(time.Now()) {
+		return entities.Link{}, errors.Wrap(
+			entities.ErrInvalidLink,
+			"the expiration time isn't in the future",
+		)
+	}
+	if link.RedirectCode != 0 && !entities.IsRedirectCode(link.RedirectCode) {
+		return entities.Link{}, errors.Wrapf(
+			entities.ErrInvalidLink,
+			"the redirect code %d isn't supported",
+			link.RedirectCode,
+		)
+	}
+	if link.QueryForwarding != "" &&
+		!entities.IsQueryForwarding(link.QueryForwarding) {
+		return entities.Link{}, errors.Wrapf(
+			entities.ErrInvalidLink,
+			"the query forwarding mode %q isn't supported",
+			link.QueryForwarding,
+		)
+	}
+	if link.Code != "" {
+		if err := creator.CodeChecker.CheckCode(link.Code); err != nil {
+			return entities.Link{}, errors.Wrap(err, "unable to check the code")
+		}
+	}
+
+	normalizedURL, err := creator.URLNormalizer.NormalizeURL(link.URL)
+	if err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to normalize the URL")
+	}
+
+	link.URL = normalizedURL
+	if err := creator.URLChecker.CheckURL(link.URL); err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to check the URL")
+	}
+
+	rules, err := creator.prepareRules(link.Rules)
+	if err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to prepare the rules")
+	}
+
+	link.Rules = rules
+
+	variants, err := creator.prepareVariants(link.Variants)
+	if err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to prepare the variants")
+	}
+
+	link.Variants = variants
+	return link, nil
+}
+
+// it returns false if the link should be created
+func (creator LinkCreator) findLink(
+	ctx context.Context,
+	link entities.Link,
+) (entities.Link, bool, error) {
+	existingLink, err := creator.LinkGetter.GetLink(ctx, link.URL)
+	switch errors.Cause(err) {
 	case nil:
-		return link, nil
-	case sql.ErrNoRows:
+		// the URL has been disabled, so it can't be shortened again
+		if existingLink.Disabled {
+			return entities.Link{}, false, errors.Wrap(
+				entities.ErrLinkDisabled,
+				"the URL already has a disabled link",
+			)
+		}
+		if link.Code != "" && link.Code != existingLink.Code {
+			return entities.Link{}, false, errors.Wrap(
+				entities.ErrLinkConflict,
+				"the URL already has another code",
+			)
+		}
+		// the existing link would be returned instead of the requested one,
+		// so the request options would be silently ignored
+		if !link.HasSameOptions(existingLink) {
+			return entities.Link{}, false, errors.Wrap(
+				entities.ErrLinkConflict,
+				"the URL already has a link with other options",
+			)
+		}
+
+		return existingLink, true, nil
+	// the expired link will be replaced
+	case sql.ErrNoRows, entities.ErrLinkExpired:
+		return entities.Link{}, false, nil
 	default:
-		return entities.Link{}, errors.Wrap(err, "unable to get the link")
+		return entities.Link{}, false, errors.Wrap(err, "unable to get the link")
+	}
+}
+
+func (creator LinkCreator) prepareRules(
+	rules []entities.RedirectRule,
+) ([]entities.RedirectRule, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	// the rules are copied to avoid modifying the passed link
+	preparedRules := make([]entities.RedirectRule, 0, len(rules))
+	for index, rule := range rules {
+		if rule.StartTime != nil && rule.EndTime != nil &&
+			!rule.StartTime.Before(*rule.EndTime) {
+			return nil, errors.Wrapf(
+				entities.ErrInvalidLink,
+				"the time window of the rule #%d is empty",
+				index+1,
+			)
+		}
+
+		preparedURL, err := creator.prepareURL(rule.URL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to prepare the rule #%d", index+1)
+		}
+
+		rule.URL = preparedURL
+		preparedRules = append(preparedRules, rule)
+	}
+
+	return preparedRules, nil
+}
+
+func (creator LinkCreator) prepareVariants(
+	variants []entities.Variant,
+) ([]entities.Variant, error) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+
+	// the variants are copied to avoid modifying the passed link
+	preparedVariants := make([]entities.Variant, 0, len(variants))
+	names := make(map[string]struct{})
+	for index, variant := range variants {
+		// names are stored in cookies, so they should be safe for them
+		if !isVariantName(variant.Name) {
+			return nil, errors.Wrapf(
+				entities.ErrInvalidLink,
+				"the variant #%d has an incorrect name",
+				index+1,
+			)
+		}
+		if _, ok := names[variant.Name]; ok {
+			return nil, errors.Wrapf(
+				entities.ErrInvalidLink,
+				"the variant name %q is duplicated",
+				variant.Name,
+			)
+		}
+		if variant.Weight <= 0 {
+			return nil, errors.Wrapf(
+				entities.ErrInvalidLink,
+				"the weight of the variant %q isn't positive",
+				variant.Name,
+			)
+		}
+
+		preparedURL, err := creator.prepareURL(variant.URL)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"unable to prepare the variant %q",
+				variant.Name,
+			)
+		}
+
+		variant.URL = preparedURL
+		names[variant.Name] = struct{}{}
+		preparedVariants = append(preparedVariants, variant)
+	}
+
+	return preparedVariants, nil
+}
+
+func isVariantName(name string) bool {
+	if name == "" {
+		return false
 	}
 
-	code, err := creator.CodeGenerator.GenerateCode()
+	for _, symbol := range name {
+		isAllowed := (symbol >= '0' && symbol <= '9') ||
+			(symbol >= 'a' && symbol <= 'z') ||
+			(symbol >= 'A' && symbol <= 'Z') ||
+			symbol == '-' || symbol == '_' || symbol == '.'
+		if !isAllowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (creator LinkCreator) prepareURL(url string) (string, error) {
+	normalizedURL, err := creator.URLNormalizer.NormalizeURL(url)
 	if err != nil {
-		return entities.Link{}, errors.Wrap(err, "unable to generate a code")
+		return "", errors.Wrap(err, "unable to normalize the URL")
 	}
 
-	link = entities.Link{Code: code, URL: url}
-	if err := creator.LinkSetter.SetLink(link); err != nil {
+	if err := creator.URLChecker.CheckURL(normalizedURL); err != nil {
+		return "", errors.Wrap(err, "unable to check the URL")
+	}
+
+	return normalizedURL, nil
+}
+
+func (creator LinkCreator) setLink(
+	ctx context.Context,
+	link entities.Link,
+) (entities.Link, error) {
+	if err := creator.LinkSetter.SetLink(ctx, link); err != nil {
 		return entities.Link{}, errors.Wrap(err, "unable to set the link")
 	}
 
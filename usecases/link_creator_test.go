@@ -1,10 +1,14 @@
 package usecases
 
 import (
+	"context"
 	"database/sql"
+	"net/http"
 	"testing"
 	"testing/iotest"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
@@ -14,10 +18,13 @@ func TestLinkCreator_CreateLink(test *testing.T) {
 	type fields struct {
 		LinkGetter    LinkGetter
 		LinkSetter    LinkSetter
+		URLNormalizer URLNormalizer
+		URLChecker    URLChecker
+		CodeChecker   CodeChecker
 		CodeGenerator CodeGenerator
 	}
 	type args struct {
-		url string
+		link entities.Link
 	}
 
 	for _, data := range []struct {
@@ -33,57 +40,503 @@ func TestLinkCreator_CreateLink(test *testing.T) {
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
 					getter.
-						On("GetLink", "url").
+						On("GetLink", context.Background(), "url").
 						Return(entities.Link{Code: "code", URL: "url"}, nil)
 
 					return getter
 				}(),
-				LinkSetter:    new(MockLinkSetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
 				CodeGenerator: new(MockCodeGenerator),
 			},
-			args:     args{"url"},
+			args:     args{entities.Link{URL: "url"}},
 			wantLink: entities.Link{Code: "code", URL: "url"},
 			wantErr:  assert.NoError,
 		},
+		{
+			name: "error with the getter and a disabled link",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{Code: "code", URL: "url", Disabled: true}, nil)
+
+					return getter
+				}(),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{URL: "url"}},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkDisabled, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "success with the getter and the same options",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{
+							Code:         "code",
+							URL:          "url",
+							RedirectCode: http.StatusFound,
+						}, nil)
+
+					return getter
+				}(),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{URL: "url", RedirectCode: http.StatusFound},
+			},
+			wantLink: entities.Link{
+				Code:         "code",
+				URL:          "url",
+				RedirectCode: http.StatusFound,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with the getter and a nanosecond-precision expiration time",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					// MongoDB returns times with the millisecond precision
+					expirationTime :=
+						time.Date(2106, time.January, 2, 15, 4, 5, 123000000, time.UTC)
+
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{
+							Code:           "code",
+							URL:            "url",
+							ExpirationTime: &expirationTime,
+						}, nil)
+
+					return getter
+				}(),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{
+					URL: "url",
+					ExpirationTime: func() *time.Time {
+						expirationTime :=
+							time.Date(2106, time.January, 2, 15, 4, 5, 123456789, time.UTC)
+						return &expirationTime
+					}(),
+				},
+			},
+			wantLink: entities.Link{
+				Code: "code",
+				URL:  "url",
+				ExpirationTime: func() *time.Time {
+					expirationTime :=
+						time.Date(2106, time.January, 2, 15, 4, 5, 123000000, time.UTC)
+					return &expirationTime
+				}(),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error with the getter and other options",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{URL: "url", RedirectCode: http.StatusFound},
+			},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkConflict, errors.Cause(err), args)
+			},
+		},
 		{
 			name: "success with the setter",
 			fields: fields{
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
-					getter.On("GetLink", "url").Return(entities.Link{}, sql.ErrNoRows)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).Return(nil)
+
+					return setter
+				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: new(MockCodeChecker),
+				CodeGenerator: func() CodeGenerator {
+					generator := new(MockCodeGenerator)
+					generator.On("GenerateCode", context.Background()).Return("code", nil)
+
+					return generator
+				}(),
+			},
+			args:     args{entities.Link{URL: "url"}},
+			wantLink: entities.Link{Code: "code", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with the setter and a redirect code",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url", RedirectCode: http.StatusFound}).Return(nil)
+
+					return setter
+				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: new(MockCodeChecker),
+				CodeGenerator: func() CodeGenerator {
+					generator := new(MockCodeGenerator)
+					generator.On("GenerateCode", context.Background()).Return("code", nil)
+
+					return generator
+				}(),
+			},
+			args:     args{entities.Link{URL: "url", RedirectCode: http.StatusFound}},
+			wantLink: entities.Link{Code: "code", URL: "url", RedirectCode: http.StatusFound},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with the setter and forwarding",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url", QueryForwarding: entities.MergeQueryForwarding, PathForwarding: true}).Return(nil)
+
+					return setter
+				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: new(MockCodeChecker),
+				CodeGenerator: func() CodeGenerator {
+					generator := new(MockCodeGenerator)
+					generator.On("GenerateCode", context.Background()).Return("code", nil)
+
+					return generator
+				}(),
+			},
+			args:     args{entities.Link{URL: "url", QueryForwarding: entities.MergeQueryForwarding, PathForwarding: true}},
+			wantLink: entities.Link{Code: "code", URL: "url", QueryForwarding: entities.MergeQueryForwarding, PathForwarding: true},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with the setter and an expired link",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(
+							entities.Link{},
+							errors.Wrap(entities.ErrLinkExpired, "unable to get the link"),
+						)
 
 					return getter
 				}(),
 				LinkSetter: func() LinkSetter {
 					setter := new(MockLinkSetter)
-					setter.On("SetLink", entities.Link{Code: "code", URL: "url"}).Return(nil)
+					setter.On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).Return(nil)
 
 					return setter
 				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: new(MockCodeChecker),
 				CodeGenerator: func() CodeGenerator {
 					generator := new(MockCodeGenerator)
-					generator.On("GenerateCode").Return("code", nil)
+					generator.On("GenerateCode", context.Background()).Return("code", nil)
 
 					return generator
 				}(),
 			},
-			args:     args{"url"},
+			args:     args{entities.Link{URL: "url"}},
 			wantLink: entities.Link{Code: "code", URL: "url"},
 			wantErr:  assert.NoError,
 		},
+		{
+			name: "success with the setter and a code conflict",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.
+						On("SetLink", context.Background(), entities.Link{Code: "code #1", URL: "url"}).
+						Return(errors.Wrap(entities.ErrLinkConflict, "unable to set the link"))
+					setter.
+						On("SetLink", context.Background(), entities.Link{Code: "code #2", URL: "url"}).
+						Return(nil)
+
+					return setter
+				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: new(MockCodeChecker),
+				CodeGenerator: func() CodeGenerator {
+					generator := new(MockCodeGenerator)
+					generator.On("GenerateCode", context.Background()).Return("code #1", nil).Once()
+					generator.On("GenerateCode", context.Background()).Return("code #2", nil).Once()
+
+					return generator
+				}(),
+			},
+			args:     args{entities.Link{URL: "url"}},
+			wantLink: entities.Link{Code: "code #2", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with the alias and the getter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{Code: "alias", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: func() CodeChecker {
+					checker := new(MockCodeChecker)
+					checker.On("CheckCode", "alias").Return(nil)
+
+					return checker
+				}(),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{Code: "alias", URL: "url"}},
+			wantLink: entities.Link{Code: "alias", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with the alias and the setter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.On("SetLink", context.Background(), entities.Link{Code: "alias", URL: "url"}).Return(nil)
+
+					return setter
+				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: func() CodeChecker {
+					checker := new(MockCodeChecker)
+					checker.On("CheckCode", "alias").Return(nil)
+
+					return checker
+				}(),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{Code: "alias", URL: "url"}},
+			wantLink: entities.Link{Code: "alias", URL: "url"},
+			wantErr:  assert.NoError,
+		},
 		{
 			name: "error with the getter",
 			fields: fields{
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
-					getter.On("GetLink", "url").Return(entities.Link{}, iotest.ErrTimeout)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, iotest.ErrTimeout)
 
 					return getter
 				}(),
-				LinkSetter:    new(MockLinkSetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
 				CodeGenerator: new(MockCodeGenerator),
 			},
-			args:     args{"url"},
+			args:     args{entities.Link{URL: "url"}},
 			wantLink: entities.Link{},
 			wantErr:  assert.Error,
 		},
@@ -92,19 +545,32 @@ func TestLinkCreator_CreateLink(test *testing.T) {
 			fields: fields{
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
-					getter.On("GetLink", "url").Return(entities.Link{}, sql.ErrNoRows)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
 
 					return getter
 				}(),
 				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: new(MockCodeChecker),
 				CodeGenerator: func() CodeGenerator {
 					generator := new(MockCodeGenerator)
-					generator.On("GenerateCode").Return("", iotest.ErrTimeout)
+					generator.On("GenerateCode", context.Background()).Return("", iotest.ErrTimeout)
 
 					return generator
 				}(),
 			},
-			args:     args{"url"},
+			args:     args{entities.Link{URL: "url"}},
 			wantLink: entities.Link{},
 			wantErr:  assert.Error,
 		},
@@ -113,42 +579,739 @@ func TestLinkCreator_CreateLink(test *testing.T) {
 			fields: fields{
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
-					getter.On("GetLink", "url").Return(entities.Link{}, sql.ErrNoRows)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
 
 					return getter
 				}(),
 				LinkSetter: func() LinkSetter {
 					setter := new(MockLinkSetter)
 					setter.
-						On("SetLink", entities.Link{Code: "code", URL: "url"}).
+						On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
 						Return(iotest.ErrTimeout)
 
 					return setter
 				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: new(MockCodeChecker),
 				CodeGenerator: func() CodeGenerator {
 					generator := new(MockCodeGenerator)
-					generator.On("GenerateCode").Return("code", nil)
+					generator.On("GenerateCode", context.Background()).Return("code", nil)
 
 					return generator
 				}(),
 			},
-			args:     args{"url"},
+			args:     args{entities.Link{URL: "url"}},
 			wantLink: entities.Link{},
 			wantErr:  assert.Error,
 		},
+		{
+			name: "error with an expiration time in the past",
+			fields: fields{
+				LinkGetter:    new(MockLinkGetter),
+				LinkSetter:    new(MockLinkSetter),
+				URLNormalizer: new(MockURLNormalizer),
+				URLChecker:    new(MockURLChecker),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				link: entities.Link{
+					URL: "url",
+					ExpirationTime: func() *time.Time {
+						expirationTime := time.Now().Add(-time.Minute)
+						return &expirationTime
+					}(),
+				},
+			},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with an unsupported redirect code",
+			fields: fields{
+				LinkGetter:    new(MockLinkGetter),
+				LinkSetter:    new(MockLinkSetter),
+				URLNormalizer: new(MockURLNormalizer),
+				URLChecker:    new(MockURLChecker),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{URL: "url", RedirectCode: http.StatusSeeOther}},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with an unsupported query forwarding mode",
+			fields: fields{
+				LinkGetter:    new(MockLinkGetter),
+				LinkSetter:    new(MockLinkSetter),
+				URLNormalizer: new(MockURLNormalizer),
+				URLChecker:    new(MockURLChecker),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{URL: "url", QueryForwarding: "unknown"}},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the alias checker",
+			fields: fields{
+				LinkGetter:    new(MockLinkGetter),
+				LinkSetter:    new(MockLinkSetter),
+				URLNormalizer: new(MockURLNormalizer),
+				URLChecker:    new(MockURLChecker),
+				CodeChecker: func() CodeChecker {
+					checker := new(MockCodeChecker)
+					checker.
+						On("CheckCode", "alias").
+						Return(errors.Wrap(entities.ErrInvalidLink, "the code is too short"))
+
+					return checker
+				}(),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{Code: "alias", URL: "url"}},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the alias and the getter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: func() CodeChecker {
+					checker := new(MockCodeChecker)
+					checker.On("CheckCode", "alias").Return(nil)
+
+					return checker
+				}(),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{Code: "alias", URL: "url"}},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkConflict, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the alias and the setter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.
+						On("SetLink", context.Background(), entities.Link{Code: "alias", URL: "url"}).
+						Return(errors.Wrap(entities.ErrLinkConflict, "unable to set the link"))
+
+					return setter
+				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: func() CodeChecker {
+					checker := new(MockCodeChecker)
+					checker.On("CheckCode", "alias").Return(nil)
+
+					return checker
+				}(),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{Code: "alias", URL: "url"}},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkConflict, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "success with normalizing of the URL",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "http://example.com/").
+						Return(entities.Link{Code: "code", URL: "http://example.com/"}, nil)
+
+					return getter
+				}(),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.
+						On("NormalizeURL", "HTTP://Example.com:80").
+						Return("http://example.com/", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "http://example.com/").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{URL: "HTTP://Example.com:80"}},
+			wantLink: entities.Link{Code: "code", URL: "http://example.com/"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "error with the URL normalizer",
+			fields: fields{
+				LinkGetter: new(MockLinkGetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.
+						On("NormalizeURL", "javascript:alert(1)").
+						Return("", errors.Wrap(entities.ErrInvalidLink, "the URL is invalid"))
+
+					return normalizer
+				}(),
+				URLChecker:    new(MockURLChecker),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{URL: "javascript:alert(1)"}},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the URL checker",
+			fields: fields{
+				LinkGetter: new(MockLinkGetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.
+						On("NormalizeURL", "http://127.0.0.1/").
+						Return("http://127.0.0.1/", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.
+						On("CheckURL", "http://127.0.0.1/").
+						Return(errors.Wrap(entities.ErrInvalidLink, "the URL is forbidden"))
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args:     args{entities.Link{URL: "http://127.0.0.1/"}},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "success with rules",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.
+						On("SetLink", context.Background(), entities.Link{
+							Code: "code",
+							URL:  "url",
+							Rules: []entities.RedirectRule{
+								{OSes: []string{"ios"}, URL: "http://example.com/"},
+							},
+						}).
+						Return(nil)
+
+					return setter
+				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+					normalizer.
+						On("NormalizeURL", "HTTP://Example.com:80").
+						Return("http://example.com/", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.On("CheckURL", "http://example.com/").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: new(MockCodeChecker),
+				CodeGenerator: func() CodeGenerator {
+					generator := new(MockCodeGenerator)
+					generator.On("GenerateCode", context.Background()).Return("code", nil)
+
+					return generator
+				}(),
+			},
+			args: args{
+				entities.Link{
+					URL: "url",
+					Rules: []entities.RedirectRule{
+						{OSes: []string{"ios"}, URL: "HTTP://Example.com:80"},
+					},
+				},
+			},
+			wantLink: entities.Link{
+				Code: "code",
+				URL:  "url",
+				Rules: []entities.RedirectRule{
+					{OSes: []string{"ios"}, URL: "http://example.com/"},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error with an empty time window of a rule",
+			fields: fields{
+				LinkGetter: new(MockLinkGetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{
+					URL: "url",
+					Rules: []entities.RedirectRule{
+						{
+							StartTime: func() *time.Time {
+								startTime := time.Date(2006, time.January, 3, 15, 4, 5, 0, time.UTC)
+								return &startTime
+							}(),
+							EndTime: func() *time.Time {
+								endTime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+								return &endTime
+							}(),
+							URL: "url #1",
+						},
+					},
+				},
+			},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the URL normalizer and a rule",
+			fields: fields{
+				LinkGetter: new(MockLinkGetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+					normalizer.
+						On("NormalizeURL", "javascript:alert(1)").
+						Return("", errors.Wrap(entities.ErrInvalidLink, "the URL is invalid"))
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{
+					URL:   "url",
+					Rules: []entities.RedirectRule{{URL: "javascript:alert(1)"}},
+				},
+			},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the URL checker and a rule",
+			fields: fields{
+				LinkGetter: new(MockLinkGetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+					normalizer.
+						On("NormalizeURL", "http://127.0.0.1/").
+						Return("http://127.0.0.1/", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.
+						On("CheckURL", "http://127.0.0.1/").
+						Return(errors.Wrap(entities.ErrInvalidLink, "the URL is forbidden"))
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{
+					URL:   "url",
+					Rules: []entities.RedirectRule{{URL: "http://127.0.0.1/"}},
+				},
+			},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "success with variants",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.
+						On("SetLink", context.Background(), entities.Link{
+							Code: "code",
+							URL:  "url",
+							Variants: []entities.Variant{
+								{Name: "a", URL: "http://example.com/a", Weight: 3},
+								{Name: "b", URL: "http://example.com/b", Weight: 1},
+							},
+						}).
+						Return(nil)
+
+					return setter
+				}(),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+					normalizer.
+						On("NormalizeURL", "HTTP://Example.com/a").
+						Return("http://example.com/a", nil)
+					normalizer.
+						On("NormalizeURL", "HTTP://Example.com/b").
+						Return("http://example.com/b", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.On("CheckURL", "http://example.com/a").Return(nil)
+					checker.On("CheckURL", "http://example.com/b").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker: new(MockCodeChecker),
+				CodeGenerator: func() CodeGenerator {
+					generator := new(MockCodeGenerator)
+					generator.On("GenerateCode", context.Background()).Return("code", nil)
+
+					return generator
+				}(),
+			},
+			args: args{
+				entities.Link{
+					URL: "url",
+					Variants: []entities.Variant{
+						{Name: "a", URL: "HTTP://Example.com/a", Weight: 3},
+						{Name: "b", URL: "HTTP://Example.com/b", Weight: 1},
+					},
+				},
+			},
+			wantLink: entities.Link{
+				Code: "code",
+				URL:  "url",
+				Variants: []entities.Variant{
+					{Name: "a", URL: "http://example.com/a", Weight: 3},
+					{Name: "b", URL: "http://example.com/b", Weight: 1},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error with a variant without a name",
+			fields: fields{
+				LinkGetter: new(MockLinkGetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+					normalizer.On("NormalizeURL", "url #1").Return("url #1", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.On("CheckURL", "url #1").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{
+					URL: "url",
+					Variants: []entities.Variant{
+						{Name: "a", URL: "url #1", Weight: 1},
+						{Name: "", URL: "url #2", Weight: 1},
+					},
+				},
+			},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with a variant with an incorrect name",
+			fields: fields{
+				LinkGetter: new(MockLinkGetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+					normalizer.On("NormalizeURL", "url #1").Return("url #1", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.On("CheckURL", "url #1").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{
+					URL: "url",
+					Variants: []entities.Variant{
+						{Name: "a", URL: "url #1", Weight: 1},
+						{Name: "variant #2", URL: "url #2", Weight: 1},
+					},
+				},
+			},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with a duplicated variant name",
+			fields: fields{
+				LinkGetter: new(MockLinkGetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+					normalizer.On("NormalizeURL", "url #1").Return("url #1", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.On("CheckURL", "url #1").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{
+					URL: "url",
+					Variants: []entities.Variant{
+						{Name: "a", URL: "url #1", Weight: 1},
+						{Name: "a", URL: "url #2", Weight: 1},
+					},
+				},
+			},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with a non-positive variant weight",
+			fields: fields{
+				LinkGetter: new(MockLinkGetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+					normalizer.On("NormalizeURL", "url #1").Return("url #1", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.On("CheckURL", "url #1").Return(nil)
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{
+					URL: "url",
+					Variants: []entities.Variant{
+						{Name: "a", URL: "url #1", Weight: 1},
+						{Name: "b", URL: "url #2", Weight: 0},
+					},
+				},
+			},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the URL checker and a variant",
+			fields: fields{
+				LinkGetter: new(MockLinkGetter),
+				LinkSetter: new(MockLinkSetter),
+				URLNormalizer: func() URLNormalizer {
+					normalizer := new(MockURLNormalizer)
+					normalizer.On("NormalizeURL", "url").Return("url", nil)
+					normalizer.
+						On("NormalizeURL", "http://127.0.0.1/").
+						Return("http://127.0.0.1/", nil)
+
+					return normalizer
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.
+						On("CheckURL", "http://127.0.0.1/").
+						Return(errors.Wrap(entities.ErrInvalidLink, "the URL is forbidden"))
+
+					return checker
+				}(),
+				CodeChecker:   new(MockCodeChecker),
+				CodeGenerator: new(MockCodeGenerator),
+			},
+			args: args{
+				entities.Link{
+					URL: "url",
+					Variants: []entities.Variant{
+						{Name: "a", URL: "http://127.0.0.1/", Weight: 1},
+					},
+				},
+			},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+			},
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			creator := LinkCreator{
 				LinkGetter:    data.fields.LinkGetter,
 				LinkSetter:    data.fields.LinkSetter,
+				URLNormalizer: data.fields.URLNormalizer,
+				URLChecker:    data.fields.URLChecker,
+				CodeChecker:   data.fields.CodeChecker,
 				CodeGenerator: data.fields.CodeGenerator,
 			}
-			gotLink, gotErr := creator.CreateLink(data.args.url)
+			gotLink, gotErr := creator.CreateLink(context.Background(), data.args.link)
 
 			mock.AssertExpectationsForObjects(
 				test,
 				data.fields.LinkGetter,
 				data.fields.LinkSetter,
+				data.fields.URLNormalizer,
+				data.fields.URLChecker,
+				data.fields.CodeChecker,
 				data.fields.CodeGenerator,
 			)
 			assert.Equal(test, data.wantLink, gotLink)
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=LinkDeleter --inpackage --case=underscore --testonly
+
+// LinkDeleter ...
+type LinkDeleter interface {
+	DeleteLink(ctx context.Context, link entities.Link) error
+}
+
+// LinkDeleterGroup ...
+type LinkDeleterGroup []LinkDeleter
+
+// DeleteLink ...
+func (deleters LinkDeleterGroup) DeleteLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	for _, deleter := range deleters {
+		if err := deleter.DeleteLink(ctx, link); err != nil {
+			return errors.Wrap(err, "unable to delete the link")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkDeleterGroup_DeleteLink(test *testing.T) {
+	type args struct {
+		link entities.Link
+	}
+
+	for _, data := range []struct {
+		name     string
+		deleters LinkDeleterGroup
+		args     args
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "success without deleters",
+			deleters: nil,
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with deleters",
+			deleters: func() LinkDeleterGroup {
+				deleterOne := new(MockLinkDeleter)
+				deleterOne.
+					On("DeleteLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+					Return(nil)
+
+				deleterTwo := new(MockLinkDeleter)
+				deleterTwo.
+					On("DeleteLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+					Return(nil)
+
+				return LinkDeleterGroup{deleterOne, deleterTwo}
+			}(),
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error with the first deleter",
+			deleters: func() LinkDeleterGroup {
+				deleterOne := new(MockLinkDeleter)
+				deleterOne.
+					On("DeleteLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+					Return(iotest.ErrTimeout)
+
+				deleterTwo := new(MockLinkDeleter)
+
+				return LinkDeleterGroup{deleterOne, deleterTwo}
+			}(),
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotErr := data.deleters.DeleteLink(context.Background(), data.args.link)
+
+			for _, deleter := range data.deleters {
+				mock.AssertExpectationsForObjects(test, deleter)
+			}
+			data.wantErr(test, gotErr)
+		})
+	}
+}
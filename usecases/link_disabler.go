@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkDisabler ...
+type LinkDisabler struct {
+	LinkGetter  LinkGetter
+	LinkUpdater LinkUpdater
+}
+
+// DisableLink ...
+//
+// It allows both disabling and enabling of the link, depending
+// on the passed flag.
+//
+func (disabler LinkDisabler) DisableLink(
+	ctx context.Context,
+	code string,
+	disabled bool,
+) (entities.Link, error) {
+	link, err := disabler.LinkGetter.GetLink(ctx, code)
+	if err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to get the link")
+	}
+
+	link.Disabled = disabled
+	if err := disabler.LinkUpdater.UpdateLink(ctx, link); err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to update the link")
+	}
+
+	return link, nil
+}
@@ -0,0 +1,139 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkDisabler_DisableLink(test *testing.T) {
+	type fields struct {
+		LinkGetter  LinkGetter
+		LinkUpdater LinkUpdater
+	}
+	type args struct {
+		code     string
+		disabled bool
+	}
+
+	for _, data := range []struct {
+		name     string
+		fields   fields
+		args     args
+		wantLink entities.Link
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success with disabling",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "code").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkUpdater: func() LinkUpdater {
+					updater := new(MockLinkUpdater)
+					updater.
+						On("UpdateLink", context.Background(), entities.Link{Code: "code", URL: "url", Disabled: true}).
+						Return(nil)
+
+					return updater
+				}(),
+			},
+			args:     args{code: "code", disabled: true},
+			wantLink: entities.Link{Code: "code", URL: "url", Disabled: true},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with enabling",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "code").
+						Return(entities.Link{Code: "code", URL: "url", Disabled: true}, nil)
+
+					return getter
+				}(),
+				LinkUpdater: func() LinkUpdater {
+					updater := new(MockLinkUpdater)
+					updater.
+						On("UpdateLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+						Return(nil)
+
+					return updater
+				}(),
+			},
+			args:     args{code: "code", disabled: false},
+			wantLink: entities.Link{Code: "code", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "error with the getter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "code").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkUpdater: new(MockLinkUpdater),
+			},
+			args:     args{code: "code", disabled: true},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the updater",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "code").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkUpdater: func() LinkUpdater {
+					updater := new(MockLinkUpdater)
+					updater.
+						On("UpdateLink", context.Background(), entities.Link{Code: "code", URL: "url", Disabled: true}).
+						Return(iotest.ErrTimeout)
+
+					return updater
+				}(),
+			},
+			args:     args{code: "code", disabled: true},
+			wantLink: entities.Link{},
+			wantErr:  assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			disabler := LinkDisabler{
+				LinkGetter:  data.fields.LinkGetter,
+				LinkUpdater: data.fields.LinkUpdater,
+			}
+			gotLink, gotErr :=
+				disabler.DisableLink(context.Background(), data.args.code, data.args.disabled)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkGetter,
+				data.fields.LinkUpdater,
+			)
+			assert.Equal(test, data.wantLink, gotLink)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=LinkIterator --inpackage --case=underscore --testonly
+
+// LinkIterator ...
+//
+// It should skip expired links.
+//
+type LinkIterator interface {
+	IterateLinks(
+		ctx context.Context,
+		handler func(link entities.Link) error,
+	) error
+}
+
+// LinkExporter ...
+type LinkExporter struct {
+	LinkIterator LinkIterator
+	LinkSetter   LinkSetter
+}
+
+// ExportLinks ...
+//
+// The link setter receives all the exported links too, so it may be used
+// for warming of a cache.
+//
+func (exporter LinkExporter) ExportLinks(
+	ctx context.Context,
+	handler func(link entities.Link) error,
+) error {
+	exportLink := func(link entities.Link) error {
+		if err := exporter.LinkSetter.SetLink(ctx, link); err != nil {
+			return errors.Wrap(err, "unable to set the link")
+		}
+
+		if err := handler(link); err != nil {
+			return errors.Wrap(err, "unable to handle the link")
+		}
+
+		return nil
+	}
+	err := exporter.LinkIterator.IterateLinks(ctx, exportLink)
+	if err != nil {
+		return errors.Wrap(err, "unable to iterate over the links")
+	}
+
+	return nil
+}
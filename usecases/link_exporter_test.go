@@ -0,0 +1,134 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkExporter_ExportLinks(test *testing.T) {
+	type fields struct {
+		LinkIterator LinkIterator
+		LinkSetter   LinkSetter
+	}
+	type args struct {
+		handlerErr error
+	}
+
+	links := []entities.Link{
+		{Code: "code #1", URL: "url #1"},
+		{Code: "code #2", URL: "url #2"},
+	}
+	newLinkIterator := func() LinkIterator {
+		iterator := new(MockLinkIterator)
+		iterator.
+			On("IterateLinks", context.Background(), mock.AnythingOfType("func(entities.Link) error")).
+			Return(func(ctx context.Context, handler func(link entities.Link) error) error {
+				for _, link := range links {
+					if err := handler(link); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+
+		return iterator
+	}
+	for _, data := range []struct {
+		name      string
+		fields    fields
+		args      args
+		wantLinks []entities.Link
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				LinkIterator: newLinkIterator(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					for _, link := range links {
+						setter.On("SetLink", context.Background(), link).Return(nil)
+					}
+
+					return setter
+				}(),
+			},
+			args:      args{handlerErr: nil},
+			wantLinks: links,
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "error with the iterator",
+			fields: fields{
+				LinkIterator: func() LinkIterator {
+					iterator := new(MockLinkIterator)
+					iterator.
+						On("IterateLinks", context.Background(), mock.AnythingOfType("func(entities.Link) error")).
+						Return(iotest.ErrTimeout)
+
+					return iterator
+				}(),
+				LinkSetter: new(MockLinkSetter),
+			},
+			args:      args{handlerErr: nil},
+			wantLinks: nil,
+			wantErr:   assert.Error,
+		},
+		{
+			name: "error with the setter",
+			fields: fields{
+				LinkIterator: newLinkIterator(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.On("SetLink", context.Background(), links[0]).Return(iotest.ErrTimeout)
+
+					return setter
+				}(),
+			},
+			args:      args{handlerErr: nil},
+			wantLinks: nil,
+			wantErr:   assert.Error,
+		},
+		{
+			name: "error with the handler",
+			fields: fields{
+				LinkIterator: newLinkIterator(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.On("SetLink", context.Background(), links[0]).Return(nil)
+
+					return setter
+				}(),
+			},
+			args:      args{handlerErr: iotest.ErrTimeout},
+			wantLinks: []entities.Link{links[0]},
+			wantErr:   assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var gotLinks []entities.Link
+			exporter := LinkExporter{
+				LinkIterator: data.fields.LinkIterator,
+				LinkSetter:   data.fields.LinkSetter,
+			}
+			gotErr := exporter.ExportLinks(context.Background(), func(link entities.Link) error {
+				gotLinks = append(gotLinks, link)
+				return data.args.handlerErr
+			})
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkIterator,
+				data.fields.LinkSetter,
+			)
+			assert.Equal(test, data.wantLinks, gotLinks)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
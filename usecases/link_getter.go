@@ -1,18 +1,20 @@
 package usecases
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/go-log/log"
 	"github.com/pkg/errors"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
 )
 
 //go:generate mockery --name=LinkGetter --inpackage --case=underscore --testonly
 
 // LinkGetter ...
 type LinkGetter interface {
-	GetLink(query string) (entities.Link, error)
+	GetLink(ctx context.Context, query string) (entities.Link, error)
 }
 
 // SilentLinkGetter ...
@@ -22,13 +24,20 @@ type SilentLinkGetter struct {
 }
 
 // GetLink ...
-func (getter SilentLinkGetter) GetLink(query string) (entities.Link, error) {
-	link, err := getter.LinkGetter.GetLink(query)
-	switch err {
+func (getter SilentLinkGetter) GetLink(
+	ctx context.Context,
+	query string,
+) (entities.Link, error) {
+	link, err := getter.LinkGetter.GetLink(ctx, query)
+	switch errors.Cause(err) {
 	case nil:
 		return link, nil
+	case entities.ErrLinkExpired:
+		// it isn't a failure of the getter, so there is no sense to hide it
+		return entities.Link{}, err
 	default:
-		if err != sql.ErrNoRows {
+		// the breaker logs the changes of its state itself
+		if err != sql.ErrNoRows && errors.Cause(err) != breakers.ErrOpenCircuit {
 			getter.Logger.Logf("unable to get the link: %v", err)
 		}
 
@@ -36,21 +45,83 @@ func (getter SilentLinkGetter) GetLink(query string) (entities.Link, error) {
 	}
 }
 
+// CheckingLinkGetter ...
+//
+// It forbids getting of links with URLs rejected by the checker, e.g. blocked
+// ones. URLs of redirect rules and variants are checked too, so a link is
+// rejected as a whole, even if only some visitors would be redirected
+// to a rejected URL.
+//
+type CheckingLinkGetter struct {
+	LinkGetter LinkGetter
+	URLChecker URLChecker
+}
+
+// GetLink ...
+func (getter CheckingLinkGetter) GetLink(
+	ctx context.Context,
+	query string,
+) (entities.Link, error) {
+	link, err := getter.LinkGetter.GetLink(ctx, query)
+	if err != nil {
+		// the error is returned as is to keep sentinel errors comparable
+		return entities.Link{}, err
+	}
+
+	if err := getter.URLChecker.CheckURL(link.URL); err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to check the link URL")
+	}
+	for index, rule := range link.Rules {
+		if err := getter.URLChecker.CheckURL(rule.URL); err != nil {
+			return entities.Link{}, errors.Wrapf(
+				err,
+				"unable to check the URL of the rule #%d",
+				index+1,
+			)
+		}
+	}
+	for _, variant := range link.Variants {
+		if err := getter.URLChecker.CheckURL(variant.URL); err != nil {
+			return entities.Link{}, errors.Wrapf(
+				err,
+				"unable to check the URL of the variant %q",
+				variant.Name,
+			)
+		}
+	}
+
+	return link, nil
+}
+
 // LinkGetterGroup ...
+//
+// It skips the getters with the open circuit. If the link isn't found
+// by the rest ones, the open circuit error is returned instead
+// of the sql.ErrNoRows error, because the link may exist after all.
+//
 type LinkGetterGroup []LinkGetter
 
 // GetLink ...
-func (getters LinkGetterGroup) GetLink(query string) (entities.Link, error) {
+func (getters LinkGetterGroup) GetLink(
+	ctx context.Context,
+	query string,
+) (entities.Link, error) {
+	var skippedErr error
 	for _, getter := range getters {
-		link, err := getter.GetLink(query)
-		switch err {
-		case nil:
+		link, err := getter.GetLink(ctx, query)
+		switch {
+		case err == nil:
 			return link, nil
-		case sql.ErrNoRows:
+		case err == sql.ErrNoRows:
+		case errors.Cause(err) == breakers.ErrOpenCircuit:
+			skippedErr = err
 		default:
 			return entities.Link{}, errors.Wrap(err, "unable to get the link")
 		}
 	}
+	if skippedErr != nil {
+		return entities.Link{}, errors.Wrap(skippedErr, "unable to get the link")
+	}
 
 	return entities.Link{}, sql.ErrNoRows
 }
@@ -1,14 +1,17 @@
 package usecases
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"testing/iotest"
 
 	"github.com/go-log/log"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
 )
 
 func TestSilentLinkGetter_GetLink(test *testing.T) {
@@ -33,7 +36,7 @@ func TestSilentLinkGetter_GetLink(test *testing.T) {
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
 					getter.
-						On("GetLink", "query").
+						On("GetLink", context.Background(), "query").
 						Return(entities.Link{Code: "code", URL: "url"}, nil)
 
 					return getter
@@ -49,7 +52,7 @@ func TestSilentLinkGetter_GetLink(test *testing.T) {
 			fields: fields{
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
-					getter.On("GetLink", "query").Return(entities.Link{}, sql.ErrNoRows)
+					getter.On("GetLink", context.Background(), "query").Return(entities.Link{}, sql.ErrNoRows)
 
 					return getter
 				}(),
@@ -59,12 +62,29 @@ func TestSilentLinkGetter_GetLink(test *testing.T) {
 			wantLink: entities.Link{},
 			wantErr:  sql.ErrNoRows,
 		},
+		{
+			name: "error (entities.ErrLinkExpired)",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "query").
+						Return(entities.Link{}, entities.ErrLinkExpired)
+
+					return getter
+				}(),
+				Logger: new(MockLogger),
+			},
+			args:     args{"query"},
+			wantLink: entities.Link{},
+			wantErr:  entities.ErrLinkExpired,
+		},
 		{
 			name: "error (not sql.ErrNoRows)",
 			fields: fields{
 				LinkGetter: func() LinkGetter {
 					getter := new(MockLinkGetter)
-					getter.On("GetLink", "query").Return(entities.Link{}, iotest.ErrTimeout)
+					getter.On("GetLink", context.Background(), "query").Return(entities.Link{}, iotest.ErrTimeout)
 
 					return getter
 				}(),
@@ -83,13 +103,30 @@ func TestSilentLinkGetter_GetLink(test *testing.T) {
 			wantLink: entities.Link{},
 			wantErr:  sql.ErrNoRows,
 		},
+		{
+			name: "error (breakers.ErrOpenCircuit)",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "query").
+						Return(entities.Link{}, errors.Wrap(breakers.ErrOpenCircuit, "dummy"))
+
+					return getter
+				}(),
+				Logger: new(MockLogger),
+			},
+			args:     args{"query"},
+			wantLink: entities.Link{},
+			wantErr:  sql.ErrNoRows,
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			getter := SilentLinkGetter{
 				LinkGetter: data.fields.LinkGetter,
 				Logger:     data.fields.Logger,
 			}
-			gotLink, gotErr := getter.GetLink(data.args.query)
+			gotLink, gotErr := getter.GetLink(context.Background(), data.args.query)
 
 			mock.AssertExpectationsForObjects(
 				test,
@@ -102,6 +139,232 @@ func TestSilentLinkGetter_GetLink(test *testing.T) {
 	}
 }
 
+func TestCheckingLinkGetter_GetLink(test *testing.T) {
+	type fields struct {
+		LinkGetter LinkGetter
+		URLChecker URLChecker
+	}
+	type args struct {
+		query string
+	}
+
+	for _, data := range []struct {
+		name     string
+		fields   fields
+		args     args
+		wantLink entities.Link
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "query").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+			},
+			args:     args{"query"},
+			wantLink: entities.Link{Code: "code", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with rules",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "query").
+						Return(entities.Link{
+							Code:  "code",
+							URL:   "url",
+							Rules: []entities.RedirectRule{{URL: "url #1"}, {URL: "url #2"}},
+						}, nil)
+
+					return getter
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.On("CheckURL", "url #1").Return(nil)
+					checker.On("CheckURL", "url #2").Return(nil)
+
+					return checker
+				}(),
+			},
+			args: args{"query"},
+			wantLink: entities.Link{
+				Code:  "code",
+				URL:   "url",
+				Rules: []entities.RedirectRule{{URL: "url #1"}, {URL: "url #2"}},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with variants",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "query").
+						Return(entities.Link{
+							Code:     "code",
+							URL:      "url",
+							Variants: []entities.Variant{{Name: "a", URL: "url #1"}, {Name: "b", URL: "url #2"}},
+						}, nil)
+
+					return getter
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.On("CheckURL", "url #1").Return(nil)
+					checker.On("CheckURL", "url #2").Return(nil)
+
+					return checker
+				}(),
+			},
+			args: args{"query"},
+			wantLink: entities.Link{
+				Code:     "code",
+				URL:      "url",
+				Variants: []entities.Variant{{Name: "a", URL: "url #1"}, {Name: "b", URL: "url #2"}},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error with the getter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "query").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				URLChecker: new(MockURLChecker),
+			},
+			args:     args{"query"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, err, args)
+			},
+		},
+		{
+			name: "error with the checker",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "query").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.
+						On("CheckURL", "url").
+						Return(errors.Wrap(entities.ErrLinkBlocked, "the URL is blocked"))
+
+					return checker
+				}(),
+			},
+			args:     args{"query"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkBlocked, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the checker and rules",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "query").
+						Return(entities.Link{
+							Code:  "code",
+							URL:   "url",
+							Rules: []entities.RedirectRule{{URL: "url #1"}, {URL: "url #2"}},
+						}, nil)
+
+					return getter
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.
+						On("CheckURL", "url #1").
+						Return(errors.Wrap(entities.ErrLinkBlocked, "the URL is blocked"))
+
+					return checker
+				}(),
+			},
+			args:     args{"query"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkBlocked, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the checker and variants",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "query").
+						Return(entities.Link{
+							Code:     "code",
+							URL:      "url",
+							Variants: []entities.Variant{{Name: "a", URL: "url #1"}, {Name: "b", URL: "url #2"}},
+						}, nil)
+
+					return getter
+				}(),
+				URLChecker: func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+					checker.
+						On("CheckURL", "url #1").
+						Return(errors.Wrap(entities.ErrLinkBlocked, "the URL is blocked"))
+
+					return checker
+				}(),
+			},
+			args:     args{"query"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkBlocked, errors.Cause(err), args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			getter := CheckingLinkGetter{
+				LinkGetter: data.fields.LinkGetter,
+				URLChecker: data.fields.URLChecker,
+			}
+			gotLink, gotErr := getter.GetLink(context.Background(), data.args.query)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkGetter,
+				data.fields.URLChecker,
+			)
+			assert.Equal(test, data.wantLink, gotLink)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
 func TestLinkGetterGroup_GetLink(test *testing.T) {
 	type args struct {
 		query string
@@ -119,7 +382,7 @@ func TestLinkGetterGroup_GetLink(test *testing.T) {
 			getters: func() LinkGetterGroup {
 				getterOne := new(MockLinkGetter)
 				getterOne.
-					On("GetLink", "query").
+					On("GetLink", context.Background(), "query").
 					Return(entities.Link{Code: "code", URL: "url"}, nil)
 
 				getterTwo := new(MockLinkGetter)
@@ -134,11 +397,11 @@ func TestLinkGetterGroup_GetLink(test *testing.T) {
 			name: "success not with the first getter",
 			getters: func() LinkGetterGroup {
 				getterOne := new(MockLinkGetter)
-				getterOne.On("GetLink", "query").Return(entities.Link{}, sql.ErrNoRows)
+				getterOne.On("GetLink", context.Background(), "query").Return(entities.Link{}, sql.ErrNoRows)
 
 				getterTwo := new(MockLinkGetter)
 				getterTwo.
-					On("GetLink", "query").
+					On("GetLink", context.Background(), "query").
 					Return(entities.Link{Code: "code", URL: "url"}, nil)
 
 				return LinkGetterGroup{getterOne, getterTwo}
@@ -160,7 +423,7 @@ func TestLinkGetterGroup_GetLink(test *testing.T) {
 			name: "error with the first getter",
 			getters: func() LinkGetterGroup {
 				getterOne := new(MockLinkGetter)
-				getterOne.On("GetLink", "query").Return(entities.Link{}, iotest.ErrTimeout)
+				getterOne.On("GetLink", context.Background(), "query").Return(entities.Link{}, iotest.ErrTimeout)
 
 				getterTwo := new(MockLinkGetter)
 
@@ -170,9 +433,45 @@ func TestLinkGetterGroup_GetLink(test *testing.T) {
 			wantLink: entities.Link{},
 			wantErr:  assert.Error,
 		},
+		{
+			name: "success with the open circuit of the first getter",
+			getters: func() LinkGetterGroup {
+				getterOne := new(MockLinkGetter)
+				getterOne.
+					On("GetLink", context.Background(), "query").
+					Return(entities.Link{}, errors.Wrap(breakers.ErrOpenCircuit, "dummy"))
+
+				getterTwo := new(MockLinkGetter)
+				getterTwo.On("GetLink", context.Background(), "query").Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+				return LinkGetterGroup{getterOne, getterTwo}
+			}(),
+			args:     args{"query"},
+			wantLink: entities.Link{Code: "code", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "error with the open circuit of the first getter",
+			getters: func() LinkGetterGroup {
+				getterOne := new(MockLinkGetter)
+				getterOne.
+					On("GetLink", context.Background(), "query").
+					Return(entities.Link{}, errors.Wrap(breakers.ErrOpenCircuit, "dummy"))
+
+				getterTwo := new(MockLinkGetter)
+				getterTwo.On("GetLink", context.Background(), "query").Return(entities.Link{}, sql.ErrNoRows)
+
+				return LinkGetterGroup{getterOne, getterTwo}
+			}(),
+			args:     args{"query"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, breakers.ErrOpenCircuit, errors.Cause(err), args)
+			},
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
-			gotLink, gotErr := data.getters.GetLink(data.args.query)
+			gotLink, gotErr := data.getters.GetLink(context.Background(), data.args.query)
 
 			for _, getter := range data.getters {
 				mock.AssertExpectationsForObjects(test, getter)
@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkImporter ...
+type LinkImporter struct {
+	LinkGetter  LinkGetter
+	LinkSetter  LinkSetter
+	LinkUpdater LinkUpdater
+}
+
+// ImportLink ...
+//
+// Unlike the link creator, it keeps the link code and doesn't change the link
+// URL, so links are moved as is.
+//
+// If the URL already has a link with the same code, only the disabling flag
+// is updated. So an interrupted import may be just restarted. It returns
+// false, if the link was already present.
+//
+func (importer LinkImporter) ImportLink(
+	ctx context.Context,
+	link entities.Link,
+) (bool, error) {
+	if link.Code == "" || link.URL == "" {
+		return false, errors.Wrap(
+			entities.ErrInvalidLink,
+			"the link should have both a code and an URL",
+		)
+	}
+	if link.IsExpired(time.Now()) {
+		return false, errors.Wrap(entities.ErrInvalidLink, "the link is expired")
+	}
+
+	existingLink, err := importer.LinkGetter.GetLink(ctx, link.URL)
+	switch errors.Cause(err) {
+	case nil:
+		if existingLink.Code != link.Code {
+			return false, errors.Wrap(
+				entities.ErrLinkConflict,
+				"the URL already has another code",
+			)
+		}
+		if existingLink.Disabled == link.Disabled {
+			return false, nil
+		}
+
+		if err := importer.LinkUpdater.UpdateLink(ctx, link); err != nil {
+			return false, errors.Wrap(err, "unable to update the link")
+		}
+
+		return false, nil
+	// the expired link will be replaced
+	case sql.ErrNoRows, entities.ErrLinkExpired:
+	default:
+		return false, errors.Wrap(err, "unable to get the link")
+	}
+
+	if err := importer.LinkSetter.SetLink(ctx, link); err != nil {
+		return false, errors.Wrap(err, "unable to set the link")
+	}
+
+	// not all the link setters store the disabling flag
+	if link.Disabled {
+		if err := importer.LinkUpdater.UpdateLink(ctx, link); err != nil {
+			return false, errors.Wrap(err, "unable to update the link")
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,293 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkImporter_ImportLink(test *testing.T) {
+	type fields struct {
+		LinkGetter  LinkGetter
+		LinkSetter  LinkSetter
+		LinkUpdater LinkUpdater
+	}
+	type args struct {
+		link entities.Link
+	}
+
+	expirationTime := time.Now().Add(-time.Hour)
+	for _, data := range []struct {
+		name         string
+		fields       fields
+		args         args
+		wantImported bool
+		wantErr      assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success with setting",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).Return(nil)
+
+					return setter
+				}(),
+				LinkUpdater: new(MockLinkUpdater),
+			},
+			args:         args{entities.Link{Code: "code", URL: "url"}},
+			wantImported: true,
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "success with setting (with an expired link)",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{}, entities.ErrLinkExpired)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).Return(nil)
+
+					return setter
+				}(),
+				LinkUpdater: new(MockLinkUpdater),
+			},
+			args:         args{entities.Link{Code: "code", URL: "url"}},
+			wantImported: true,
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "success with setting (with a disabled link)",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.
+						On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url", Disabled: true}).
+						Return(nil)
+
+					return setter
+				}(),
+				LinkUpdater: func() LinkUpdater {
+					updater := new(MockLinkUpdater)
+					updater.
+						On("UpdateLink", context.Background(), entities.Link{Code: "code", URL: "url", Disabled: true}).
+						Return(nil)
+
+					return updater
+				}(),
+			},
+			args:         args{entities.Link{Code: "code", URL: "url", Disabled: true}},
+			wantImported: true,
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "success with skipping",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkSetter:  new(MockLinkSetter),
+				LinkUpdater: new(MockLinkUpdater),
+			},
+			args:         args{entities.Link{Code: "code", URL: "url"}},
+			wantImported: false,
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "success with updating",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkSetter: new(MockLinkSetter),
+				LinkUpdater: func() LinkUpdater {
+					updater := new(MockLinkUpdater)
+					updater.
+						On("UpdateLink", context.Background(), entities.Link{Code: "code", URL: "url", Disabled: true}).
+						Return(nil)
+
+					return updater
+				}(),
+			},
+			args:         args{entities.Link{Code: "code", URL: "url", Disabled: true}},
+			wantImported: false,
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "error without a code",
+			fields: fields{
+				LinkGetter:  new(MockLinkGetter),
+				LinkSetter:  new(MockLinkSetter),
+				LinkUpdater: new(MockLinkUpdater),
+			},
+			args:         args{entities.Link{URL: "url"}},
+			wantImported: false,
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err))
+			},
+		},
+		{
+			name: "error with an expired link",
+			fields: fields{
+				LinkGetter:  new(MockLinkGetter),
+				LinkSetter:  new(MockLinkSetter),
+				LinkUpdater: new(MockLinkUpdater),
+			},
+			args: args{
+				link: entities.Link{
+					Code:           "code",
+					URL:            "url",
+					ExpirationTime: &expirationTime,
+				},
+			},
+			wantImported: false,
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...interface{}) bool {
+				return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err))
+			},
+		},
+		{
+			name: "error with another code of the URL",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "url").
+						Return(entities.Link{Code: "code #1", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkSetter:  new(MockLinkSetter),
+				LinkUpdater: new(MockLinkUpdater),
+			},
+			args:         args{entities.Link{Code: "code #2", URL: "url"}},
+			wantImported: false,
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkConflict, errors.Cause(err))
+			},
+		},
+		{
+			name: "error with the getter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, iotest.ErrTimeout)
+
+					return getter
+				}(),
+				LinkSetter:  new(MockLinkSetter),
+				LinkUpdater: new(MockLinkUpdater),
+			},
+			args:         args{entities.Link{Code: "code", URL: "url"}},
+			wantImported: false,
+			wantErr:      assert.Error,
+		},
+		{
+			name: "error with the setter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.
+						On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+						Return(entities.ErrLinkConflict)
+
+					return setter
+				}(),
+				LinkUpdater: new(MockLinkUpdater),
+			},
+			args:         args{entities.Link{Code: "code", URL: "url"}},
+			wantImported: false,
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...interface{}) bool {
+				return assert.Equal(test, entities.ErrLinkConflict, errors.Cause(err))
+			},
+		},
+		{
+			name: "error with the updater",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "url").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.
+						On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url", Disabled: true}).
+						Return(nil)
+
+					return setter
+				}(),
+				LinkUpdater: func() LinkUpdater {
+					updater := new(MockLinkUpdater)
+					updater.
+						On("UpdateLink", context.Background(), entities.Link{Code: "code", URL: "url", Disabled: true}).
+						Return(iotest.ErrTimeout)
+
+					return updater
+				}(),
+			},
+			args:         args{entities.Link{Code: "code", URL: "url", Disabled: true}},
+			wantImported: false,
+			wantErr:      assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			importer := LinkImporter{
+				LinkGetter:  data.fields.LinkGetter,
+				LinkSetter:  data.fields.LinkSetter,
+				LinkUpdater: data.fields.LinkUpdater,
+			}
+			gotImported, gotErr := importer.ImportLink(context.Background(), data.args.link)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkGetter,
+				data.fields.LinkSetter,
+				data.fields.LinkUpdater,
+			)
+			assert.Equal(test, data.wantImported, gotImported)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
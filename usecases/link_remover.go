@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+// LinkRemover ...
+type LinkRemover struct {
+	LinkGetter  LinkGetter
+	LinkDeleter LinkDeleter
+}
+
+// RemoveLink ...
+//
+// The link is got first, because its URL is required to remove it
+// from all places.
+//
+func (remover LinkRemover) RemoveLink(
+	ctx context.Context,
+	code string,
+) (entities.Link, error) {
+	link, err := remover.LinkGetter.GetLink(ctx, code)
+	if err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to get the link")
+	}
+
+	if err := remover.LinkDeleter.DeleteLink(ctx, link); err != nil {
+		return entities.Link{}, errors.Wrap(err, "unable to delete the link")
+	}
+
+	return link, nil
+}
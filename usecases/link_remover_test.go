@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/iotest"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkRemover_RemoveLink(test *testing.T) {
+	type fields struct {
+		LinkGetter  LinkGetter
+		LinkDeleter LinkDeleter
+	}
+	type args struct {
+		code string
+	}
+
+	for _, data := range []struct {
+		name     string
+		fields   fields
+		args     args
+		wantLink entities.Link
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "code").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkDeleter: func() LinkDeleter {
+					deleter := new(MockLinkDeleter)
+					deleter.
+						On("DeleteLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+						Return(nil)
+
+					return deleter
+				}(),
+			},
+			args:     args{"code"},
+			wantLink: entities.Link{Code: "code", URL: "url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "error with the getter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.On("GetLink", context.Background(), "code").Return(entities.Link{}, sql.ErrNoRows)
+
+					return getter
+				}(),
+				LinkDeleter: new(MockLinkDeleter),
+			},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, sql.ErrNoRows, errors.Cause(err), args)
+			},
+		},
+		{
+			name: "error with the deleter",
+			fields: fields{
+				LinkGetter: func() LinkGetter {
+					getter := new(MockLinkGetter)
+					getter.
+						On("GetLink", context.Background(), "code").
+						Return(entities.Link{Code: "code", URL: "url"}, nil)
+
+					return getter
+				}(),
+				LinkDeleter: func() LinkDeleter {
+					deleter := new(MockLinkDeleter)
+					deleter.
+						On("DeleteLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+						Return(iotest.ErrTimeout)
+
+					return deleter
+				}(),
+			},
+			args:     args{"code"},
+			wantLink: entities.Link{},
+			wantErr:  assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			remover := LinkRemover{
+				LinkGetter:  data.fields.LinkGetter,
+				LinkDeleter: data.fields.LinkDeleter,
+			}
+			gotLink, gotErr := remover.RemoveLink(context.Background(), data.args.code)
+
+			mock.AssertExpectationsForObjects(
+				test,
+				data.fields.LinkGetter,
+				data.fields.LinkDeleter,
+			)
+			assert.Equal(test, data.wantLink, gotLink)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -1,16 +1,19 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/go-log/log"
 	"github.com/pkg/errors"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
 )
 
 //go:generate mockery --name=LinkSetter --inpackage --case=underscore --testonly
 
 // LinkSetter ...
 type LinkSetter interface {
-	SetLink(link entities.Link) error
+	SetLink(ctx context.Context, link entities.Link) error
 }
 
 // SilentLinkSetter ...
@@ -20,8 +23,13 @@ type SilentLinkSetter struct {
 }
 
 // SetLink ...
-func (setter SilentLinkSetter) SetLink(link entities.Link) error {
-	if err := setter.LinkSetter.SetLink(link); err != nil {
+func (setter SilentLinkSetter) SetLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	err := setter.LinkSetter.SetLink(ctx, link)
+	// the breaker logs the changes of its state itself
+	if err != nil && errors.Cause(err) != breakers.ErrOpenCircuit {
 		setter.Logger.Logf("unable to set the link: %v", err)
 	}
 
@@ -32,12 +40,96 @@ func (setter SilentLinkSetter) SetLink(link entities.Link) error {
 type LinkSetterGroup []LinkSetter
 
 // SetLink ...
-func (setters LinkSetterGroup) SetLink(link entities.Link) error {
+func (setters LinkSetterGroup) SetLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
 	for _, setter := range setters {
-		if err := setter.SetLink(link); err != nil {
+		if err := setter.SetLink(ctx, link); err != nil {
 			return errors.Wrap(err, "unable to set the link")
 		}
 	}
 
 	return nil
 }
+
+//go:generate mockery --name=BulkLinkSetter --inpackage --case=underscore --testonly
+
+// BulkLinkSetter ...
+//
+// It returns an error per link; the common error means that the links haven't
+// been processed at all.
+//
+type BulkLinkSetter interface {
+	SetLinks(ctx context.Context, links []entities.Link) ([]error, error)
+}
+
+// SequentialLinkSetter ...
+//
+// It sets the links one by one, so it adapts the link setters that don't
+// support bulk operations.
+//
+type SequentialLinkSetter struct {
+	LinkSetter LinkSetter
+}
+
+// SetLinks ...
+func (setter SequentialLinkSetter) SetLinks(
+	ctx context.Context,
+	links []entities.Link,
+) ([]error, error) {
+	errs := make([]error, len(links))
+	for index, link := range links {
+		errs[index] = setter.LinkSetter.SetLink(ctx, link)
+	}
+
+	return errs, nil
+}
+
+// BulkLinkSetterGroup ...
+//
+// Each setter receives only the links successfully set by the previous ones.
+//
+type BulkLinkSetterGroup []BulkLinkSetter
+
+// SetLinks ...
+func (setters BulkLinkSetterGroup) SetLinks(
+	ctx context.Context,
+	links []entities.Link,
+) ([]error, error) {
+	errs := make([]error, len(links))
+	indices := make([]int, len(links))
+	for index := range links {
+		indices[index] = index
+	}
+
+	for _, setter := range setters {
+		if len(indices) == 0 {
+			break
+		}
+
+		remainingLinks := make([]entities.Link, 0, len(indices))
+		for _, index := range indices {
+			remainingLinks = append(remainingLinks, links[index])
+		}
+
+		setterErrs, err := setter.SetLinks(ctx, remainingLinks)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to set the links")
+		}
+
+		remainingIndices := make([]int, 0, len(indices))
+		for position, index := range indices {
+			if setterErrs[position] != nil {
+				errs[index] = errors.Wrap(setterErrs[position], "unable to set the link")
+				continue
+			}
+
+			remainingIndices = append(remainingIndices, index)
+		}
+
+		indices = remainingIndices
+	}
+
+	return errs, nil
+}
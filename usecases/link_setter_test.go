@@ -1,13 +1,16 @@
 package usecases
 
 import (
+	"context"
 	"testing"
 	"testing/iotest"
 
 	"github.com/go-log/log"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
 )
 
 func TestSilentLinkSetter_SetLink(test *testing.T) {
@@ -30,7 +33,7 @@ func TestSilentLinkSetter_SetLink(test *testing.T) {
 			fields: fields{
 				LinkSetter: func() LinkSetter {
 					getter := new(MockLinkSetter)
-					getter.On("SetLink", entities.Link{Code: "code", URL: "url"}).Return(nil)
+					getter.On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).Return(nil)
 
 					return getter
 				}(),
@@ -47,7 +50,7 @@ func TestSilentLinkSetter_SetLink(test *testing.T) {
 				LinkSetter: func() LinkSetter {
 					getter := new(MockLinkSetter)
 					getter.
-						On("SetLink", entities.Link{Code: "code", URL: "url"}).
+						On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
 						Return(iotest.ErrTimeout)
 
 					return getter
@@ -68,13 +71,31 @@ func TestSilentLinkSetter_SetLink(test *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "error with the open circuit",
+			fields: fields{
+				LinkSetter: func() LinkSetter {
+					getter := new(MockLinkSetter)
+					getter.
+						On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+						Return(errors.Wrap(breakers.ErrOpenCircuit, "dummy"))
+
+					return getter
+				}(),
+				Logger: new(MockLogger),
+			},
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			setter := SilentLinkSetter{
 				LinkSetter: data.fields.LinkSetter,
 				Logger:     data.fields.Logger,
 			}
-			gotErr := setter.SetLink(data.args.link)
+			gotErr := setter.SetLink(context.Background(), data.args.link)
 
 			mock.AssertExpectationsForObjects(
 				test,
@@ -109,10 +130,10 @@ func TestLinkSetterGroup_SetLink(test *testing.T) {
 			name: "success with setters",
 			setters: func() LinkSetterGroup {
 				setterOne := new(MockLinkSetter)
-				setterOne.On("SetLink", entities.Link{Code: "code", URL: "url"}).Return(nil)
+				setterOne.On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).Return(nil)
 
 				setterTwo := new(MockLinkSetter)
-				setterTwo.On("SetLink", entities.Link{Code: "code", URL: "url"}).Return(nil)
+				setterTwo.On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).Return(nil)
 
 				return LinkSetterGroup{setterOne, setterTwo}
 			}(),
@@ -126,7 +147,7 @@ func TestLinkSetterGroup_SetLink(test *testing.T) {
 			setters: func() LinkSetterGroup {
 				setterOne := new(MockLinkSetter)
 				setterOne.
-					On("SetLink", entities.Link{Code: "code", URL: "url"}).
+					On("SetLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
 					Return(iotest.ErrTimeout)
 
 				setterTwo := new(MockLinkSetter)
@@ -140,11 +161,216 @@ func TestLinkSetterGroup_SetLink(test *testing.T) {
 		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
-			gotErr := data.setters.SetLink(data.args.link)
+			gotErr := data.setters.SetLink(context.Background(), data.args.link)
+
+			for _, setter := range data.setters {
+				mock.AssertExpectationsForObjects(test, setter)
+			}
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func TestSequentialLinkSetter_SetLinks(test *testing.T) {
+	type fields struct {
+		LinkSetter LinkSetter
+	}
+	type args struct {
+		links []entities.Link
+	}
+
+	for _, data := range []struct {
+		name     string
+		fields   fields
+		args     args
+		wantErrs []error
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.
+						On("SetLink", context.Background(), entities.Link{Code: "code #1", URL: "url #1"}).
+						Return(nil)
+					setter.
+						On("SetLink", context.Background(), entities.Link{Code: "code #2", URL: "url #2"}).
+						Return(nil)
+
+					return setter
+				}(),
+			},
+			args: args{
+				links: []entities.Link{
+					{Code: "code #1", URL: "url #1"},
+					{Code: "code #2", URL: "url #2"},
+				},
+			},
+			wantErrs: []error{nil, nil},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with an error of a link",
+			fields: fields{
+				LinkSetter: func() LinkSetter {
+					setter := new(MockLinkSetter)
+					setter.
+						On("SetLink", context.Background(), entities.Link{Code: "code #1", URL: "url #1"}).
+						Return(iotest.ErrTimeout)
+					setter.
+						On("SetLink", context.Background(), entities.Link{Code: "code #2", URL: "url #2"}).
+						Return(nil)
+
+					return setter
+				}(),
+			},
+			args: args{
+				links: []entities.Link{
+					{Code: "code #1", URL: "url #1"},
+					{Code: "code #2", URL: "url #2"},
+				},
+			},
+			wantErrs: []error{iotest.ErrTimeout, nil},
+			wantErr:  assert.NoError,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			setter := SequentialLinkSetter{LinkSetter: data.fields.LinkSetter}
+			gotErrs, gotErr := setter.SetLinks(context.Background(), data.args.links)
+
+			mock.AssertExpectationsForObjects(test, data.fields.LinkSetter)
+			assert.Equal(test, data.wantErrs, gotErrs)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
+
+func TestBulkLinkSetterGroup_SetLinks(test *testing.T) {
+	type args struct {
+		links []entities.Link
+	}
+
+	for _, data := range []struct {
+		name     string
+		setters  BulkLinkSetterGroup
+		args     args
+		wantErrs []error
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success without setters",
+			setters: nil,
+			args: args{
+				links: []entities.Link{{Code: "code", URL: "url"}},
+			},
+			wantErrs: []error{nil},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with setters",
+			setters: func() BulkLinkSetterGroup {
+				links := []entities.Link{
+					{Code: "code #1", URL: "url #1"},
+					{Code: "code #2", URL: "url #2"},
+				}
+
+				setterOne := new(MockBulkLinkSetter)
+				setterOne.On("SetLinks", context.Background(), links).Return([]error{nil, nil}, nil)
+
+				setterTwo := new(MockBulkLinkSetter)
+				setterTwo.On("SetLinks", context.Background(), links).Return([]error{nil, nil}, nil)
+
+				return BulkLinkSetterGroup{setterOne, setterTwo}
+			}(),
+			args: args{
+				links: []entities.Link{
+					{Code: "code #1", URL: "url #1"},
+					{Code: "code #2", URL: "url #2"},
+				},
+			},
+			wantErrs: []error{nil, nil},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with errors of links",
+			setters: func() BulkLinkSetterGroup {
+				setterOne := new(MockBulkLinkSetter)
+				setterOne.
+					On("SetLinks", context.Background(), []entities.Link{
+						{Code: "code #1", URL: "url #1"},
+						{Code: "code #2", URL: "url #2"},
+						{Code: "code #3", URL: "url #3"},
+					}).
+					Return([]error{iotest.ErrTimeout, nil, nil}, nil)
+
+				setterTwo := new(MockBulkLinkSetter)
+				setterTwo.
+					On("SetLinks", context.Background(), []entities.Link{
+						{Code: "code #2", URL: "url #2"},
+						{Code: "code #3", URL: "url #3"},
+					}).
+					Return([]error{nil, entities.ErrLinkConflict}, nil)
+
+				return BulkLinkSetterGroup{setterOne, setterTwo}
+			}(),
+			args: args{
+				links: []entities.Link{
+					{Code: "code #1", URL: "url #1"},
+					{Code: "code #2", URL: "url #2"},
+					{Code: "code #3", URL: "url #3"},
+				},
+			},
+			wantErrs: []error{iotest.ErrTimeout, nil, entities.ErrLinkConflict},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with errors of all the links",
+			setters: func() BulkLinkSetterGroup {
+				setterOne := new(MockBulkLinkSetter)
+				setterOne.
+					On("SetLinks", context.Background(), []entities.Link{{Code: "code", URL: "url"}}).
+					Return([]error{iotest.ErrTimeout}, nil)
+
+				setterTwo := new(MockBulkLinkSetter)
+
+				return BulkLinkSetterGroup{setterOne, setterTwo}
+			}(),
+			args: args{
+				links: []entities.Link{{Code: "code", URL: "url"}},
+			},
+			wantErrs: []error{iotest.ErrTimeout},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "error with the first setter",
+			setters: func() BulkLinkSetterGroup {
+				setterOne := new(MockBulkLinkSetter)
+				setterOne.
+					On("SetLinks", context.Background(), []entities.Link{{Code: "code", URL: "url"}}).
+					Return(nil, iotest.ErrTimeout)
+
+				setterTwo := new(MockBulkLinkSetter)
+
+				return BulkLinkSetterGroup{setterOne, setterTwo}
+			}(),
+			args: args{
+				links: []entities.Link{{Code: "code", URL: "url"}},
+			},
+			wantErrs: nil,
+			wantErr:  assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotErrs, gotErr := data.setters.SetLinks(context.Background(), data.args.links)
 
 			for _, setter := range data.setters {
 				mock.AssertExpectationsForObjects(test, setter)
 			}
+			for index, err := range gotErrs {
+				gotErrs[index] = errors.Cause(err)
+			}
+			assert.Equal(test, data.wantErrs, gotErrs)
 			data.wantErr(test, gotErr)
 		})
 	}
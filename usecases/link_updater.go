@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+//go:generate mockery --name=LinkUpdater --inpackage --case=underscore --testonly
+
+// LinkUpdater ...
+type LinkUpdater interface {
+	UpdateLink(ctx context.Context, link entities.Link) error
+}
+
+// LinkUpdaterGroup ...
+type LinkUpdaterGroup []LinkUpdater
+
+// UpdateLink ...
+func (updaters LinkUpdaterGroup) UpdateLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	for _, updater := range updaters {
+		if err := updater.UpdateLink(ctx, link); err != nil {
+			return errors.Wrap(err, "unable to update the link")
+		}
+	}
+
+	return nil
+}
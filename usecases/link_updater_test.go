@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestLinkUpdaterGroup_UpdateLink(test *testing.T) {
+	type args struct {
+		link entities.Link
+	}
+
+	for _, data := range []struct {
+		name     string
+		updaters LinkUpdaterGroup
+		args     args
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "success without updaters",
+			updaters: nil,
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with updaters",
+			updaters: func() LinkUpdaterGroup {
+				updaterOne := new(MockLinkUpdater)
+				updaterOne.
+					On("UpdateLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+					Return(nil)
+
+				updaterTwo := new(MockLinkUpdater)
+				updaterTwo.
+					On("UpdateLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+					Return(nil)
+
+				return LinkUpdaterGroup{updaterOne, updaterTwo}
+			}(),
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error with the first updater",
+			updaters: func() LinkUpdaterGroup {
+				updaterOne := new(MockLinkUpdater)
+				updaterOne.
+					On("UpdateLink", context.Background(), entities.Link{Code: "code", URL: "url"}).
+					Return(iotest.ErrTimeout)
+
+				updaterTwo := new(MockLinkUpdater)
+
+				return LinkUpdaterGroup{updaterOne, updaterTwo}
+			}(),
+			args: args{
+				link: entities.Link{Code: "code", URL: "url"},
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotErr := data.updaters.UpdateLink(context.Background(), data.args.link)
+
+			for _, updater := range data.updaters {
+				mock.AssertExpectationsForObjects(test, updater)
+			}
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -2,27 +2,31 @@
 
 package usecases
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockCodeGenerator is an autogenerated mock type for the CodeGenerator type
 type MockCodeGenerator struct {
 	mock.Mock
 }
 
-// GenerateCode provides a mock function with given fields:
-func (_m *MockCodeGenerator) GenerateCode() (string, error) {
-	ret := _m.Called()
+// GenerateCode provides a mock function with given fields: ctx
+func (_m *MockCodeGenerator) GenerateCode(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package usecases
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 	entities "github.com/thewizardplusplus/go-link-shortener-backend/entities"
 )
@@ -12,20 +14,20 @@ type MockLinkGetter struct {
 	mock.Mock
 }
 
-// GetLink provides a mock function with given fields: query
-func (_m *MockLinkGetter) GetLink(query string) (entities.Link, error) {
-	ret := _m.Called(query)
+// GetLink provides a mock function with given fields: ctx, query
+func (_m *MockLinkGetter) GetLink(ctx context.Context, query string) (entities.Link, error) {
+	ret := _m.Called(ctx, query)
 
 	var r0 entities.Link
-	if rf, ok := ret.Get(0).(func(string) entities.Link); ok {
-		r0 = rf(query)
+	if rf, ok := ret.Get(0).(func(context.Context, string) entities.Link); ok {
+		r0 = rf(ctx, query)
 	} else {
 		r0 = ret.Get(0).(entities.Link)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(query)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
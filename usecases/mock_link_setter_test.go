@@ -3,6 +3,8 @@
 package usecases
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 	entities "github.com/thewizardplusplus/go-link-shortener-backend/entities"
 )
@@ -12,13 +14,13 @@ type MockLinkSetter struct {
 	mock.Mock
 }
 
-// SetLink provides a mock function with given fields: link
-func (_m *MockLinkSetter) SetLink(link entities.Link) error {
-	ret := _m.Called(link)
+// SetLink provides a mock function with given fields: ctx, link
+func (_m *MockLinkSetter) SetLink(ctx context.Context, link entities.Link) error {
+	ret := _m.Called(ctx, link)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(entities.Link) error); ok {
-		r0 = rf(link)
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Link) error); ok {
+		r0 = rf(ctx, link)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,159 @@
+package normalizers
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"golang.org/x/net/idna"
+)
+
+// it's the lookup profile, but without the STD3 rules, which disallow
+// underscores and similar symbols used by real hosts
+var hostProfile = idna.New(idna.MapForLookup(), idna.StrictDomainName(false))
+
+var defaultPorts = map[string]string{
+	"ftp":   "21",
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+}
+
+// URLNormalizer ...
+//
+// Tracking parameters are names of query parameters removed from the URL;
+// a name with the trailing asterisk is a prefix, e.g. "utm_*".
+//
+type URLNormalizer struct {
+	AllowedSchemes     []string
+	TrackingParameters []string
+}
+
+// NormalizeURL ...
+func (normalizer URLNormalizer) NormalizeURL(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return "", errors.Wrap(entities.ErrInvalidLink, "the URL is empty")
+	}
+
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return "", errors.Wrapf(
+			entities.ErrInvalidLink,
+			"unable to parse the URL: %v",
+			err,
+		)
+	}
+	if !parsedURL.IsAbs() {
+		return "", errors.Wrap(entities.ErrInvalidLink, "the URL isn't absolute")
+	}
+	if !normalizer.isSchemeAllowed(parsedURL.Scheme) {
+		return "", errors.Wrapf(
+			entities.ErrInvalidLink,
+			"the URL scheme %q isn't allowed",
+			parsedURL.Scheme,
+		)
+	}
+	if parsedURL.Opaque != "" || parsedURL.Hostname() == "" {
+		return "", errors.Wrap(entities.ErrInvalidLink, "the URL doesn't have a host")
+	}
+
+	host, err := normalizeHost(parsedURL.Scheme, parsedURL.Host)
+	if err != nil {
+		return "", errors.Wrapf(
+			entities.ErrInvalidLink,
+			"unable to normalize the URL host: %v",
+			err,
+		)
+	}
+
+	parsedURL.Host = host
+	if parsedURL.Path == "" {
+		parsedURL.Path = "/"
+	}
+	normalizer.removeTrackingParameters(parsedURL)
+
+	return parsedURL.String(), nil
+}
+
+func (normalizer URLNormalizer) isSchemeAllowed(scheme string) bool {
+	for _, allowedScheme := range normalizer.AllowedSchemes {
+		if strings.EqualFold(scheme, allowedScheme) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (normalizer URLNormalizer) removeTrackingParameters(parsedURL *url.URL) {
+	if len(normalizer.TrackingParameters) == 0 || parsedURL.RawQuery == "" {
+		return
+	}
+
+	var isChanged bool
+	query := parsedURL.Query()
+	for name := range query {
+		if normalizer.isTrackingParameter(name) {
+			query.Del(name)
+			isChanged = true
+		}
+	}
+
+	// the query is re-encoded only if necessary,
+	// because the encoding changes the order of the parameters
+	if isChanged {
+		parsedURL.RawQuery = query.Encode()
+	}
+}
+
+func (normalizer URLNormalizer) isTrackingParameter(name string) bool {
+	for _, trackingParameter := range normalizer.TrackingParameters {
+		if strings.HasSuffix(trackingParameter, "*") {
+			prefix := strings.TrimSuffix(trackingParameter, "*")
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if name == trackingParameter {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeHost(scheme string, host string) (string, error) {
+	hostname, port := host, ""
+	if strings.LastIndex(host, ":") > strings.LastIndex(host, "]") {
+		var err error
+		if hostname, port, err = net.SplitHostPort(host); err != nil {
+			return "", errors.Wrap(err, "unable to split the host and the port")
+		}
+	}
+	hostname = strings.TrimSuffix(strings.TrimPrefix(hostname, "["), "]")
+
+	isIPv6 := strings.Contains(hostname, ":")
+	if isIPv6 {
+		hostname = strings.ToLower(hostname)
+	} else {
+		var err error
+		if hostname, err = hostProfile.ToASCII(hostname); err != nil {
+			return "", errors.Wrap(err, "unable to convert the host to Punycode")
+		}
+	}
+
+	if port == defaultPorts[strings.ToLower(scheme)] {
+		port = ""
+	}
+	if port != "" {
+		return net.JoinHostPort(hostname, port), nil
+	}
+	if isIPv6 {
+		return "[" + hostname + "]", nil
+	}
+
+	return hostname, nil
+}
@@ -0,0 +1,184 @@
+package normalizers
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestURLNormalizer_NormalizeURL(test *testing.T) {
+	type fields struct {
+		AllowedSchemes     []string
+		TrackingParameters []string
+	}
+	type args struct {
+		rawURL string
+	}
+
+	wantInvalidLink :=
+		func(test assert.TestingT, err error, args ...interface{}) bool {
+			return assert.Equal(test, entities.ErrInvalidLink, errors.Cause(err), args)
+		}
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		wantURL string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success without changes",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"https://example.com/path?b=2&a=1#fragment"},
+			wantURL: "https://example.com/path?b=2&a=1#fragment",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with spaces",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"  https://example.com/path \n"},
+			wantURL: "https://example.com/path",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with an empty path",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"https://example.com"},
+			wantURL: "https://example.com/",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with an upper-case scheme and host",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"HTTPS://Example.COM/Path"},
+			wantURL: "https://example.com/Path",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with an IDN host",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"https://Bücher.example/path"},
+			wantURL: "https://xn--bcher-kva.example/path",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with a host with an underscore",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"https://my_host.example.com/path"},
+			wantURL: "https://my_host.example.com/path",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with a default port",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"https://example.com:443/path"},
+			wantURL: "https://example.com/path",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with a non-default port",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"http://Example.com:8080/path"},
+			wantURL: "http://example.com:8080/path",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with an IPv6 host and a default port",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"http://[2001:DB8::1]:80/path"},
+			wantURL: "http://[2001:db8::1]/path",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success with an IPv6 host and a non-default port",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"http://[2001:db8::1]:8080/path"},
+			wantURL: "http://[2001:db8::1]:8080/path",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success with tracking parameters",
+			fields: fields{
+				AllowedSchemes:     []string{"http", "https"},
+				TrackingParameters: []string{"utm_*", "fbclid"},
+			},
+			args: args{
+				"https://example.com/path?utm_source=x&b=2&fbclid=y&a=1&utm_medium=z",
+			},
+			wantURL: "https://example.com/path?a=1&b=2",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success without tracking parameters",
+			fields: fields{
+				AllowedSchemes:     []string{"http", "https"},
+				TrackingParameters: []string{"utm_*", "fbclid"},
+			},
+			args:    args{"https://example.com/path?b=2&a=1"},
+			wantURL: "https://example.com/path?b=2&a=1",
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error with an empty URL",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{" "},
+			wantURL: "",
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with an unparsable URL",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"https://example.com:port/path"},
+			wantURL: "",
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a relative URL",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"/path"},
+			wantURL: "",
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with a disallowed scheme",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"javascript:alert(1)"},
+			wantURL: "",
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with an opaque URL",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"https:example.com"},
+			wantURL: "",
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error without a host",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"https:///path"},
+			wantURL: "",
+			wantErr: wantInvalidLink,
+		},
+		{
+			name:    "error with an invalid IDN host",
+			fields:  fields{AllowedSchemes: []string{"http", "https"}},
+			args:    args{"https://xn--a.example/path"},
+			wantURL: "",
+			wantErr: wantInvalidLink,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			normalizer := URLNormalizer{
+				AllowedSchemes:     data.fields.AllowedSchemes,
+				TrackingParameters: data.fields.TrackingParameters,
+			}
+			gotURL, gotErr := normalizer.NormalizeURL(data.args.rawURL)
+
+			assert.Equal(test, data.wantURL, gotURL)
+			data.wantErr(test, gotErr)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package retries
+
+import (
+	"context"
+
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/generators/counters"
+)
+
+//go:generate mockery --name=LinkSetter --inpackage --case=underscore --testonly
+
+// LinkSetter ...
+type LinkSetter interface {
+	SetLink(ctx context.Context, link entities.Link) error
+}
+
+// RetryingLinkSetter ...
+//
+// A timed out attempt may be completed by the backend after all. It's safe
+// to retry it, because the link setters search for an existing link
+// by its URL; so the next attempt finds the written link and succeeds.
+//
+type RetryingLinkSetter struct {
+	LinkSetter LinkSetter
+	Retrier    Retrier
+}
+
+// SetLink ...
+func (setter RetryingLinkSetter) SetLink(
+	ctx context.Context,
+	link entities.Link,
+) error {
+	return setter.Retrier.Run(ctx, func(ctx context.Context) error {
+		return setter.LinkSetter.SetLink(ctx, link)
+	})
+}
+
+//go:generate mockery --name=BulkLinkSetter --inpackage --case=underscore --testonly
+
+// BulkLinkSetter ...
+type BulkLinkSetter interface {
+	SetLinks(ctx context.Context, links []entities.Link) ([]error, error)
+}
+
+// RetryingBulkLinkSetter ...
+//
+// It retries the whole operation on its failure and only the failed links
+// on their own failures. See the RetryingLinkSetter type about safety
+// of retrying.
+//
+type RetryingBulkLinkSetter struct {
+	BulkLinkSetter BulkLinkSetter
+	Retrier        Retrier
+}
+
+// SetLinks ...
+func (setter RetryingBulkLinkSetter) SetLinks(
+	ctx context.Context,
+	links []entities.Link,
+) ([]error, error) {
+	errs := make([]error, len(links))
+	indices := make([]int, len(links))
+	for index := range links {
+		indices[index] = index
+	}
+
+	var operationErr error
+	err := setter.Retrier.Run(ctx, func(ctx context.Context) error {
+		retriedLinks := make([]entities.Link, 0, len(indices))
+		for _, index := range indices {
+			retriedLinks = append(retriedLinks, links[index])
+		}
+
+		var linkErrs []error
+		linkErrs, operationErr = setter.BulkLinkSetter.SetLinks(ctx, retriedLinks)
+		if operationErr != nil {
+			return operationErr
+		}
+
+		// the last retryable error of links is returned to continue retrying
+		var retriedErr error
+		var retriedIndices []int
+		for position, linkErr := range linkErrs {
+			index := indices[position]
+			errs[index] = linkErr
+			if setter.Retrier.retryChecker(linkErr) {
+				retriedErr = linkErr
+				retriedIndices = append(retriedIndices, index)
+			}
+		}
+
+		indices = retriedIndices
+		return retriedErr
+	})
+	if operationErr != nil {
+		return nil, err
+	}
+
+	// errors of the links are already reported in the results
+	return errs, nil
+}
+
+// RetryingCounter ...
+type RetryingCounter struct {
+	DistributedCounter counters.DistributedCounter
+	Retrier            Retrier
+}
+
+// NextCountChunk ...
+func (counter RetryingCounter) NextCountChunk(
+	ctx context.Context,
+) (uint64, error) {
+	var countChunk uint64
+	err := counter.Retrier.Run(ctx, func(ctx context.Context) error {
+		var err error
+		countChunk, err = counter.DistributedCounter.NextCountChunk(ctx)
+
+		return err
+	})
+
+	return countChunk, err
+}
@@ -0,0 +1,213 @@
+package retries
+
+import (
+	"context"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/thewizardplusplus/go-link-shortener-backend/entities"
+)
+
+func TestRetryingLinkSetter_SetLink(test *testing.T) {
+	for _, data := range []struct {
+		name      string
+		innerErrs []error
+		wantCause error
+	}{
+		{
+			name:      "success",
+			innerErrs: []error{nil},
+			wantCause: nil,
+		},
+		{
+			name:      "success after a retry",
+			innerErrs: []error{iotest.ErrTimeout, nil},
+			wantCause: nil,
+		},
+		{
+			name:      "error",
+			innerErrs: []error{iotest.ErrTimeout, iotest.ErrTimeout},
+			wantCause: iotest.ErrTimeout,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			link := entities.Link{Code: "code", URL: "url"}
+			linkSetter := new(MockLinkSetter)
+			for _, err := range data.innerErrs {
+				linkSetter.On("SetLink", context.Background(), link).Return(err).Once()
+			}
+
+			setter := RetryingLinkSetter{
+				LinkSetter: linkSetter,
+				Retrier:    newTestRetrier(),
+			}
+			gotErr := setter.SetLink(context.Background(), link)
+
+			mock.AssertExpectationsForObjects(test, linkSetter)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestRetryingBulkLinkSetter_SetLinks(test *testing.T) {
+	links := []entities.Link{
+		{Code: "code #1", URL: "url #1"},
+		{Code: "code #2", URL: "url #2"},
+		{Code: "code #3", URL: "url #3"},
+	}
+
+	type call struct {
+		links        []entities.Link
+		linkErrs     []error
+		operationErr error
+	}
+
+	for _, data := range []struct {
+		name           string
+		calls          []call
+		wantLinkCauses []error
+		wantCause      error
+	}{
+		{
+			name: "success",
+			calls: []call{
+				{links: links, linkErrs: []error{nil, nil, nil}},
+			},
+			wantLinkCauses: []error{nil, nil, nil},
+			wantCause:      nil,
+		},
+		{
+			name: "success after a retry of the operation",
+			calls: []call{
+				{links: links, operationErr: iotest.ErrTimeout},
+				{links: links, linkErrs: []error{nil, nil, nil}},
+			},
+			wantLinkCauses: []error{nil, nil, nil},
+			wantCause:      nil,
+		},
+		{
+			name: "success after a retry of a link",
+			calls: []call{
+				{
+					links: links,
+					linkErrs: []error{
+						nil,
+						iotest.ErrTimeout,
+						entities.ErrLinkConflict,
+					},
+				},
+				{links: links[1:2], linkErrs: []error{nil}},
+			},
+			wantLinkCauses: []error{nil, nil, entities.ErrLinkConflict},
+			wantCause:      nil,
+		},
+		{
+			name: "success with an error of a link",
+			calls: []call{
+				{links: links, linkErrs: []error{nil, iotest.ErrTimeout, nil}},
+				{links: links[1:2], linkErrs: []error{iotest.ErrTimeout}},
+			},
+			wantLinkCauses: []error{nil, iotest.ErrTimeout, nil},
+			wantCause:      nil,
+		},
+		{
+			name: "error",
+			calls: []call{
+				{links: links, operationErr: iotest.ErrTimeout},
+				{links: links, operationErr: iotest.ErrTimeout},
+			},
+			wantLinkCauses: nil,
+			wantCause:      iotest.ErrTimeout,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			bulkLinkSetter := new(MockBulkLinkSetter)
+			for _, call := range data.calls {
+				bulkLinkSetter.
+					On("SetLinks", context.Background(), call.links).
+					Return(call.linkErrs, call.operationErr).
+					Once()
+			}
+
+			setter := RetryingBulkLinkSetter{
+				BulkLinkSetter: bulkLinkSetter,
+				Retrier:        newTestRetrier(),
+			}
+			gotLinkErrs, gotErr :=
+				setter.SetLinks(context.Background(), links)
+
+			var gotLinkCauses []error
+			for _, linkErr := range gotLinkErrs {
+				gotLinkCauses = append(gotLinkCauses, errors.Cause(linkErr))
+			}
+
+			mock.AssertExpectationsForObjects(test, bulkLinkSetter)
+			assert.Equal(test, data.wantLinkCauses, gotLinkCauses)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func TestRetryingCounter_NextCountChunk(test *testing.T) {
+	for _, data := range []struct {
+		name           string
+		innerErrs      []error
+		wantCountChunk uint64
+		wantCause      error
+	}{
+		{
+			name:           "success",
+			innerErrs:      []error{nil},
+			wantCountChunk: 23,
+			wantCause:      nil,
+		},
+		{
+			name:           "success after a retry",
+			innerErrs:      []error{iotest.ErrTimeout, nil},
+			wantCountChunk: 23,
+			wantCause:      nil,
+		},
+		{
+			name:           "error",
+			innerErrs:      []error{iotest.ErrTimeout, iotest.ErrTimeout},
+			wantCountChunk: 0,
+			wantCause:      iotest.ErrTimeout,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			distributedCounter := new(MockDistributedCounter)
+			for _, err := range data.innerErrs {
+				var countChunk uint64
+				if err == nil {
+					countChunk = data.wantCountChunk
+				}
+
+				distributedCounter.
+					On("NextCountChunk", context.Background()).
+					Return(countChunk, err).
+					Once()
+			}
+
+			counter := RetryingCounter{
+				DistributedCounter: distributedCounter,
+				Retrier:            newTestRetrier(),
+			}
+			gotCountChunk, gotErr := counter.NextCountChunk(context.Background())
+
+			mock.AssertExpectationsForObjects(test, distributedCounter)
+			assert.Equal(test, data.wantCountChunk, gotCountChunk)
+			assert.Equal(test, data.wantCause, errors.Cause(gotErr))
+		})
+	}
+}
+
+func newTestRetrier() Retrier {
+	return NewRetrier(
+		WithMaxAttempts(2),
+		WithSleeper(func(context.Context, time.Duration) error { return nil }),
+	)
+}
@@ -0,0 +1,201 @@
+package retries
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
+)
+
+// RetryChecker ...
+type RetryChecker func(err error) bool
+
+// RandomSource ...
+//
+// It should return a pseudo-random number in the half-open interval [0, 1).
+//
+type RandomSource func() float64
+
+// Sleeper ...
+//
+// It should wait for the delay or for the context cancellation,
+// whichever happens first.
+//
+type Sleeper func(ctx context.Context, delay time.Duration) error
+
+// RetrierOption ...
+type RetrierOption func(retrier *Retrier)
+
+// WithMaxAttempts ...
+//
+// It sets a maximal count of attempts, including the first one.
+// A count less than one is considered as one, i.e. no retries.
+//
+func WithMaxAttempts(count int) RetrierOption {
+	return func(retrier *Retrier) {
+		retrier.maxAttempts = count
+	}
+}
+
+// WithInitialDelay ...
+func WithInitialDelay(delay time.Duration) RetrierOption {
+	return func(retrier *Retrier) {
+		retrier.initialDelay = delay
+	}
+}
+
+// WithMaxDelay ...
+//
+// It limits the growth of delays. A non-positive delay means no limit.
+//
+func WithMaxDelay(delay time.Duration) RetrierOption {
+	return func(retrier *Retrier) {
+		retrier.maxDelay = delay
+	}
+}
+
+// WithMultiplier ...
+func WithMultiplier(multiplier float64) RetrierOption {
+	return func(retrier *Retrier) {
+		retrier.multiplier = multiplier
+	}
+}
+
+// WithRetryChecker ...
+func WithRetryChecker(checker RetryChecker) RetrierOption {
+	return func(retrier *Retrier) {
+		retrier.retryChecker = checker
+	}
+}
+
+// WithRandomSource ...
+func WithRandomSource(randomSource RandomSource) RetrierOption {
+	return func(retrier *Retrier) {
+		retrier.randomSource = randomSource
+	}
+}
+
+// WithSleeper ...
+func WithSleeper(sleeper Sleeper) RetrierOption {
+	return func(retrier *Retrier) {
+		retrier.sleeper = sleeper
+	}
+}
+
+// Retrier ...
+//
+// It repeats failed calls with exponentially growing delays. Delays are
+// jittered in full, i.e. chosen randomly between zero and the current
+// exponential value, so clients that failed together don't retry together.
+//
+type Retrier struct {
+	maxAttempts  int
+	initialDelay time.Duration
+	maxDelay     time.Duration
+	multiplier   float64
+	retryChecker RetryChecker
+	randomSource RandomSource
+	sleeper      Sleeper
+}
+
+// Default settings of a retrier.
+const (
+	DefaultMaxAttempts  = 3
+	DefaultInitialDelay = 50 * time.Millisecond
+	DefaultMaxDelay     = time.Second
+	DefaultMultiplier   = 2
+)
+
+// NewRetrier ...
+func NewRetrier(options ...RetrierOption) Retrier {
+	retrier := Retrier{
+		maxAttempts:  DefaultMaxAttempts,
+		initialDelay: DefaultInitialDelay,
+		maxDelay:     DefaultMaxDelay,
+		multiplier:   DefaultMultiplier,
+		retryChecker: IsRetryable,
+		randomSource: rand.Float64,
+		sleeper:      Sleep,
+	}
+	for _, option := range options {
+		option(&retrier)
+	}
+	if retrier.maxAttempts < 1 {
+		retrier.maxAttempts = 1
+	}
+
+	return retrier
+}
+
+// Run ...
+//
+// It returns the error of the last attempt as is. If the context is done
+// while waiting for the next attempt, it returns the context error instead.
+//
+func (retrier Retrier) Run(
+	ctx context.Context,
+	handler func(ctx context.Context) error,
+) error {
+	var err error
+	for attempt := 0; attempt < retrier.maxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := retrier.delay(attempt)
+			if sleepErr := retrier.sleeper(ctx, delay); sleepErr != nil {
+				return errors.Wrapf(
+					sleepErr,
+					"unable to wait for the attempt #%d after the error %v",
+					attempt+1,
+					err,
+				)
+			}
+		}
+
+		err = handler(ctx)
+		if !retrier.retryChecker(err) {
+			break
+		}
+	}
+
+	return err
+}
+
+// it returns a jittered delay before the specified attempt, counted from zero
+func (retrier Retrier) delay(attempt int) time.Duration {
+	delay := float64(retrier.initialDelay) *
+		math.Pow(retrier.multiplier, float64(attempt-1))
+	if retrier.maxDelay > 0 && delay > float64(retrier.maxDelay) {
+		delay = float64(retrier.maxDelay)
+	}
+
+	return time.Duration(retrier.randomSource() * delay)
+}
+
+// IsRetryable ...
+//
+// It's the default retry checker. It retries failures of a backend except
+// the open circuit, because the breaker rejects calls until its timeout,
+// which is usually longer than the retrying.
+//
+func IsRetryable(err error) bool {
+	return breakers.IsFailure(err) &&
+		errors.Cause(err) != breakers.ErrOpenCircuit
+}
+
+// Sleep ...
+//
+// It's the default sleeper.
+//
+func Sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
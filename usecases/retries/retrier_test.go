@@ -0,0 +1,197 @@
+package retries
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thewizardplusplus/go-link-shortener-backend/usecases/breakers"
+)
+
+func TestNewRetrier(test *testing.T) {
+	test.Run("with defaults", func(test *testing.T) {
+		got := NewRetrier()
+
+		assert.Equal(test, DefaultMaxAttempts, got.maxAttempts)
+		assert.Equal(test, DefaultInitialDelay, got.initialDelay)
+		assert.Equal(test, DefaultMaxDelay, got.maxDelay)
+		assert.Equal(test, float64(DefaultMultiplier), got.multiplier)
+		assert.NotNil(test, got.retryChecker)
+		assert.NotNil(test, got.randomSource)
+		assert.NotNil(test, got.sleeper)
+	})
+
+	test.Run("with options", func(test *testing.T) {
+		got := NewRetrier(
+			WithMaxAttempts(0),
+			WithInitialDelay(time.Second),
+			WithMaxDelay(time.Minute),
+			WithMultiplier(3),
+		)
+
+		// the first attempt is always made
+		assert.Equal(test, 1, got.maxAttempts)
+		assert.Equal(test, time.Second, got.initialDelay)
+		assert.Equal(test, time.Minute, got.maxDelay)
+		assert.Equal(test, float64(3), got.multiplier)
+	})
+}
+
+func TestRetrier_Run(test *testing.T) {
+	for _, data := range []struct {
+		name         string
+		maxDelay     time.Duration
+		handlerErrs  []error
+		sleeperErr   error
+		wantAttempts int
+		wantDelays   []time.Duration
+		wantCause    error
+	}{
+		{
+			name:         "success",
+			maxDelay:     time.Minute,
+			handlerErrs:  []error{nil},
+			wantAttempts: 1,
+			wantDelays:   nil,
+			wantCause:    nil,
+		},
+		{
+			name:         "success after retries",
+			maxDelay:     time.Minute,
+			handlerErrs:  []error{iotest.ErrTimeout, iotest.ErrTimeout, nil},
+			wantAttempts: 3,
+			wantDelays:   []time.Duration{50 * time.Millisecond, 100 * time.Millisecond},
+			wantCause:    nil,
+		},
+		{
+			name:         "success after retries with the limited delay",
+			maxDelay:     150 * time.Millisecond,
+			handlerErrs:  []error{iotest.ErrTimeout, iotest.ErrTimeout, iotest.ErrTimeout, nil},
+			wantAttempts: 4,
+			wantDelays:   []time.Duration{50 * time.Millisecond, 100 * time.Millisecond, 150 * time.Millisecond},
+			wantCause:    nil,
+		},
+		{
+			name:         "error without retries",
+			maxDelay:     time.Minute,
+			handlerErrs:  []error{errors.Wrap(sql.ErrNoRows, "dummy")},
+			wantAttempts: 1,
+			wantDelays:   nil,
+			wantCause:    sql.ErrNoRows,
+		},
+		{
+			name:         "error with the open circuit",
+			maxDelay:     time.Minute,
+			handlerErrs:  []error{iotest.ErrTimeout, errors.Wrap(breakers.ErrOpenCircuit, "dummy")},
+			wantAttempts: 2,
+			wantDelays:   []time.Duration{50 * time.Millisecond},
+			wantCause:    breakers.ErrOpenCircuit,
+		},
+		{
+			name:         "error after all the attempts",
+			maxDelay:     time.Minute,
+			handlerErrs:  []error{iotest.ErrTimeout, iotest.ErrTimeout, iotest.ErrTimeout, iotest.ErrTimeout},
+			wantAttempts: 4,
+			wantDelays:   []time.Duration{50 * time.Millisecond, 100 * time.Millisecond, 200 * time.Millisecond},
+			wantCause:    iotest.ErrTimeout,
+		},
+		{
+			name:         "error with the sleeper",
+			maxDelay:     time.Minute,
+			handlerErrs:  []error{iotest.ErrTimeout},
+			sleeperErr:   context.Canceled,
+			wantAttempts: 1,
+			wantDelays:   []time.Duration{50 * time.Millisecond},
+			wantCause:    context.Canceled,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var gotDelays []time.Duration
+			retrier := NewRetrier(
+				WithMaxAttempts(4),
+				WithInitialDelay(100*time.Millisecond),
+				WithMaxDelay(2*data.maxDelay),
+				WithMultiplier(2),
+				// it halves all the delays
+				WithRandomSource(func() float64 { return 0.5 }),
+				WithSleeper(func(ctx context.Context, delay time.Duration) error {
+					gotDelays = append(gotDelays, delay)
+					return data.sleeperErr
+				}),
+			)
+
+			var gotAttempts int
+			err := retrier.Run(context.Background(), func(context.Context) error {
+				gotAttempts++
+				return data.handlerErrs[gotAttempts-1]
+			})
+
+			assert.Equal(test, data.wantAttempts, gotAttempts)
+			assert.Equal(test, data.wantDelays, gotDelays)
+			assert.Equal(test, data.wantCause, errors.Cause(err))
+		})
+	}
+}
+
+func TestIsRetryable(test *testing.T) {
+	for _, data := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "success",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "missed link",
+			err:  errors.Wrap(sql.ErrNoRows, "dummy"),
+			want: false,
+		},
+		{
+			name: "open circuit",
+			err:  errors.Wrap(breakers.ErrOpenCircuit, "dummy"),
+			want: false,
+		},
+		{
+			name: "timeout",
+			err:  errors.Wrap(context.DeadlineExceeded, "dummy"),
+			want: true,
+		},
+		{
+			name: "failure",
+			err:  iotest.ErrTimeout,
+			want: true,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := IsRetryable(data.err)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestSleep(test *testing.T) {
+	test.Run("success", func(test *testing.T) {
+		err := Sleep(context.Background(), time.Millisecond)
+
+		assert.NoError(test, err)
+	})
+
+	test.Run("error", func(test *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := Sleep(ctx, time.Hour)
+
+		require.Error(test, err)
+		assert.Equal(test, context.Canceled, err)
+	})
+}
@@ -0,0 +1,26 @@
+package usecases
+
+import (
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=URLChecker --inpackage --case=underscore --testonly
+
+// URLChecker ...
+type URLChecker interface {
+	CheckURL(url string) error
+}
+
+// URLCheckerGroup ...
+type URLCheckerGroup []URLChecker
+
+// CheckURL ...
+func (checkers URLCheckerGroup) CheckURL(url string) error {
+	for _, checker := range checkers {
+		if err := checker.CheckURL(url); err != nil {
+			return errors.Wrap(err, "unable to check the URL")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"testing"
+	"testing/iotest"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestURLCheckerGroup_CheckURL(test *testing.T) {
+	type args struct {
+		url string
+	}
+
+	for _, data := range []struct {
+		name     string
+		checkers URLCheckerGroup
+		args     args
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "success without checkers",
+			checkers: nil,
+			args:     args{"url"},
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "success with checkers",
+			checkers: URLCheckerGroup{
+				func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+				func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(nil)
+
+					return checker
+				}(),
+			},
+			args:    args{"url"},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			checkers: URLCheckerGroup{
+				func() URLChecker {
+					checker := new(MockURLChecker)
+					checker.On("CheckURL", "url").Return(iotest.ErrTimeout)
+
+					return checker
+				}(),
+				new(MockURLChecker),
+			},
+			args: args{"url"},
+			wantErr: func(test assert.TestingT, err error, args ...interface{}) bool {
+				return assert.Equal(test, iotest.ErrTimeout, errors.Cause(err), args)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			gotErr := data.checkers.CheckURL(data.args.url)
+
+			for _, checker := range data.checkers {
+				mock.AssertExpectationsForObjects(test, checker)
+			}
+			data.wantErr(test, gotErr)
+		})
+	}
+}